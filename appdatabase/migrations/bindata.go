@@ -42,6 +42,9 @@
 // 1670836810_add_imported_flag_to_community_archive_hashes.up.sql (144B)
 // 1671438731_add_magnetlink_uri_to_communities_archive_info.up.sql (86B)
 // 1672933930_switcher_card.up.sql (162B)
+// 1674300002_add_send_read_receipts_setting.up.sql (164B)
+// 1674300011_add_mailserver_scores.up.sql (403B)
+// 1674300014_add_data_policy_setting.up.sql (50B)
 // doc.go (74B)
 
 package migrations
@@ -951,6 +954,66 @@ func _1672933930_switcher_cardUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1674300002_add_send_read_receipts_settingUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x03\x8a\xe5\xa5\xc4\x17\xa5\x26\x82\x88\xe4\xd4\xcc\x82\x92\x62\x05\x27\x7f\x7f\x1f\x57\x47\x3f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x37\x47\x9f\x60\x57\x6b\x2e\x47\x2c\x26\xc5\x17\x57\xe6\x25\xc7\x27\xe7\xe4\x27\x67\x13\x30\xd4\xd3\x2f\xc4\xd5\x1d\xa8\xdf\xcf\x3f\x44\xc1\x2f\xd4\xc7\x07\x6e\xba\x81\x35\x17\x00\x4a\x5a\x5f\x27\xa4\x00\x00\x00")
+
+func _1674300002_add_send_read_receipts_settingUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300002_add_send_read_receipts_settingUpSql,
+		"1674300002_add_send_read_receipts_setting.up.sql",
+	)
+}
+
+func _1674300002_add_send_read_receipts_settingUpSql() (*asset, error) {
+	bytes, err := _1674300002_add_send_read_receipts_settingUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300002_add_send_read_receipts_setting.up.sql", size: 164, mode: os.FileMode(0644), modTime: time.Unix(1674300002, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc5, 0xd6, 0xaa, 0x39, 0x88, 0xe8, 0x7f, 0xfe, 0x95, 0x3b, 0xe0, 0x6e, 0x89, 0x5b, 0x1, 0xc1, 0x35, 0x69, 0x52, 0x13, 0xc7, 0x72, 0x9b, 0xc7, 0x91, 0xd0, 0x28, 0x4a, 0x4f, 0x35, 0xce, 0x9a}}
+	return a, nil
+}
+
+var __1674300011_add_mailserver_scoresUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x3d\x0f\x82\x30\x14\x45\xf7\xfe\x8a\x37\x62\xe2\xe0\xee\x54\xa1\x24\x8d\xb5\x18\x28\x09\x4c\x8d\x81\x87\x69\xc2\x97\x6d\xf5\xf7\x0b\x32\x38\x98\x08\xeb\x3d\x27\x37\x37\x37\x4c\x19\x55\x0c\x14\x3d\x09\x06\x3c\x06\x99\x28\x60\x05\xcf\x54\x06\xdd\xcd\xb4\x0e\xed\x0b\xad\x76\xd5\x60\xd1\x41\x40\x00\x4c\x0d\x8a\x15\x0a\xae\x29\xbf\xd0\xb4\x84\x33\x2b\xf7\x53\xdc\xb4\x88\x7e\x21\x73\x85\xcc\x85\x98\x63\xeb\xbd\xee\x1c\x70\xf9\x8d\x21\x62\x31\xcd\x85\x82\xc3\x2c\x8c\xa6\xbf\xff\xe3\xcd\x34\x02\x6b\xbd\xa6\x59\x7c\x3c\xd1\xf9\x0d\x4d\x1b\x4c\xd3\x57\x43\x37\xb6\xe8\x71\xcd\x26\xbb\x23\x21\xe1\x72\x21\x97\x11\x2b\xd6\x2e\xd4\xcb\x4f\x89\xfc\x45\xc1\x07\x4d\x85\x6f\xd7\x13\x76\x17\x93\x01\x00\x00")
+
+func _1674300011_add_mailserver_scoresUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300011_add_mailserver_scoresUpSql,
+		"1674300011_add_mailserver_scores.up.sql",
+	)
+}
+
+func _1674300011_add_mailserver_scoresUpSql() (*asset, error) {
+	bytes, err := _1674300011_add_mailserver_scoresUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300011_add_mailserver_scores.up.sql", size: 403, mode: os.FileMode(0644), modTime: time.Unix(1674300011, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x54, 0x2e, 0xcf, 0xb2, 0xc5, 0xf8, 0x84, 0x67, 0xea, 0x9e, 0x5e, 0xbe, 0x9c, 0x2c, 0xac, 0x80, 0x2a, 0x5, 0xad, 0x90, 0x4c, 0x22, 0x7d, 0x9f, 0x4f, 0xa6, 0xf2, 0xd, 0xe, 0xb6, 0xb4, 0x79}}
+	return a, nil
+}
+
+var __1674300014_add_data_policy_settingUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x49\x2c\x49\x8c\x2f\xc8\xcf\xc9\x4c\xae\x54\x70\xf2\xf1\x77\xb2\xe6\x02\x00\xb4\x51\x57\x25\x32\x00\x00\x00")
+
+func _1674300014_add_data_policy_settingUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300014_add_data_policy_settingUpSql,
+		"1674300014_add_data_policy_setting.up.sql",
+	)
+}
+
+func _1674300014_add_data_policy_settingUpSql() (*asset, error) {
+	bytes, err := _1674300014_add_data_policy_settingUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300014_add_data_policy_setting.up.sql", size: 50, mode: os.FileMode(0644), modTime: time.Unix(1674300014, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0x71, 0xb9, 0x33, 0xef, 0x4d, 0xfd, 0x72, 0x33, 0x67, 0x64, 0xe6, 0x58, 0xa8, 0xeb, 0x67, 0xd2, 0x7d, 0xc8, 0x94, 0x9b, 0x5c, 0xf0, 0xb3, 0xaa, 0xc5, 0xd9, 0xbf, 0x32, 0x7, 0x3e, 0x9f}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -1146,6 +1209,12 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1672933930_switcher_card.up.sql": _1672933930_switcher_cardUpSql,
 
+	"1674300002_add_send_read_receipts_setting.up.sql": _1674300002_add_send_read_receipts_settingUpSql,
+
+	"1674300011_add_mailserver_scores.up.sql": _1674300011_add_mailserver_scoresUpSql,
+
+	"1674300014_add_data_policy_setting.up.sql": _1674300014_add_data_policy_settingUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -1232,7 +1301,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1670836810_add_imported_flag_to_community_archive_hashes.up.sql":  &bintree{_1670836810_add_imported_flag_to_community_archive_hashesUpSql, map[string]*bintree{}},
 	"1671438731_add_magnetlink_uri_to_communities_archive_info.up.sql": &bintree{_1671438731_add_magnetlink_uri_to_communities_archive_infoUpSql, map[string]*bintree{}},
 	"1672933930_switcher_card.up.sql":                                  &bintree{_1672933930_switcher_cardUpSql, map[string]*bintree{}},
-	"doc.go":                                                           &bintree{docGo, map[string]*bintree{}},
+	"1674300002_add_send_read_receipts_setting.up.sql":                 &bintree{_1674300002_add_send_read_receipts_settingUpSql, map[string]*bintree{}},
+	"1674300011_add_mailserver_scores.up.sql":                          &bintree{_1674300011_add_mailserver_scoresUpSql, map[string]*bintree{}},
+	"1674300014_add_data_policy_setting.up.sql":                        &bintree{_1674300014_add_data_policy_settingUpSql, map[string]*bintree{}},
+	"doc.go": &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN send_read_receipts BOOLEAN DEFAULT FALSE;
+ALTER TABLE settings_sync_clock ADD COLUMN send_read_receipts INTEGER NOT NULL DEFAULT 0;
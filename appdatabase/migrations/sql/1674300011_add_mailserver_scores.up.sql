@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS mailserver_scores (
+  id TEXT PRIMARY KEY,
+  fleet TEXT NOT NULL,
+  rtt_ms INT NOT NULL DEFAULT 0,
+  pings INT NOT NULL DEFAULT 0,
+  failed_pings INT NOT NULL DEFAULT 0,
+  requests INT NOT NULL DEFAULT 0,
+  failed_requests INT NOT NULL DEFAULT 0,
+  incomplete_requests INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS mailserver_scores_fleet ON mailserver_scores(fleet);
@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN data_policy BLOB;
@@ -0,0 +1,112 @@
+// migrate-mailserver-db copies the envelopes of a mailserver between its LevelDB and PostgreSQL backends.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/mailserver"
+)
+
+const (
+	backendLevelDB  = "leveldb"
+	backendPostgres = "postgres"
+)
+
+var (
+	srcBackend   = flag.String("src-backend", backendLevelDB, `backend of the source DB, "leveldb" or "postgres"`)
+	src          = flag.String("src", "", "data directory of the source LevelDB, or URI of the source PostgreSQL DB")
+	dstBackend   = flag.String("dst-backend", backendPostgres, `backend of the destination DB, "leveldb" or "postgres"`)
+	dst          = flag.String("dst", "", "data directory of the destination LevelDB, or URI of the destination PostgreSQL DB")
+	since        = flag.Duration("since", 30*24*time.Hour, "how far in the past to copy envelopes from")
+	window       = flag.Duration("window", 24*time.Hour, "period of time copied and checked at once")
+	progressFile = flag.String("progress", "migration-progress.json", "file keeping the progress, to resume an interrupted migration")
+	verbosity    = flag.Int("verbosity", int(log.LvlInfo), "log verbosity (0-9)")
+)
+
+func main() {
+	flag.Parse()
+
+	glogger := log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+	glogger.Verbosity(log.Lvl(*verbosity))
+	log.Root().SetHandler(glogger)
+
+	if *src == "" || *dst == "" {
+		fmt.Fprintln(os.Stderr, "both -src and -dst are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	srcDB, cleanup, err := openSource(*srcBackend, *src)
+	if err != nil {
+		log.Crit("failed to open the source DB", "err", err)
+	}
+	defer cleanup()
+
+	dstDB, err := openDB(*dstBackend, *dst)
+	if err != nil {
+		log.Crit("failed to open the destination DB", "err", err)
+	}
+	defer dstDB.Close()
+
+	copied, err := mailserver.MigrateEnvelopes(srcDB, dstDB, mailserver.MigrationOptions{
+		From:         time.Now().Add(-*since),
+		Window:       *window,
+		ProgressFile: *progressFile,
+	})
+	if err != nil {
+		log.Crit("migration failed", "copied", copied, "err", err)
+	}
+	log.Info("migration done", "copied", copied)
+}
+
+func openDB(backend string, location string) (mailserver.DB, error) {
+	switch backend {
+	case backendLevelDB:
+		return mailserver.NewLevelDB(location)
+	case backendPostgres:
+		return mailserver.NewPostgresDB(location)
+	default:
+		return nil, fmt.Errorf("unknown backend %q", backend)
+	}
+}
+
+// openSource opens the source DB. A LevelDB is locked and keeps changing while the
+// mailserver runs, so a checkpoint of it is read instead.
+func openSource(backend string, location string) (mailserver.DB, func(), error) {
+	if backend != backendLevelDB {
+		db, err := openDB(backend, location)
+		if err != nil {
+			return nil, nil, err
+		}
+		return db, func() { _ = db.Close() }, nil
+	}
+
+	// Next to the source, as the checkpoint is as large as it
+	checkpoint, err := os.MkdirTemp(filepath.Dir(filepath.Clean(location)), filepath.Base(location)+"-checkpoint")
+	if err != nil {
+		return nil, nil, err
+	}
+	removeCheckpoint := func() { _ = os.RemoveAll(checkpoint) }
+
+	err = mailserver.CheckpointLevelDB(location, checkpoint)
+	if err != nil {
+		removeCheckpoint()
+		return nil, nil, err
+	}
+
+	db, err := mailserver.NewLevelDB(checkpoint)
+	if err != nil {
+		removeCheckpoint()
+		return nil, nil, err
+	}
+	return db, func() {
+		_ = db.Close()
+		removeCheckpoint()
+	}, nil
+}
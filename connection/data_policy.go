@@ -0,0 +1,210 @@
+package connection
+
+import (
+	"errors"
+	"sync"
+)
+
+// DataCategory is a kind of non-essential traffic that can be deferred while
+// on a metered connection.
+type DataCategory string
+
+const (
+	// DataMedia is media downloaded from remote URLs, e.g. the assets of Discord imports
+	// and profile pictures
+	DataMedia DataCategory = "media"
+	// DataHistoryArchives is the community history archives downloaded with torrents
+	DataHistoryArchives DataCategory = "historyArchives"
+	// DataLinkPreviews is the pages fetched to unfurl links
+	DataLinkPreviews DataCategory = "linkPreviews"
+	// DataIPFS is the sticker packs and other content fetched from IPFS
+	DataIPFS DataCategory = "ipfs"
+	// DataCommunityHistory is the community messages requested from store nodes when
+	// joining a community or one of its chats
+	DataCommunityHistory DataCategory = "communityHistory"
+)
+
+// DataCategories lists all the categories of deferrable traffic.
+var DataCategories = []DataCategory{DataMedia, DataHistoryArchives, DataLinkPreviews, DataIPFS, DataCommunityHistory}
+
+// DataMode tells on which connections a category of traffic is allowed.
+type DataMode string
+
+const (
+	// DataAlways allows the traffic on any connection
+	DataAlways DataMode = "always"
+	// DataUnmetered defers the traffic while on a metered connection
+	DataUnmetered DataMode = "unmetered"
+)
+
+// ErrDataDeferred is returned when a request is not made because of the data policy.
+var ErrDataDeferred = errors.New("deferred until on an unmetered connection")
+
+// DefaultDataModes are the modes of the categories not configured otherwise.
+// Only the traffic happening in the background is deferred by default.
+var DefaultDataModes = map[DataCategory]DataMode{
+	DataMedia:            DataAlways,
+	DataHistoryArchives:  DataUnmetered,
+	DataLinkPreviews:     DataAlways,
+	DataIPFS:             DataAlways,
+	DataCommunityHistory: DataUnmetered,
+}
+
+// DataPolicy decides whether non-essential traffic is allowed on the current
+// connection, and holds the work deferred until it is.
+type DataPolicy struct {
+	mu       sync.Mutex
+	state    State
+	modes    map[DataCategory]DataMode
+	deferred map[DataCategory][]func()
+	keys     map[DataCategory]map[string]bool // keys of the work deferred with DeferOnce
+	allowed  map[DataCategory]chan struct{}   // closed once the category is allowed
+	held     map[DataCategory]bool            // categories with traffic refused or deferred
+}
+
+func NewDataPolicy() *DataPolicy {
+	p := &DataPolicy{
+		modes:    make(map[DataCategory]DataMode),
+		deferred: make(map[DataCategory][]func()),
+		keys:     make(map[DataCategory]map[string]bool),
+		allowed:  make(map[DataCategory]chan struct{}),
+		held:     make(map[DataCategory]bool),
+	}
+	for category, mode := range DefaultDataModes {
+		p.modes[category] = mode
+	}
+	return p
+}
+
+func (p *DataPolicy) isAllowed(category DataCategory) bool {
+	return p.modes[category] != DataUnmetered || !p.state.IsExpensive()
+}
+
+// Allowed returns whether the traffic of category is allowed on the current
+// connection. Otherwise the category is reported as resumed once it is.
+func (p *DataPolicy) Allowed(category DataCategory) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.isAllowed(category) {
+		p.held[category] = true
+		return false
+	}
+	return true
+}
+
+// Modes returns the mode of each category.
+func (p *DataPolicy) Modes() map[DataCategory]DataMode {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	modes := make(map[DataCategory]DataMode, len(p.modes))
+	for category, mode := range p.modes {
+		modes[category] = mode
+	}
+	return modes
+}
+
+// SetModes overrides the mode of the given categories, and returns the ones
+// whose traffic is resumed as a result.
+func (p *DataPolicy) SetModes(modes map[DataCategory]DataMode) []DataCategory {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for category, mode := range modes {
+		p.modes[category] = mode
+	}
+	return p.resume()
+}
+
+// ConnectionChanged updates the current connection, and returns the categories
+// whose traffic is resumed as a result.
+func (p *DataPolicy) ConnectionChanged(state State) []DataCategory {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.state = state
+	return p.resume()
+}
+
+// resume runs the deferred work of the categories allowed again
+func (p *DataPolicy) resume() []DataCategory {
+	var resumed []DataCategory
+	for _, category := range DataCategories {
+		if !p.held[category] || !p.isAllowed(category) {
+			continue
+		}
+		delete(p.held, category)
+		resumed = append(resumed, category)
+
+		if allowed, ok := p.allowed[category]; ok {
+			close(allowed)
+			delete(p.allowed, category)
+		}
+
+		for _, fn := range p.deferred[category] {
+			go fn()
+		}
+		delete(p.deferred, category)
+		delete(p.keys, category)
+	}
+	return resumed
+}
+
+// Defer holds fn until category is allowed, if it isn't on the current
+// connection. It returns whether fn was deferred, otherwise the caller proceeds.
+func (p *DataPolicy) Defer(category DataCategory, fn func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.isAllowed(category) {
+		return false
+	}
+	p.held[category] = true
+	p.deferred[category] = append(p.deferred[category], fn)
+	return true
+}
+
+// DeferOnce is Defer for work identified by key, which is only held once:
+// fn is dropped if work with the same key is already deferred.
+func (p *DataPolicy) DeferOnce(category DataCategory, key string, fn func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.isAllowed(category) {
+		return false
+	}
+	p.held[category] = true
+	if p.keys[category][key] {
+		return true
+	}
+	if p.keys[category] == nil {
+		p.keys[category] = make(map[string]bool)
+	}
+	p.keys[category][key] = true
+	p.deferred[category] = append(p.deferred[category], fn)
+	return true
+}
+
+// Wait blocks until category is allowed. It returns false if cancel is closed first.
+func (p *DataPolicy) Wait(category DataCategory, cancel <-chan struct{}) bool {
+	p.mu.Lock()
+	if p.isAllowed(category) {
+		p.mu.Unlock()
+		return true
+	}
+	p.held[category] = true
+	allowed, ok := p.allowed[category]
+	if !ok {
+		allowed = make(chan struct{})
+		p.allowed[category] = allowed
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-allowed:
+		return true
+	case <-cancel:
+		return false
+	}
+}
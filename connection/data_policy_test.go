@@ -0,0 +1,84 @@
+package connection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataPolicy(t *testing.T) {
+	p := NewDataPolicy()
+	require.True(t, p.Allowed(DataHistoryArchives))
+
+	p.ConnectionChanged(State{Type: connectionCellular})
+	require.False(t, p.Allowed(DataHistoryArchives))
+	require.True(t, p.Allowed(DataLinkPreviews))
+
+	p.SetModes(map[DataCategory]DataMode{DataLinkPreviews: DataUnmetered})
+	require.False(t, p.Allowed(DataLinkPreviews))
+
+	ran := make(chan struct{})
+	require.True(t, p.Defer(DataCommunityHistory, func() { close(ran) }))
+
+	waited := make(chan bool)
+	go func() {
+		waited <- p.Wait(DataHistoryArchives, nil)
+	}()
+
+	cancel := make(chan struct{})
+	close(cancel)
+	require.False(t, p.Wait(DataLinkPreviews, cancel))
+
+	// Expensive wifi is metered as well
+	require.Empty(t, p.ConnectionChanged(State{Type: connectionWifi, Expensive: true}))
+
+	resumed := p.ConnectionChanged(State{Type: connectionWifi})
+	require.ElementsMatch(t, []DataCategory{DataHistoryArchives, DataLinkPreviews, DataCommunityHistory}, resumed)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("deferred work didn't run")
+	}
+	require.True(t, <-waited)
+
+	// Resumed only once
+	require.Empty(t, p.ConnectionChanged(State{Type: connectionWifi}))
+	require.False(t, p.Defer(DataCommunityHistory, func() {}))
+}
+
+func TestDataPolicyDeferOnce(t *testing.T) {
+	p := NewDataPolicy()
+	p.SetModes(map[DataCategory]DataMode{DataIPFS: DataUnmetered})
+	p.ConnectionChanged(State{Type: connectionCellular})
+
+	runs := make(chan string, 3)
+	require.True(t, p.DeferOnce(DataIPFS, "a", func() { runs <- "a" }))
+	require.True(t, p.DeferOnce(DataIPFS, "a", func() { runs <- "a" }))
+	require.True(t, p.DeferOnce(DataIPFS, "b", func() { runs <- "b" }))
+
+	require.Equal(t, []DataCategory{DataIPFS}, p.ConnectionChanged(State{Type: connectionWifi}))
+
+	var ran []string
+	for i := 0; i < 2; i++ {
+		select {
+		case key := <-runs:
+			ran = append(ran, key)
+		case <-time.After(time.Second):
+			t.Fatal("deferred work didn't run")
+		}
+	}
+	require.ElementsMatch(t, []string{"a", "b"}, ran)
+	select {
+	case <-runs:
+		t.Fatal("duplicate deferred work ran")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// A key can be deferred again once its work has run
+	p.ConnectionChanged(State{Type: connectionCellular})
+	require.True(t, p.DeferOnce(DataIPFS, "a", func() { runs <- "a" }))
+	p.ConnectionChanged(State{Type: connectionWifi})
+	require.Equal(t, "a", <-runs)
+}
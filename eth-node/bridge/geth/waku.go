@@ -202,6 +202,11 @@ func (w *gethWakuWrapper) GetStats() types.StatsSummary {
 	return w.waku.GetStats()
 }
 
+// TakeTopicBandwidth is not implemented for Waku v1
+func (w *gethWakuWrapper) TakeTopicBandwidth() []types.TopicBandwidth {
+	return nil
+}
+
 func (w *gethWakuWrapper) GetFilter(id string) types.Filter {
 	return NewWakuFilterWrapper(w.waku.GetFilter(id), id)
 }
@@ -139,7 +139,10 @@ func (w *gethWakuV2Wrapper) Subscribe(opts *types.SubscriptionOptions) (string,
 		return "", err
 	}
 
-	id, err := w.waku.Subscribe(GetWakuV2FilterFrom(f))
+	wakuFilter := GetWakuV2FilterFrom(f)
+	wakuFilter.PubsubTopic = opts.PubsubTopic
+
+	id, err := w.waku.Subscribe(wakuFilter)
 	if err != nil {
 		return "", err
 	}
@@ -152,6 +155,25 @@ func (w *gethWakuV2Wrapper) GetStats() types.StatsSummary {
 	return w.waku.GetStats()
 }
 
+func (w *gethWakuV2Wrapper) TakeTopicBandwidth() []types.TopicBandwidth {
+	var result []types.TopicBandwidth
+	for _, bandwidth := range w.waku.TakeTopicBandwidth() {
+		topic, err := wakucommon.ExtractTopicFromContentTopic(bandwidth.ContentTopic)
+		if err != nil {
+			// Not a topic of ours
+			continue
+		}
+		result = append(result, types.TopicBandwidth{
+			PubsubTopic: bandwidth.PubsubTopic,
+			Topic:       types.TopicType(topic),
+			Protocol:    bandwidth.Protocol,
+			Upload:      bandwidth.Upload,
+			Download:    bandwidth.Download,
+		})
+	}
+	return result
+}
+
 func (w *gethWakuV2Wrapper) GetFilter(id string) types.Filter {
 	return NewWakuV2FilterWrapper(w.waku.GetFilter(id), id)
 }
@@ -203,7 +225,7 @@ func (w *gethWakuV2Wrapper) RequestStoreMessages(peerID []byte, r types.Messages
 		topics = append(topics, wakucommon.BytesToTopic(topic))
 	}
 
-	pbCursor, err := w.waku.Query(peer, topics, uint64(r.From), uint64(r.To), options)
+	pbCursor, err := w.waku.Query(peer, r.PubsubTopic, topics, uint64(r.From), uint64(r.To), options)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +32,10 @@ type MessagesRequest struct {
 	// Topics is a list of topics. A returned message should
 	// belong to one of the topics from the list.
 	Topics [][]byte `json:"topics"`
+
+	// PubsubTopic is the Waku v2 pubsub topic the messages were relayed on,
+	// the default one if empty.
+	PubsubTopic string `json:"pubsubTopic"`
 }
 
 type StoreRequestCursor struct {
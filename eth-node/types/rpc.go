@@ -17,6 +17,8 @@ type NewMessage struct {
 	PowTarget  float64   `json:"powTarget"`
 	TargetPeer string    `json:"targetPeer"`
 	Ephemeral  bool      `json:"ephemeral"`
+	// PubsubTopic is the Waku v2 pubsub topic to publish on, the default one if empty
+	PubsubTopic string `json:"pubsubTopic"`
 }
 
 // Message is the RPC representation of a whisper message.
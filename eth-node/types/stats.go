@@ -4,3 +4,13 @@ type StatsSummary struct {
 	UploadRate   uint64 `json:"uploadRate"`
 	DownloadRate uint64 `json:"downloadRate"`
 }
+
+// TopicBandwidth is the size of the messages sent and received on a topic over
+// a Waku protocol
+type TopicBandwidth struct {
+	PubsubTopic string    `json:"pubsubTopic"`
+	Topic       TopicType `json:"topic"`
+	Protocol    string    `json:"protocol"`
+	Upload      uint64    `json:"upload"`
+	Download    uint64    `json:"download"`
+}
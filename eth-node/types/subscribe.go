@@ -7,4 +7,7 @@ type SubscriptionOptions struct {
 	SymKeyID     string
 	PoW          float64
 	Topics       [][]byte
+	// PubsubTopic is the Waku v2 pubsub topic the messages are relayed on,
+	// the default one if empty. Ignored by Waku v1
+	PubsubTopic string
 }
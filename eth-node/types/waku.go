@@ -126,6 +126,9 @@ type Waku interface {
 
 	GetStats() StatsSummary
 
+	// TakeTopicBandwidth returns the traffic by topic and protocol since the previous call
+	TakeTopicBandwidth() []TopicBandwidth
+
 	Subscribe(opts *SubscriptionOptions) (string, error)
 	GetFilter(id string) Filter
 	Unsubscribe(id string) error
@@ -15,7 +15,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/signal"
 )
 
 const maxRequestsPerSecond = 3
@@ -39,11 +41,12 @@ type Downloader struct {
 	rateLimiterChan chan taskRequest
 	inputTaskChan   chan taskRequest
 	client          *http.Client
+	dataPolicy      *connection.DataPolicy
 
 	quit chan struct{}
 }
 
-func NewDownloader(rootDir string) *Downloader {
+func NewDownloader(rootDir string, dataPolicy *connection.DataPolicy) *Downloader {
 	ipfsDir := filepath.Clean(filepath.Join(rootDir, "./ipfs"))
 	if err := os.MkdirAll(ipfsDir, 0700); err != nil {
 		panic("could not create IPFSDir")
@@ -61,6 +64,7 @@ func NewDownloader(rootDir string) *Downloader {
 		client: &http.Client{
 			Timeout: time.Second * 5,
 		},
+		dataPolicy: dataPolicy,
 
 		quit: make(chan struct{}, 1),
 	}
@@ -150,7 +154,8 @@ func decodeStringHash(input string) (string, error) {
 }
 
 // Get checks if an IPFS image exists and returns it from cache
-// otherwise downloads it from INFURA's ipfs gateway
+// otherwise downloads it from INFURA's ipfs gateway. On a metered connection the
+// download may be deferred, a datapolicy.fetched signal is then sent once it's cached.
 func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
 	cid, err := decodeStringHash(hash)
 	if err != nil {
@@ -165,6 +170,30 @@ func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
 		return content, nil
 	}
 
+	if d.dataPolicy != nil && d.dataPolicy.DeferOnce(connection.DataIPFS, cid, func() { d.fetchDeferred(hash, cid) }) {
+		return nil, connection.ErrDataDeferred
+	}
+
+	return d.request(cid, download)
+}
+
+// fetchDeferred downloads the content of a deferred Get into the cache, and
+// notifies that it can be requested again
+func (d *Downloader) fetchDeferred(hash string, cid string) {
+	select {
+	case <-d.quit:
+		return
+	default:
+	}
+
+	_, err := d.request(cid, true)
+	if err != nil {
+		log.Error("failed to fetch deferred ipfs content", "cid", cid, "err", err)
+	}
+	signal.SendDataPolicyFetched(string(connection.DataIPFS), hash, err)
+}
+
+func (d *Downloader) request(cid string, download bool) ([]byte, error) {
 	doneChan := make(chan taskResponse, 1)
 
 	d.wg.Add(1)
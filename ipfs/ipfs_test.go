@@ -0,0 +1,64 @@
+package ipfs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/go-multicodec"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/status-im/status-go/connection"
+	"github.com/status-im/status-go/params"
+)
+
+func TestGetDeferredOnMeteredConnection(t *testing.T) {
+	content := []byte("sticker pack")
+	var requests int32
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write(content)
+	}))
+	defer gateway.Close()
+
+	gatewayURL := params.IpfsGatewayURL
+	params.IpfsGatewayURL = gateway.URL + "/"
+	defer func() { params.IpfsGatewayURL = gatewayURL }()
+
+	c, err := cid.Decode("QmWVVLwVKCwkVNjYJrRzQWREVvEk917PhbHYAUhA1gECTM")
+	require.NoError(t, err)
+	contentHash, err := multicodec.AddCodec("ipfs-ns", c.Bytes())
+	require.NoError(t, err)
+	hash := hexutil.Encode(contentHash)[2:]
+
+	policy := connection.NewDataPolicy()
+	policy.SetModes(map[connection.DataCategory]connection.DataMode{connection.DataIPFS: connection.DataUnmetered})
+	policy.ConnectionChanged(connection.State{Type: connection.NewType(connection.Cellular)})
+
+	d := NewDownloader(t.TempDir(), policy)
+	defer d.Stop()
+
+	// Deferred once, however many times it's requested
+	_, err = d.Get(hash, false)
+	require.ErrorIs(t, err, connection.ErrDataDeferred)
+	_, err = d.Get(hash, false)
+	require.ErrorIs(t, err, connection.ErrDataDeferred)
+	require.Zero(t, atomic.LoadInt32(&requests))
+
+	policy.ConnectionChanged(connection.State{Type: connection.NewType(connection.Wifi)})
+	require.Eventually(t, func() bool {
+		exists, _, err := d.exists(c.Hash().B58String())
+		return err == nil && exists
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Served from the cache once fetched
+	fetched, err := d.Get(hash, false)
+	require.NoError(t, err)
+	require.Equal(t, content, fetched)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,145 @@
+package mailserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	checkpointAttempts = 5
+	checkpointBackoff  = time.Second
+)
+
+// errCheckpointChanged is returned when the LevelDB was flushed or compacted during a copy
+var errCheckpointChanged = errors.New("the LevelDB changed during the copy")
+
+// CheckpointLevelDB copies the LevelDB in dataDir to checkpointDir, while a mailserver
+// may be writing to it. The tables and logs only form a consistent state together
+// with the manifest describing them, so the copy is retried until the manifest
+// doesn't change while the files are copied. The copy is then opened to check that
+// it's complete.
+func CheckpointLevelDB(dataDir, checkpointDir string) error {
+	var err error
+	for attempt := 1; attempt <= checkpointAttempts; attempt++ {
+		err = checkpointLevelDB(dataDir, checkpointDir)
+		if err == nil {
+			return verifyLevelDBCheckpoint(checkpointDir)
+		}
+		if !errors.Is(err, errCheckpointChanged) && !os.IsNotExist(err) {
+			return err
+		}
+		log.Info("retrying the LevelDB checkpoint", "attempt", attempt, "err", err)
+		time.Sleep(checkpointBackoff * time.Duration(attempt))
+	}
+	return fmt.Errorf("failed to checkpoint the LevelDB after %d attempts: %w", checkpointAttempts, err)
+}
+
+func checkpointLevelDB(dataDir, checkpointDir string) error {
+	if err := os.RemoveAll(checkpointDir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(checkpointDir, 0700); err != nil {
+		return err
+	}
+
+	current, err := os.ReadFile(filepath.Join(dataDir, "CURRENT"))
+	if err != nil {
+		return err
+	}
+	manifest := strings.TrimSpace(string(current))
+
+	// Tables are immutable and logs are only appended to, so copying them after the
+	// manifest is enough as long as no edit was appended to the manifest meanwhile
+	manifestSize, err := copyFile(filepath.Join(dataDir, manifest), filepath.Join(checkpointDir, manifest))
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return err
+	}
+	for _, suffix := range []string{".ldb", ".sst", ".log"} {
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), suffix) {
+				continue
+			}
+			_, err := copyFile(filepath.Join(dataDir, entry.Name()), filepath.Join(checkpointDir, entry.Name()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	currentAfter, err := os.ReadFile(filepath.Join(dataDir, "CURRENT"))
+	if err != nil {
+		return err
+	}
+	manifestInfo, err := os.Stat(filepath.Join(dataDir, manifest))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(current, currentAfter) || manifestInfo.Size() != manifestSize {
+		return errCheckpointChanged
+	}
+
+	return os.WriteFile(filepath.Join(checkpointDir, "CURRENT"), current, 0600)
+}
+
+// verifyLevelDBCheckpoint opens the copy, which fails if a table or log it
+// relies on is missing, and reads it through to check the tables' checksums
+func verifyLevelDBCheckpoint(checkpointDir string) error {
+	db, err := leveldb.OpenFile(checkpointDir, &opt.Options{
+		ErrorIfMissing: true,
+		Strict:         opt.StrictManifest | opt.StrictBlockChecksum,
+	})
+	if err != nil {
+		return err
+	}
+
+	entries := 0
+	i := db.NewIterator(nil, nil)
+	for i.Next() {
+		entries++
+	}
+	i.Release()
+	err = i.Error()
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		log.Info("checkpointed the LevelDB", "entries", entries)
+	}
+	return err
+}
+
+func copyFile(from, to string) (int64, error) {
+	in, err := os.Open(from)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(to, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(out, in)
+	if err != nil {
+		_ = out.Close()
+		return n, err
+	}
+	return n, out.Close()
+}
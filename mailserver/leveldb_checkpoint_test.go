@@ -0,0 +1,59 @@
+package mailserver
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func TestCheckpointLevelDBWhileRunning(t *testing.T) {
+	dir := t.TempDir()
+	src, err := NewLevelDB(dir)
+	require.NoError(t, err)
+	defer func() { _ = src.Close() }()
+
+	topic := []byte{0x01, 0x02, 0x03, 0x04}
+	for i := 0; i < 10; i++ {
+		envelope, err := newTestEnvelope(topic)
+		require.NoError(t, err)
+		require.NoError(t, src.SaveEnvelope(envelope))
+	}
+
+	// The mailserver keeps archiving envelopes during the checkpoint
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			envelope, err := newTestEnvelope(topic)
+			if err == nil {
+				_ = src.SaveEnvelope(envelope)
+			}
+		}
+	}()
+
+	checkpointDir := filepath.Join(t.TempDir(), "checkpoint")
+	err = CheckpointLevelDB(dir, checkpointDir)
+	close(stop)
+	wg.Wait()
+	require.NoError(t, err)
+
+	checkpoint, err := NewLevelDB(checkpointDir)
+	require.NoError(t, err)
+	defer func() { _ = checkpoint.Close() }()
+
+	counts, err := countEnvelopesByTopic(checkpoint, windowQuery(time.Now().Add(-time.Hour), time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, counts[types.BytesToTopic(topic)], 10)
+}
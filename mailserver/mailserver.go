@@ -69,6 +69,18 @@ type Config struct {
 	MinimumPoW float64
 	// RateLimit is a maximum number of requests per second from a peer.
 	RateLimit int
+	// RequestsRate is the number of requests per second a peer can make, up to
+	// RequestsBurst at once. Not limited if zero.
+	RequestsRate  float64
+	RequestsBurst int
+	// BytesRate is the number of envelope bytes per second returned to a peer, up
+	// to BytesBurst at once. Not limited if zero.
+	BytesRate  float64
+	BytesBurst int
+	// TopicQuotas limit the envelope bytes of some topics returned to a peer.
+	TopicQuotas []TopicQuota
+	// AllowedPeers are the IDs of the peers that aren't rate limited.
+	AllowedPeers []string
 	// DataRetention specifies a number of days an envelope should be stored for.
 	DataRetention   int
 	PostgresEnabled bool
@@ -98,9 +110,21 @@ func (s *WakuMailServer) Init(waku *waku.Waku, cfg *params.WakuConfig) error {
 		MinimumPoW:      cfg.MinimumPoW,
 		DataRetention:   cfg.MailServerDataRetention,
 		RateLimit:       cfg.MailServerRateLimit,
+		RequestsRate:    cfg.MailServerRequestsRate,
+		RequestsBurst:   cfg.MailServerRequestsBurst,
+		BytesRate:       cfg.MailServerBytesRate,
+		BytesBurst:      cfg.MailServerBytesBurst,
+		AllowedPeers:    cfg.MailServerAllowedPeers,
 		PostgresEnabled: cfg.DatabaseConfig.PGConfig.Enabled,
 		PostgresURI:     cfg.DatabaseConfig.PGConfig.URI,
 	}
+	for _, quota := range cfg.MailServerTopicQuotas {
+		config.TopicQuotas = append(config.TopicQuotas, TopicQuota{
+			Topic:      types.BytesToTopic(types.FromHex(quota.Topic)),
+			BytesRate:  quota.BytesRate,
+			BytesBurst: quota.BytesBurst,
+		})
+	}
 	var err error
 	s.ms, err = newMailServer(
 		config,
@@ -375,6 +399,8 @@ type mailServer struct {
 	cleaner       *dbCleaner // removes old envelopes
 	muRateLimiter sync.RWMutex
 	rateLimiter   *rateLimiter
+	tokenLimiter  *tokenBucketLimiter
+	allowedPeers  map[string]bool
 }
 
 func newMailServer(cfg Config, adapter adapter, service service) (*mailServer, error) {
@@ -388,14 +414,24 @@ func newMailServer(cfg Config, adapter adapter, service service) (*mailServer, e
 	}
 
 	s := mailServer{
-		adapter: adapter,
-		service: service,
+		adapter:      adapter,
+		service:      service,
+		allowedPeers: make(map[string]bool),
 	}
 
 	if cfg.RateLimit > 0 {
 		s.setupRateLimiter(time.Duration(cfg.RateLimit) * time.Second)
 	}
 
+	if hasLimits(cfg) {
+		s.tokenLimiter = newTokenBucketLimiter(cfg)
+		s.tokenLimiter.Start()
+	}
+
+	for _, peerID := range cfg.AllowedPeers {
+		s.allowedPeers[types.HexToHash(peerID).String()] = true
+	}
+
 	// Open database in the last step in order not to init with error
 	// and leave the database open by accident.
 	if cfg.PostgresEnabled {
@@ -480,7 +516,7 @@ func (s *mailServer) DeliverMail(peerID, reqID types.Hash, req MessagesRequestPa
 		return
 	}
 
-	if s.exceedsPeerRequests(peerID) {
+	if s.exceedsPeerRequests(peerID) || s.exceedsPeerQuotas(peerID, req.Topics) {
 		deliveryFailuresCounter.WithLabelValues("peer_req_limit").Inc()
 		log.Error(
 			"[mailserver:DeliverMail] peer exceeded the limit",
@@ -519,6 +555,7 @@ func (s *mailServer) DeliverMail(peerID, reqID types.Hash, req MessagesRequestPa
 				errCh <- err
 				break
 			}
+			s.consumePeerQuotas(peerID, bundle)
 			counter++
 		}
 		close(errCh)
@@ -677,6 +714,9 @@ func (s *mailServer) Close() {
 	if s.rateLimiter != nil {
 		s.rateLimiter.Stop()
 	}
+	if s.tokenLimiter != nil {
+		s.tokenLimiter.Stop()
+	}
 	if s.cleaner != nil {
 		s.cleaner.Stop()
 	}
@@ -686,7 +726,7 @@ func (s *mailServer) exceedsPeerRequests(peerID types.Hash) bool {
 	s.muRateLimiter.RLock()
 	defer s.muRateLimiter.RUnlock()
 
-	if s.rateLimiter == nil {
+	if s.rateLimiter == nil || s.allowedPeers[peerID.String()] {
 		return false
 	}
 
@@ -699,6 +739,49 @@ func (s *mailServer) exceedsPeerRequests(peerID types.Hash) bool {
 	return true
 }
 
+// exceedsPeerQuotas returns whether a request of peerID for topics exceeds its
+// budgets, taking it from them otherwise.
+func (s *mailServer) exceedsPeerQuotas(peerID types.Hash, topics [][]byte) bool {
+	if s.tokenLimiter == nil || s.allowedPeers[peerID.String()] {
+		return false
+	}
+
+	allowed, reason := s.tokenLimiter.Allow(peerID.String(), topics)
+	if allowed {
+		return false
+	}
+
+	rateLimitedRequestsCounter.WithLabelValues(reason).Inc()
+	log.Info("peerID exceeded its quota", "peerID", peerID.String(), "reason", reason)
+	return true
+}
+
+// consumePeerQuotas takes the envelopes sent to peerID from its budgets.
+func (s *mailServer) consumePeerQuotas(peerID types.Hash, envelopes []rlp.RawValue) {
+	if s.tokenLimiter == nil || s.allowedPeers[peerID.String()] {
+		return
+	}
+
+	size := 0
+	var topicSizes map[types.TopicType]int
+	if s.tokenLimiter.HasTopicQuotas() {
+		topicSizes = make(map[types.TopicType]int)
+	}
+	for _, rawValue := range envelopes {
+		size += len(rawValue)
+		if topicSizes == nil {
+			continue
+		}
+		var envelope wakucommon.Envelope
+		if err := rlp.DecodeBytes(rawValue, &envelope); err != nil {
+			continue
+		}
+		topicSizes[types.TopicType(envelope.Topic)] += len(rawValue)
+	}
+
+	s.tokenLimiter.Consume(peerID.String(), size, topicSizes)
+}
+
 func (s *mailServer) createIterator(req MessagesRequestPayload) (Iterator, error) {
 	var (
 		emptyHash  types.Hash
@@ -111,6 +111,16 @@ func (s *MailserverSuite) TestInit() {
 			expectedError: nil,
 			info:          "config with rate limit",
 		},
+		{
+			config: params.WakuConfig{
+				DataDir:                s.config.DataDir,
+				MailServerPassword:     "pwd",
+				MailServerRequestsRate: 1,
+				MailServerBytesRate:    1024,
+			},
+			expectedError: nil,
+			info:          "config with token bucket limits",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -135,6 +145,10 @@ func (s *MailserverSuite) TestInit() {
 			if tc.config.MailServerRateLimit > 0 {
 				s.NotNil(mailServer.ms.rateLimiter)
 			}
+
+			if tc.config.MailServerRequestsRate > 0 {
+				s.NotNil(mailServer.ms.tokenLimiter)
+			}
 		})
 	}
 }
@@ -173,6 +187,24 @@ func (s *MailserverSuite) TestManageLimits() {
 	s.Equal(firstSaved, s.server.ms.rateLimiter.db["peerID"])
 }
 
+func (s *MailserverSuite) TestManageQuotas() {
+	config := *s.config
+	allowedPeerID := types.BytesToHash([]byte("allowedPeerID"))
+	config.MailServerRequestsRate = 1
+	config.MailServerAllowedPeers = []string{allowedPeerID.String()}
+	err := s.server.Init(s.shh, &config)
+	s.NoError(err)
+	defer s.server.Close()
+
+	peerID := types.BytesToHash([]byte("peerID"))
+	s.False(s.server.ms.exceedsPeerQuotas(peerID, nil))
+	s.True(s.server.ms.exceedsPeerQuotas(peerID, nil))
+
+	// Allowed peers aren't limited
+	s.False(s.server.ms.exceedsPeerQuotas(allowedPeerID, nil))
+	s.False(s.server.ms.exceedsPeerQuotas(allowedPeerID, nil))
+}
+
 func (s *MailserverSuite) TestDBKey() {
 	var h types.Hash
 	var emptyTopic types.TopicType
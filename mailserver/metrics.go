@@ -60,6 +60,10 @@ var (
 		Help:    "Size of envelopes saved.",
 		Buckets: prom.ExponentialBuckets(1024, 2, 11),
 	}, []string{"db"})
+	rateLimitedRequestsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "mailserver_rate_limited_requests_total",
+		Help: "Number of requests rejected because a peer exceeded its quota.",
+	}, []string{"reason"})
 	envelopeQueriesCounter = prom.NewCounterVec(prom.CounterOpts{
 		Name: "mailserver_envelope_queries_total",
 		Help: "Number of queries for envelopes in the DB.",
@@ -80,5 +84,6 @@ func init() {
 	prom.MustRegister(archivedErrorsCounter)
 	prom.MustRegister(archivedEnvelopesGauge)
 	prom.MustRegister(archivedEnvelopeSizeMeter)
+	prom.MustRegister(rateLimitedRequestsCounter)
 	prom.MustRegister(envelopeQueriesCounter)
 }
@@ -0,0 +1,241 @@
+package mailserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/types"
+	wakucommon "github.com/status-im/status-go/waku/common"
+)
+
+const (
+	defaultMigrationWindow = 24 * time.Hour
+	// migrationSettleTime is how long envelopes can take to reach the source,
+	// windows more recent than that aren't recorded as migrated
+	migrationSettleTime = 10 * time.Minute
+)
+
+// MigrationOptions configures a copy of envelopes between two DBs.
+type MigrationOptions struct {
+	// From and To bound the timestamps of the envelopes to copy, To excluded. It
+	// defaults to the end of the current second.
+	From time.Time
+	To   time.Time
+	// Window is the period of time copied and checked at once.
+	Window time.Duration
+	// ProgressFile keeps the time up to which envelopes have been copied and checked,
+	// so that an interrupted migration is resumed. Progress isn't saved if empty.
+	ProgressFile string
+}
+
+// MigrationProgress is the state of a migration saved to its progress file.
+type MigrationProgress struct {
+	// CopiedUntil is the unix time up to which all envelopes have been copied and checked.
+	CopiedUntil int64 `json:"copiedUntil"`
+}
+
+// ErrMigrationCountMismatch is returned when the destination has fewer envelopes of
+// a topic in a time window than the source.
+type ErrMigrationCountMismatch struct {
+	From        time.Time
+	To          time.Time
+	Topic       types.TopicType
+	Source      int
+	Destination int
+}
+
+func (e *ErrMigrationCountMismatch) Error() string {
+	return fmt.Sprintf("envelopes of topic %s between %s and %s: %d in source, %d in destination",
+		e.Topic, e.From.Format(time.RFC3339), e.To.Format(time.RFC3339), e.Source, e.Destination)
+}
+
+// MigrateEnvelopes streams the envelopes of src into dst one time window at a time,
+// oldest first, and checks the number of envelopes of each topic of every window
+// once copied. src can keep receiving envelopes meanwhile: as envelopes are saved
+// under their own timestamp, the most recent windows are copied again on the next run.
+// It returns the number of envelopes copied.
+func MigrateEnvelopes(src, dst DB, options MigrationOptions) (int, error) {
+	if options.Window == 0 {
+		options.Window = defaultMigrationWindow
+	}
+	if options.To.IsZero() {
+		options.To = time.Now().Truncate(time.Second).Add(time.Second)
+	}
+
+	progress, err := loadMigrationProgress(options.ProgressFile)
+	if err != nil {
+		return 0, err
+	}
+
+	from := options.From
+	if progress.CopiedUntil > from.Unix() {
+		from = time.Unix(progress.CopiedUntil, 0)
+		log.Info("resuming migration", "from", from)
+	}
+
+	total := 0
+	settled := time.Now().Add(-migrationSettleTime)
+	for from.Before(options.To) {
+		to := from.Add(options.Window)
+		if to.After(options.To) {
+			to = options.To
+		}
+
+		copied, err := migrateWindow(src, dst, from, to)
+		total += copied
+		if err != nil {
+			return total, err
+		}
+		log.Info("migrated envelopes", "from", from, "to", to, "count", copied)
+
+		if !to.After(settled) {
+			progress.CopiedUntil = to.Unix()
+			err = saveMigrationProgress(options.ProgressFile, progress)
+			if err != nil {
+				return total, err
+			}
+		}
+
+		from = to
+	}
+
+	return total, nil
+}
+
+// migrateWindow copies the envelopes between from and to and checks that dst holds
+// at least as many envelopes of each topic as src did before the copy.
+func migrateWindow(src, dst DB, from, to time.Time) (int, error) {
+	query := windowQuery(from, to)
+
+	// Counted beforehand, as a running source can receive more envelopes meanwhile
+	sourceCounts, err := countEnvelopesByTopic(src, query)
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := src.BuildIterator(query)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = i.Release() }()
+
+	total := 0
+	for i.Next() {
+		rawValue, err := i.GetEnvelopeByBloomFilter(query.bloom)
+		if err != nil {
+			return total, err
+		}
+		if rawValue == nil {
+			continue
+		}
+
+		var envelope wakucommon.Envelope
+		err = rlp.DecodeBytes(rawValue, &envelope)
+		if err != nil {
+			return total, err
+		}
+
+		err = dst.SaveEnvelope(gethbridge.NewWakuEnvelope(&envelope))
+		if err != nil {
+			return total, err
+		}
+
+		total++
+	}
+	if err := i.Error(); err != nil {
+		return total, err
+	}
+
+	counts, err := countEnvelopesByTopic(dst, query)
+	if err != nil {
+		return total, err
+	}
+
+	for topic, count := range sourceCounts {
+		if counts[topic] < count {
+			return total, &ErrMigrationCountMismatch{
+				From:        from,
+				To:          to,
+				Topic:       topic,
+				Source:      count,
+				Destination: counts[topic],
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func countEnvelopesByTopic(db DB, query CursorQuery) (map[types.TopicType]int, error) {
+	i, err := db.BuildIterator(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = i.Release() }()
+
+	counts := make(map[types.TopicType]int)
+	for i.Next() {
+		key, err := i.DBKey()
+		if err != nil {
+			return nil, err
+		}
+		counts[key.Topic()]++
+	}
+
+	return counts, i.Error()
+}
+
+// windowQuery returns a query of all the envelopes between from and to.
+func windowQuery(from, to time.Time) CursorQuery {
+	var zero types.Hash
+	var emptyTopic types.TopicType
+	return CursorQuery{
+		start: NewDBKey(uint32(from.Unix()), emptyTopic, zero).Bytes(),
+		end:   NewDBKey(uint32(to.Unix()), emptyTopic, zero).Bytes(),
+		bloom: types.MakeFullNodeBloom(),
+		limit: math.MaxUint32,
+	}
+}
+
+func loadMigrationProgress(path string) (*MigrationProgress, error) {
+	progress := &MigrationProgress{}
+	if path == "" {
+		return progress, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return progress, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return progress, json.Unmarshal(data, progress)
+}
+
+func saveMigrationProgress(path string, progress *MigrationProgress) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	// Written then renamed so that the progress isn't lost if interrupted
+	tmpPath := path + ".tmp"
+	err = ioutil.WriteFile(tmpPath, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
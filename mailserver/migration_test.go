@@ -0,0 +1,140 @@
+package mailserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func newTestLevelDB(t *testing.T) (*LevelDB, func()) {
+	dir, err := os.MkdirTemp("", "status-go-test-level-db")
+	require.NoError(t, err)
+	db, err := NewLevelDB(dir)
+	require.NoError(t, err)
+	return db, func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// droppingDB loses the envelopes saved into it
+type droppingDB struct {
+	DB
+}
+
+func (d *droppingDB) SaveEnvelope(types.Envelope) error {
+	return nil
+}
+
+// unreadableDB holds envelopes that can't be read
+type unreadableDB struct {
+	DB
+}
+
+func (d *unreadableDB) BuildIterator(query CursorQuery) (Iterator, error) {
+	i, err := d.DB.BuildIterator(query)
+	if err != nil {
+		return nil, err
+	}
+	return &unreadableIterator{i}, nil
+}
+
+type unreadableIterator struct {
+	Iterator
+}
+
+func (i *unreadableIterator) GetEnvelopeByBloomFilter([]byte) ([]byte, error) {
+	return nil, nil
+}
+
+func TestMigrateEnvelopes(t *testing.T) {
+	src, cleanupSrc := newTestLevelDB(t)
+	defer cleanupSrc()
+	dst, cleanupDst := newTestLevelDB(t)
+	defer cleanupDst()
+
+	topic1 := []byte{0x01, 0x02, 0x03, 0x04}
+	topic2 := []byte{0x05, 0x06, 0x07, 0x08}
+	for _, topic := range [][]byte{topic1, topic1, topic2} {
+		envelope, err := newTestEnvelope(topic)
+		require.NoError(t, err)
+		require.NoError(t, src.SaveEnvelope(envelope))
+	}
+
+	progressFile := filepath.Join(t.TempDir(), "progress.json")
+	options := MigrationOptions{
+		From:         time.Now().Add(-time.Hour),
+		To:           time.Now().Add(time.Minute),
+		Window:       15 * time.Minute,
+		ProgressFile: progressFile,
+	}
+
+	copied, err := MigrateEnvelopes(src, dst, options)
+	require.NoError(t, err)
+	require.Equal(t, 3, copied)
+
+	counts, err := countEnvelopesByTopic(dst, windowQuery(options.From, options.To))
+	require.NoError(t, err)
+	require.Equal(t, 2, counts[types.BytesToTopic(topic1)])
+	require.Equal(t, 1, counts[types.BytesToTopic(topic2)])
+
+	// The recent windows are copied again as envelopes might still reach the source
+	progress, err := loadMigrationProgress(progressFile)
+	require.NoError(t, err)
+	require.Equal(t, options.From.Add(45*time.Minute).Unix(), progress.CopiedUntil)
+
+	copied, err = MigrateEnvelopes(src, dst, options)
+	require.NoError(t, err)
+	require.Equal(t, 3, copied)
+
+	counts, err = countEnvelopesByTopic(dst, windowQuery(options.From, options.To))
+	require.NoError(t, err)
+	require.Equal(t, 2, counts[types.BytesToTopic(topic1)])
+}
+
+func TestMigrateEnvelopesCountMismatch(t *testing.T) {
+	src, cleanupSrc := newTestLevelDB(t)
+	defer cleanupSrc()
+	dst, cleanupDst := newTestLevelDB(t)
+	defer cleanupDst()
+
+	topic := []byte{0x01, 0x02, 0x03, 0x04}
+	envelope, err := newTestEnvelope(topic)
+	require.NoError(t, err)
+	require.NoError(t, src.SaveEnvelope(envelope))
+
+	_, err = MigrateEnvelopes(src, &droppingDB{dst}, MigrationOptions{From: time.Now().Add(-time.Hour)})
+	require.Error(t, err)
+
+	mismatch, ok := err.(*ErrMigrationCountMismatch)
+	require.True(t, ok)
+	require.Equal(t, types.BytesToTopic(topic), mismatch.Topic)
+	require.Equal(t, 1, mismatch.Source)
+	require.Equal(t, 0, mismatch.Destination)
+}
+
+func TestMigrateEnvelopesChecksSourceCounts(t *testing.T) {
+	src, cleanupSrc := newTestLevelDB(t)
+	defer cleanupSrc()
+	dst, cleanupDst := newTestLevelDB(t)
+	defer cleanupDst()
+
+	topic := []byte{0x01, 0x02, 0x03, 0x04}
+	envelope, err := newTestEnvelope(topic)
+	require.NoError(t, err)
+	require.NoError(t, src.SaveEnvelope(envelope))
+
+	// Envelopes skipped while copying are still accounted for
+	_, err = MigrateEnvelopes(&unreadableDB{src}, dst, MigrationOptions{From: time.Now().Add(-time.Hour)})
+	require.Error(t, err)
+
+	mismatch, ok := err.(*ErrMigrationCountMismatch)
+	require.True(t, ok)
+	require.Equal(t, 1, mismatch.Source)
+	require.Equal(t, 0, mismatch.Destination)
+}
@@ -0,0 +1,246 @@
+package mailserver
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	limitReasonRequests = "requests"
+	limitReasonBytes    = "bytes"
+	limitReasonTopic    = "topic"
+)
+
+// TopicQuota limits the envelope bytes of a topic returned to each peer.
+type TopicQuota struct {
+	Topic types.TopicType
+	// BytesRate is the number of bytes per second, up to BytesBurst at once.
+	BytesRate  float64
+	BytesBurst int
+}
+
+// tokenBucket holds up to burst tokens, refilled at rate tokens per second.
+// It can be overdrawn, the debt is then paid back before tokens are available again.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	b := float64(burst)
+	if b == 0 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+	return &tokenBucket{rate: rate, burst: b, tokens: b, last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+func (b *tokenBucket) available(now time.Time) float64 {
+	b.refill(now)
+	return b.tokens
+}
+
+func (b *tokenBucket) take(n float64, now time.Time) {
+	b.refill(now)
+	b.tokens -= n
+}
+
+func (b *tokenBucket) full(now time.Time) bool {
+	return b.available(now) >= b.burst
+}
+
+type peerBuckets struct {
+	requests *tokenBucket
+	bytes    *tokenBucket
+	topics   map[types.TopicType]*tokenBucket
+}
+
+// tokenBucketLimiter limits the requests of each peer and the envelope bytes
+// returned to it, in total and by topic.
+type tokenBucketLimiter struct {
+	sync.Mutex
+
+	requestsRate  float64
+	requestsBurst int
+	bytesRate     float64
+	bytesBurst    int
+	topicQuotas   map[types.TopicType]TopicQuota
+
+	peers map[string]*peerBuckets
+	now   func() time.Time
+
+	period time.Duration
+	cancel chan struct{}
+}
+
+func newTokenBucketLimiter(cfg Config) *tokenBucketLimiter {
+	l := &tokenBucketLimiter{
+		requestsRate:  cfg.RequestsRate,
+		requestsBurst: cfg.RequestsBurst,
+		bytesRate:     cfg.BytesRate,
+		bytesBurst:    cfg.BytesBurst,
+		topicQuotas:   make(map[types.TopicType]TopicQuota),
+		peers:         make(map[string]*peerBuckets),
+		now:           time.Now,
+		period:        time.Minute,
+	}
+	for _, quota := range cfg.TopicQuotas {
+		l.topicQuotas[quota.Topic] = quota
+	}
+	return l
+}
+
+// hasLimits returns whether cfg limits anything with token buckets.
+func hasLimits(cfg Config) bool {
+	return cfg.RequestsRate > 0 || cfg.BytesRate > 0 || len(cfg.TopicQuotas) > 0
+}
+
+func (l *tokenBucketLimiter) Start() {
+	cancel := make(chan struct{})
+
+	l.Lock()
+	l.cancel = cancel
+	l.Unlock()
+
+	go l.cleanUp(l.period, cancel)
+}
+
+func (l *tokenBucketLimiter) Stop() {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.cancel == nil {
+		return
+	}
+	close(l.cancel)
+	l.cancel = nil
+}
+
+func (l *tokenBucketLimiter) buckets(peerID string, now time.Time) *peerBuckets {
+	buckets, ok := l.peers[peerID]
+	if !ok {
+		buckets = &peerBuckets{topics: make(map[types.TopicType]*tokenBucket)}
+		if l.requestsRate > 0 {
+			buckets.requests = newTokenBucket(l.requestsRate, l.requestsBurst, now)
+		}
+		if l.bytesRate > 0 {
+			buckets.bytes = newTokenBucket(l.bytesRate, l.bytesBurst, now)
+		}
+		l.peers[peerID] = buckets
+	}
+	return buckets
+}
+
+func (l *tokenBucketLimiter) topicBucket(buckets *peerBuckets, topic types.TopicType, now time.Time) *tokenBucket {
+	quota, ok := l.topicQuotas[topic]
+	if !ok {
+		return nil
+	}
+	bucket, ok := buckets.topics[topic]
+	if !ok {
+		bucket = newTokenBucket(quota.BytesRate, quota.BytesBurst, now)
+		buckets.topics[topic] = bucket
+	}
+	return bucket
+}
+
+// Allow returns whether peerID can make a request for topics, and takes it from
+// its budget. Otherwise it returns the reason it's rejected.
+func (l *tokenBucketLimiter) Allow(peerID string, topics [][]byte) (bool, string) {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	buckets := l.buckets(peerID, now)
+
+	if buckets.bytes != nil && buckets.bytes.available(now) <= 0 {
+		return false, limitReasonBytes
+	}
+	for _, topic := range topics {
+		bucket := l.topicBucket(buckets, types.BytesToTopic(topic), now)
+		if bucket != nil && bucket.available(now) <= 0 {
+			return false, limitReasonTopic
+		}
+	}
+	if buckets.requests != nil {
+		if buckets.requests.available(now) < 1 {
+			return false, limitReasonRequests
+		}
+		buckets.requests.take(1, now)
+	}
+
+	return true, ""
+}
+
+// Consume takes the bytes of the envelopes returned to peerID from its budget,
+// topicSizes being the bytes of the topics with a quota. The request is already
+// being served, so the budget can be overdrawn.
+func (l *tokenBucketLimiter) Consume(peerID string, size int, topicSizes map[types.TopicType]int) {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	buckets := l.buckets(peerID, now)
+	if buckets.bytes != nil {
+		buckets.bytes.take(float64(size), now)
+	}
+	for topic, topicSize := range topicSizes {
+		if bucket := l.topicBucket(buckets, topic, now); bucket != nil {
+			bucket.take(float64(topicSize), now)
+		}
+	}
+}
+
+// HasTopicQuotas returns whether the bytes of each topic must be consumed.
+func (l *tokenBucketLimiter) HasTopicQuotas() bool {
+	return len(l.topicQuotas) != 0
+}
+
+func (l *tokenBucketLimiter) cleanUp(period time.Duration, cancel <-chan struct{}) {
+	t := time.NewTicker(period)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			l.deleteIdle()
+		case <-cancel:
+			return
+		}
+	}
+}
+
+// deleteIdle forgets the peers whose budgets are full again.
+func (l *tokenBucketLimiter) deleteIdle() {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	for peerID, buckets := range l.peers {
+		if buckets.requests != nil && !buckets.requests.full(now) {
+			continue
+		}
+		if buckets.bytes != nil && !buckets.bytes.full(now) {
+			continue
+		}
+		idle := true
+		for _, bucket := range buckets.topics {
+			if !bucket.full(now) {
+				idle = false
+				break
+			}
+		}
+		if idle {
+			delete(l.peers, peerID)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package mailserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestTokenBucketLimiter(cfg Config) (*tokenBucketLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Now()}
+	l := newTokenBucketLimiter(cfg)
+	l.now = clock.Now
+	return l, clock
+}
+
+func TestTokenBucketLimiterRequests(t *testing.T) {
+	l, clock := newTestTokenBucketLimiter(Config{RequestsRate: 1, RequestsBurst: 2})
+
+	for i := 0; i < 2; i++ {
+		allowed, _ := l.Allow("peer", nil)
+		require.True(t, allowed)
+	}
+	allowed, reason := l.Allow("peer", nil)
+	require.False(t, allowed)
+	require.Equal(t, limitReasonRequests, reason)
+
+	// Other peers have their own budget
+	allowed, _ = l.Allow("other-peer", nil)
+	require.True(t, allowed)
+
+	clock.Advance(time.Second)
+	allowed, _ = l.Allow("peer", nil)
+	require.True(t, allowed)
+}
+
+func TestTokenBucketLimiterBytes(t *testing.T) {
+	l, clock := newTestTokenBucketLimiter(Config{BytesRate: 100, BytesBurst: 1000})
+
+	allowed, _ := l.Allow("peer", nil)
+	require.True(t, allowed)
+
+	// The budget is overdrawn by a big response
+	l.Consume("peer", 1500, nil)
+	allowed, reason := l.Allow("peer", nil)
+	require.False(t, allowed)
+	require.Equal(t, limitReasonBytes, reason)
+
+	// The debt is paid back first
+	clock.Advance(5 * time.Second)
+	allowed, _ = l.Allow("peer", nil)
+	require.False(t, allowed)
+
+	clock.Advance(time.Second)
+	allowed, _ = l.Allow("peer", nil)
+	require.True(t, allowed)
+}
+
+func TestTokenBucketLimiterTopicQuotas(t *testing.T) {
+	limitedTopic := types.BytesToTopic([]byte{0x01, 0x02, 0x03, 0x04})
+	otherTopic := types.BytesToTopic([]byte{0x05, 0x06, 0x07, 0x08})
+	l, clock := newTestTokenBucketLimiter(Config{
+		TopicQuotas: []TopicQuota{{Topic: limitedTopic, BytesRate: 10, BytesBurst: 100}},
+	})
+	require.True(t, l.HasTopicQuotas())
+
+	l.Consume("peer", 300, map[types.TopicType]int{limitedTopic: 200, otherTopic: 100})
+
+	allowed, reason := l.Allow("peer", [][]byte{otherTopic[:], limitedTopic[:]})
+	require.False(t, allowed)
+	require.Equal(t, limitReasonTopic, reason)
+
+	allowed, _ = l.Allow("peer", [][]byte{otherTopic[:]})
+	require.True(t, allowed)
+
+	clock.Advance(11 * time.Second)
+	allowed, _ = l.Allow("peer", [][]byte{limitedTopic[:]})
+	require.True(t, allowed)
+}
+
+func TestTokenBucketLimiterDeleteIdle(t *testing.T) {
+	l, clock := newTestTokenBucketLimiter(Config{RequestsRate: 1, RequestsBurst: 5})
+
+	allowed, _ := l.Allow("peer", nil)
+	require.True(t, allowed)
+
+	l.deleteIdle()
+	require.Len(t, l.peers, 1)
+
+	clock.Advance(time.Second)
+	l.deleteIdle()
+	require.Len(t, l.peers, 0)
+}
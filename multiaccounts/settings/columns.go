@@ -60,6 +60,11 @@ var (
 		dBColumnName:   "custom_bootnodes_enabled",
 		valueHandler:   JSONBlobHandler,
 	}
+	DataPolicy = SettingField{
+		reactFieldName: "data-policy",
+		dBColumnName:   "data_policy",
+		valueHandler:   JSONBlobHandler,
+	}
 	DappsAddress = SettingField{
 		reactFieldName: "dapps-address",
 		dBColumnName:   "dapps_address",
@@ -304,6 +309,17 @@ var (
 		dBColumnName:   "send_push_notifications",
 		valueHandler:   BoolHandler,
 	}
+	SendReadReceipts = SettingField{
+		reactFieldName: "send-read-receipts?",
+		dBColumnName:   "send_read_receipts",
+		valueHandler:   BoolHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     sendReadReceiptsProtobufFactory,
+			fromStruct:        sendReadReceiptsProtobufFactoryStruct,
+			valueFromProtobuf: BoolFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_SEND_READ_RECEIPTS,
+		},
+	}
 	SendStatusUpdates = SettingField{
 		reactFieldName: "send-status-updates?",
 		dBColumnName:   "send_status_updates",
@@ -415,6 +431,7 @@ var (
 		CustomBootNodes,
 		CustomBootNodesEnabled,
 		DappsAddress,
+		DataPolicy,
 		DefaultSyncPeriod,
 		DisplayName,
 		EIP1581Address,
@@ -454,6 +471,7 @@ var (
 		RememberSyncingChoice,
 		RemotePushNotificationsEnabled,
 		SendPushNotifications,
+		SendReadReceipts,
 		SendStatusUpdates,
 		StickersPacksInstalled,
 		StickersPacksPending,
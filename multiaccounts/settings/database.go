@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/multiaccounts/errors"
 	"github.com/status-im/status-go/nodecfg"
@@ -287,7 +288,7 @@ func (db *Database) SetSettingLastSynced(setting SettingField, clock uint64) err
 
 func (db *Database) GetSettings() (Settings, error) {
 	var s Settings
-	err := db.db.QueryRow("SELECT address, anon_metrics_should_send, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, display_name, bio, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_request_enabled, link_previews_enabled_sites, log_level, mnemonic, name, networks, notifications_enabled, push_notifications_server_enabled, push_notifications_from_contacts_only, remote_push_notifications_enabled, send_push_notifications, push_notifications_block_mentions, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, default_sync_period, use_mailservers, messages_from_contacts_only, usernames, appearance, profile_pictures_show_to, profile_pictures_visibility, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_bloom_filter_mode, webview_allow_permission_requests, current_user_status, send_status_updates, gif_recents, gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key, test_networks_enabled, mutual_contact_enabled FROM settings WHERE synthetic_id = 'id'").Scan(
+	err := db.db.QueryRow("SELECT address, anon_metrics_should_send, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, display_name, bio, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_request_enabled, link_previews_enabled_sites, log_level, mnemonic, name, networks, notifications_enabled, push_notifications_server_enabled, push_notifications_from_contacts_only, remote_push_notifications_enabled, send_push_notifications, push_notifications_block_mentions, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, default_sync_period, use_mailservers, messages_from_contacts_only, usernames, appearance, profile_pictures_show_to, profile_pictures_visibility, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_bloom_filter_mode, webview_allow_permission_requests, current_user_status, send_status_updates, gif_recents, gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key, test_networks_enabled, mutual_contact_enabled, send_read_receipts, data_policy FROM settings WHERE synthetic_id = 'id'").Scan(
 		&s.Address,
 		&s.AnonMetricsShouldSend,
 		&s.ChaosMode,
@@ -355,6 +356,8 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.GifAPIKey,
 		&s.TestNetworksEnabled,
 		&s.MutualContactEnabled,
+		&s.SendReadReceipts,
+		&sqlite.JSONBlob{Data: &s.DataPolicy},
 	)
 
 	return s, err
@@ -471,6 +474,25 @@ func (db *Database) ShouldBroadcastUserStatus() (result bool, err error) {
 	return result, err
 }
 
+// ShouldSendReadReceipts returns whether read receipts are sent, the feature is opt in
+func (db *Database) ShouldSendReadReceipts() (result bool, err error) {
+	err = db.makeSelectRow(SendReadReceipts).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return result, err
+}
+
+// DataPolicy returns the mode of each category of non-essential traffic configured
+// by the user, the default modes apply to the other categories
+func (db *Database) DataPolicy() (modes map[connection.DataCategory]connection.DataMode, err error) {
+	err = db.makeSelectRow(DataPolicy).Scan(&sqlite.JSONBlob{Data: &modes})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return modes, err
+}
+
 func (db *Database) BackupEnabled() (result bool, err error) {
 	err = db.makeSelectRow(BackupEnabled).Scan(&result)
 	if err == sql.ErrNoRows {
@@ -176,6 +176,7 @@ type Settings struct {
 	WakuBloomFilterMode            bool                          `json:"waku-bloom-filter-mode,omitempty"`
 	WebViewAllowPermissionRequests bool                          `json:"webview-allow-permission-requests?,omitempty"`
 	SendStatusUpdates              bool                          `json:"send-status-updates?,omitempty"`
+	SendReadReceipts               bool                          `json:"send-read-receipts?,omitempty"`
 	CurrentUserStatus              *json.RawMessage              `json:"current-user-status"`
 	GifRecents                     *json.RawMessage              `json:"gifs/recent-gifs"`
 	GifFavorites                   *json.RawMessage              `json:"gifs/favorite-gifs"`
@@ -186,4 +187,6 @@ type Settings struct {
 	AutoMessageEnabled             bool                          `json:"auto-message-enabled?,omitempty"`
 	GifAPIKey                      string                        `json:"gifs/api-key"`
 	TestNetworksEnabled            bool                          `json:"test-networks-enabled?,omitempty"`
+	// DataPolicy tells on which connections each category of non-essential traffic is allowed
+	DataPolicy *json.RawMessage `json:"data-policy,omitempty"`
 }
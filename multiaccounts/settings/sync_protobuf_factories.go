@@ -238,6 +238,31 @@ func profilePicturesVisibilityProtobufFactoryStruct(s Settings, clock uint64, ch
 	return buildRawProfilePicturesVisibilitySyncMessage(int64(s.ProfilePicturesVisibility), clock, chatID)
 }
 
+// SendReadReceipts
+
+func buildRawSendReadReceiptsSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_SEND_READ_RECEIPTS,
+		Value: &protobuf.SyncSetting_ValueBool{ValueBool: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func sendReadReceiptsProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertBool(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawSendReadReceiptsSyncMessage(v, clock, chatID)
+}
+
+func sendReadReceiptsProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawSendReadReceiptsSyncMessage(s.SendReadReceipts, clock, chatID)
+}
+
 // SendStatusUpdates
 
 func buildRawSendStatusUpdatesSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
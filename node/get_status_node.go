@@ -52,6 +52,7 @@ import (
 	"github.com/status-im/status-go/services/wakuv2ext"
 	"github.com/status-im/status-go/services/wallet"
 	"github.com/status-im/status-go/services/web3provider"
+	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/timesource"
 	"github.com/status-im/status-go/transactions"
 	"github.com/status-im/status-go/waku"
@@ -83,6 +84,7 @@ type StatusNode struct {
 
 	downloader *ipfs.Downloader
 	httpServer *server.MediaServer
+	dataPolicy *connection.DataPolicy // Connections on which non-essential traffic is allowed
 
 	discovery discovery.Discovery
 	register  *peers.Register
@@ -133,6 +135,7 @@ func New(transactor *transactions.Transactor) *StatusNode {
 		transactor:         transactor,
 		log:                log.New("package", "status-go/node.StatusNode"),
 		publicMethods:      make(map[string]bool),
+		dataPolicy:         connection.NewDataPolicy(),
 	}
 }
 
@@ -236,7 +239,7 @@ func (n *StatusNode) startWithDB(config *params.NodeConfig, accs *accounts.Manag
 		return err
 	}
 
-	n.downloader = ipfs.NewDownloader(config.RootDataDir)
+	n.downloader = ipfs.NewDownloader(config.RootDataDir, n.dataPolicy)
 
 	httpServer, err := server.NewMediaServer(n.appDB, n.downloader, n.multiaccountsDB)
 	if err != nil {
@@ -612,6 +615,10 @@ func (n *StatusNode) PeerCount() int {
 }
 
 func (n *StatusNode) ConnectionChanged(state connection.State) {
+	if resumed := n.dataPolicy.ConnectionChanged(state); len(resumed) != 0 {
+		signal.SendDataPolicyResumed(resumed)
+	}
+
 	if n.wakuExtSrvc == nil {
 		return
 	}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"reflect"
 
 	"github.com/status-im/status-go/server"
@@ -181,6 +182,7 @@ func (b *StatusNode) wakuExtService(config *params.NodeConfig) (*wakuext.Service
 	}
 
 	b.wakuExtSrvc.SetP2PServer(b.gethNode.Server())
+	b.wakuExtSrvc.SetDataPolicy(b.dataPolicy)
 	return b.wakuExtSrvc, nil
 }
 
@@ -193,6 +195,7 @@ func (b *StatusNode) wakuV2ExtService(config *params.NodeConfig) (*wakuv2ext.Ser
 	}
 
 	b.wakuV2ExtSrvc.SetP2PServer(b.gethNode.Server())
+	b.wakuV2ExtSrvc.SetDataPolicy(b.dataPolicy)
 	return b.wakuV2ExtSrvc, nil
 }
 
@@ -298,12 +301,24 @@ func (b *StatusNode) wakuV2Service(nodeConfig *params.NodeConfig) (*wakuv2.Waku,
 			EnableDiscV5:         nodeConfig.WakuV2Config.EnableDiscV5,
 			UDPPort:              nodeConfig.WakuV2Config.UDPPort,
 			AutoUpdate:           nodeConfig.WakuV2Config.AutoUpdate,
+			EnableRLN:            nodeConfig.WakuV2Config.EnableRLN,
+			RLNCredentialPath:    nodeConfig.WakuV2Config.RLNCredentialPath,
+			RLNStaticGroupPath:   nodeConfig.WakuV2Config.RLNStaticGroupPath,
 		}
 
 		if nodeConfig.WakuV2Config.MaxMessageSize > 0 {
 			cfg.MaxMessageSize = nodeConfig.WakuV2Config.MaxMessageSize
 		}
 
+		cfg.StoreMaxBytes = nodeConfig.WakuV2Config.StoreMaxBytes
+		cfg.StoreIncrementalVacuum = nodeConfig.WakuV2Config.StoreIncrementalVacuum
+		if cfg.StoreIncrementalVacuum {
+			cfg.StoreDBPath = filepath.Join(nodeConfig.DataDir, "wakuv2-store.db")
+		}
+		for _, policy := range nodeConfig.WakuV2Config.StoreRetentionPolicies {
+			cfg.StoreRetentionPolicies = append(cfg.StoreRetentionPolicies, wakuv2.StoreRetentionPolicy(policy))
+		}
+
 		lvl, err := logging.LevelFromString("info")
 		if err != nil {
 			panic(err)
@@ -382,6 +397,7 @@ func (b *StatusNode) accountsService(accountsFeed *event.Feed, accDB *accounts.D
 			b.config,
 			accountsFeed,
 			mediaServer,
+			b.dataPolicy,
 		)
 	}
 
@@ -100,6 +100,22 @@ type WakuConfig struct {
 	// MailServerRateLimit minimum time between queries to mail server per peer.
 	MailServerRateLimit int
 
+	// MailServerRequestsRate is the number of requests per second to mail server per peer,
+	// up to MailServerRequestsBurst at once.
+	MailServerRequestsRate  float64
+	MailServerRequestsBurst int
+
+	// MailServerBytesRate is the number of envelope bytes per second returned by mail server
+	// per peer, up to MailServerBytesBurst at once.
+	MailServerBytesRate  float64
+	MailServerBytesBurst int
+
+	// MailServerTopicQuotas limit the envelope bytes of some topics returned by mail server per peer.
+	MailServerTopicQuotas []MailServerTopicQuota
+
+	// MailServerAllowedPeers are the IDs of the peers that aren't rate limited by mail server.
+	MailServerAllowedPeers []string
+
 	// MailServerDataRetention is a number of days data should be stored by MailServer.
 	MailServerDataRetention int
 
@@ -212,6 +228,43 @@ type WakuV2Config struct {
 
 	// StoreSeconds indicates the maximum number of seconds before a message is removed from the store
 	StoreSeconds int
+
+	// StoreMaxBytes indicates the max number of bytes taken by the stored messages
+	StoreMaxBytes int64
+
+	// StoreRetentionPolicies limit the stored messages of a topic, instead of StoreCapacity and StoreSeconds
+	StoreRetentionPolicies []WakuV2StoreRetentionPolicy
+
+	// StoreIncrementalVacuum indicates whether the space freed by removed messages is returned to the file system.
+	// The messages are then moved to a DB of their own in the data directory, out of the app DB
+	StoreIncrementalVacuum bool
+
+	// EnableRLN indicates whether relayed messages must carry a RLN membership proof, requires the gowaku_rln build tag
+	EnableRLN bool
+
+	// RLNCredentialPath is the path of the JSON file holding the RLN membership credential of the node
+	RLNCredentialPath string
+
+	// RLNStaticGroupPath is the path of the JSON file listing the identity commitments of the RLN group members
+	RLNStaticGroupPath string
+}
+
+// MailServerTopicQuota limits the envelope bytes of a topic returned by mail server per peer.
+type MailServerTopicQuota struct {
+	// Topic is hex encoded
+	Topic      string
+	BytesRate  float64
+	BytesBurst int
+}
+
+// WakuV2StoreRetentionPolicy limits the stored messages of a topic. An empty pubsub
+// or content topic matches any, zero limits mean no limit
+type WakuV2StoreRetentionPolicy struct {
+	PubsubTopic  string
+	ContentTopic string
+	MaxMessages  int
+	MaxSeconds   int
+	MaxBytes     int64
 }
 
 // ----------
@@ -607,6 +660,18 @@ type ShhextConfig struct {
 	// DatasyncEnabled indicates whether we should enable dataasync
 	DataSyncEnabled bool
 
+	// SenderKeysEnabled indicates whether private group chat messages should be encrypted with sender keys
+	SenderKeysEnabled bool
+
+	// CommunityShardingEnabled indicates whether community chats should be relayed on the Waku v2 shard of their community
+	CommunityShardingEnabled bool
+
+	// MessagePaddingBuckets are the sizes in bytes encrypted payloads are padded to. Padding is disabled if empty.
+	MessagePaddingBuckets []int
+
+	// OutboxDeadlineSeconds is how long messages that can't be sent are retried for. Defaults to a day if zero.
+	OutboxDeadlineSeconds int
+
 	// VerifyTransactionURL is the URL for verifying transactions.
 	// IMPORTANT: It should always be mainnet unless used for testing
 	VerifyTransactionURL string
@@ -635,6 +700,10 @@ type ShhextConfig struct {
 
 	// BandwidthStatsEnabled indicates if a signal is going to be emitted to indicate the upload and download rate
 	BandwidthStatsEnabled bool
+
+	// MaxFileAttachmentSize is the maximum size in bytes of a file attachment that can be sent or received.
+	// If zero the messenger default is used.
+	MaxFileAttachmentSize uint64
 }
 
 // TorrentConfig provides configuration for the BitTorrent client used for message history archives.
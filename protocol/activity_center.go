@@ -27,6 +27,7 @@ const (
 	ActivityCenterNotificationTypeCommunityMembershipRequest
 	ActivityCenterNotificationTypeCommunityKicked
 	ActivityCenterNotificationTypeContactVerification
+	ActivityCenterNotificationTypeContactKeyChanged
 )
 
 type ActivityCenterMembershipStatus int
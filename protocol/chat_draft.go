@@ -0,0 +1,37 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ChatDraft is the unsent input of a chat, kept in sync across paired installations
+type ChatDraft struct {
+	ChatID      string                          `json:"chatId"`
+	Text        string                          `json:"text"`
+	ResponseTo  string                          `json:"responseTo,omitempty"`
+	Attachments []*protobuf.ChatDraftAttachment `json:"attachments,omitempty"`
+	Clock       uint64                          `json:"clock"`
+	Removed     bool                            `json:"removed,omitempty"`
+}
+
+func (d *ChatDraft) ToSyncProtobuf() *protobuf.SyncChatDraft {
+	return &protobuf.SyncChatDraft{
+		Clock:       d.Clock,
+		ChatId:      d.ChatID,
+		Text:        d.Text,
+		ResponseTo:  d.ResponseTo,
+		Attachments: d.Attachments,
+		Removed:     d.Removed,
+	}
+}
+
+func chatDraftFromSyncProtobuf(message *protobuf.SyncChatDraft) *ChatDraft {
+	return &ChatDraft{
+		ChatID:      message.ChatId,
+		Text:        message.Text,
+		ResponseTo:  message.ResponseTo,
+		Attachments: message.Attachments,
+		Clock:       message.Clock,
+		Removed:     message.Removed,
+	}
+}
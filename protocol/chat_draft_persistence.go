@@ -0,0 +1,165 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// SaveChatDraftIfNewer stores the draft unless a draft with a higher or equal clock
+// is already stored for the same chat. It returns whether the draft has been stored.
+func (db sqlitePersistence) SaveChatDraftIfNewer(draft *ChatDraft) (saved bool, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	var clock uint64
+	err = tx.QueryRow(`SELECT clock FROM chat_drafts WHERE chat_id = ?`, draft.ChatID).Scan(&clock)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+	if err == nil && clock >= draft.Clock {
+		return false, nil
+	}
+
+	var attachments []byte
+	if len(draft.Attachments) != 0 {
+		attachments, err = proto.Marshal(&protobuf.ChatDraftAttachments{Attachments: draft.Attachments})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO chat_drafts(
+		chat_id,
+		text,
+		response_to,
+		attachments,
+		clock,
+		removed)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		draft.ChatID,
+		draft.Text,
+		draft.ResponseTo,
+		attachments,
+		draft.Clock,
+		draft.Removed,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RemoveChatDraftIfOlder marks the draft of the chat as removed if its clock
+// is lower or equal to the given one, e.g. when a message has been sent in the chat
+func (db sqlitePersistence) RemoveChatDraftIfOlder(chatID string, clock uint64) (bool, error) {
+	result, err := db.db.Exec(`
+		UPDATE chat_drafts
+		SET text = '', response_to = '', attachments = NULL, clock = ?, removed = 1
+		WHERE chat_id = ? AND clock <= ? AND NOT removed`,
+		clock,
+		chatID,
+		clock,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ChatDraftClock returns the clock of the draft stored for the chat, including
+// removed ones, or 0 if there is none
+func (db sqlitePersistence) ChatDraftClock(chatID string) (uint64, error) {
+	var clock uint64
+	err := db.db.QueryRow(`SELECT clock FROM chat_drafts WHERE chat_id = ?`, chatID).Scan(&clock)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return clock, err
+}
+
+func (db sqlitePersistence) ChatDraft(chatID string) (*ChatDraft, error) {
+	drafts, err := db.chatDrafts(`WHERE chat_id = ? AND NOT removed`, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if len(drafts) == 0 {
+		return nil, nil
+	}
+	return drafts[0], nil
+}
+
+func (db sqlitePersistence) ChatDrafts() ([]*ChatDraft, error) {
+	return db.chatDrafts(`WHERE NOT removed`)
+}
+
+// AllChatDrafts returns the drafts including the removed ones, used when syncing devices
+func (db sqlitePersistence) AllChatDrafts() ([]*ChatDraft, error) {
+	return db.chatDrafts(``)
+}
+
+func (db sqlitePersistence) chatDrafts(where string, args ...interface{}) ([]*ChatDraft, error) {
+	rows, err := db.db.Query(`
+		SELECT
+			chat_id,
+			text,
+			response_to,
+			attachments,
+			clock,
+			removed
+		FROM chat_drafts `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var drafts []*ChatDraft
+	for rows.Next() {
+		draft := &ChatDraft{}
+		var attachments []byte
+		err = rows.Scan(
+			&draft.ChatID,
+			&draft.Text,
+			&draft.ResponseTo,
+			&attachments,
+			&draft.Clock,
+			&draft.Removed,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(attachments) != 0 {
+			var message protobuf.ChatDraftAttachments
+			err = proto.Unmarshal(attachments, &message)
+			if err != nil {
+				return nil, err
+			}
+			draft.Attachments = message.Attachments
+		}
+
+		drafts = append(drafts, draft)
+	}
+
+	return drafts, nil
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// Capabilities are advertised by each installation in its signed bundle, so that
+// senders know which features the installations of a recipient understand.
+// Capability names must not contain commas.
+const (
+	// CapabilityFileMessages means the installation can render file attachments
+	CapabilityFileMessages = "file-messages"
+	// CapabilityVideoMessages means the installation can render video messages
+	CapabilityVideoMessages = "video-messages"
+)
+
+// Capabilities are the capabilities of this installation
+var Capabilities = []string{
+	CapabilityFileMessages,
+	CapabilityVideoMessages,
+}
+
+// contentTypeCapabilities maps the content types older installations don't
+// understand to the capability required to render them
+var contentTypeCapabilities = map[protobuf.ChatMessage_ContentType]string{
+	protobuf.ChatMessage_FILE:  CapabilityFileMessages,
+	protobuf.ChatMessage_VIDEO: CapabilityVideoMessages,
+}
+
+// ContentTypeCapability returns the capability an installation needs to render
+// messages of the given content type, or an empty string if any installation can
+func ContentTypeCapability(contentType protobuf.ChatMessage_ContentType) string {
+	return contentTypeCapabilities[contentType]
+}
+
+// HasCapability returns whether capability is one of capabilities
+func HasCapability(capabilities []string, capability string) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
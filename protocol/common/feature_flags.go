@@ -11,4 +11,13 @@ type FeatureFlags struct {
 
 	// MailserverCycle indicates whether we should enable or not the mailserver cycle
 	MailserverCycle bool
+
+	// SenderKeys indicates whether private group chat messages should be encrypted
+	// once with a sender key, rather than once for each member
+	SenderKeys bool
+
+	// CommunitySharding indicates whether community chats should be relayed on
+	// the Waku v2 shard of their community rather than the default pubsub topic,
+	// breaking change for clients that don't support it
+	CommunitySharding bool
 }
@@ -136,6 +136,10 @@ type Message struct {
 	// Seen set to true when user have read this message already
 	Seen           bool   `json:"seen"`
 	OutgoingStatus string `json:"outgoingStatus,omitempty"`
+	// DeliveredCount is the number of recipients who acknowledged the message,
+	// out of the RecipientsCount it was reliably sent to
+	DeliveredCount  uint32 `json:"deliveredCount,omitempty"`
+	RecipientsCount uint32 `json:"recipientsCount,omitempty"`
 
 	QuotedMessage *QuotedMessage `json:"quotedMessage"`
 
@@ -170,6 +174,18 @@ type Message struct {
 	AudioLocalURL string `json:"audioLocalUrl,omitempty"`
 	// StickerLocalURL is the local url of the sticker
 	StickerLocalURL string `json:"stickerLocalUrl,omitempty"`
+	// FilePath is the path of the file to be sent
+	FilePath string `json:"filePath,omitempty"`
+	// FileLocalURL is the local url of the file
+	FileLocalURL string `json:"fileLocalUrl,omitempty"`
+	// VideoPath is the path of the video to be sent
+	VideoPath string `json:"videoPath,omitempty"`
+	// VideoThumbnailPath is the path of the poster image of the video to be sent
+	VideoThumbnailPath string `json:"videoThumbnailPath,omitempty"`
+	// VideoLocalURL is the local url of the video
+	VideoLocalURL string `json:"videoLocalUrl,omitempty"`
+	// VideoThumbnailLocalURL is the local url of the poster image of the video
+	VideoThumbnailLocalURL string `json:"videoThumbnailLocalUrl,omitempty"`
 
 	// Image dimensions
 	ImageWidth  uint32 `json:"imageWidth,omitempty"`
@@ -220,6 +236,22 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Pack int32  `json:"pack"`
 		URL  string `json:"url"`
 	}
+	type FileAlias struct {
+		Name     string `json:"name"`
+		MimeType string `json:"mimeType"`
+		Size     uint64 `json:"size"`
+		Hash     string `json:"hash"`
+		URL      string `json:"url,omitempty"`
+	}
+	type VideoAlias struct {
+		MimeType     string `json:"mimeType"`
+		Size         uint64 `json:"size"`
+		DurationMs   uint64 `json:"durationMs"`
+		Width        uint32 `json:"width"`
+		Height       uint32 `json:"height"`
+		URL          string `json:"url,omitempty"`
+		ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	}
 	item := struct {
 		ID                       string                           `json:"id"`
 		WhisperTimestamp         uint64                           `json:"whisperTimestamp"`
@@ -228,6 +260,8 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Identicon                string                           `json:"identicon"`
 		Seen                     bool                             `json:"seen"`
 		OutgoingStatus           string                           `json:"outgoingStatus,omitempty"`
+		DeliveredCount           uint32                           `json:"deliveredCount,omitempty"`
+		RecipientsCount          uint32                           `json:"recipientsCount,omitempty"`
 		QuotedMessage            *QuotedMessage                   `json:"quotedMessage"`
 		RTL                      bool                             `json:"rtl"`
 		ParsedText               json.RawMessage                  `json:"parsedText,omitempty"`
@@ -249,6 +283,8 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		AudioDurationMs          uint64                           `json:"audioDurationMs,omitempty"`
 		CommunityID              string                           `json:"communityId,omitempty"`
 		Sticker                  *StickerAlias                    `json:"sticker,omitempty"`
+		File                     *FileAlias                       `json:"file,omitempty"`
+		Video                    *VideoAlias                      `json:"video,omitempty"`
 		CommandParameters        *CommandParameters               `json:"commandParameters,omitempty"`
 		GapParameters            *GapParameters                   `json:"gapParameters,omitempty"`
 		Timestamp                uint64                           `json:"timestamp"`
@@ -272,6 +308,8 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Identicon:                m.Identicon,
 		Seen:                     m.Seen,
 		OutgoingStatus:           m.OutgoingStatus,
+		DeliveredCount:           m.DeliveredCount,
+		RecipientsCount:          m.RecipientsCount,
 		QuotedMessage:            m.QuotedMessage,
 		RTL:                      m.RTL,
 		ParsedText:               m.ParsedText,
@@ -318,6 +356,30 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		item.AudioDurationMs = audio.DurationMs
 	}
 
+	if file := m.GetFile(); file != nil {
+		item.File = &FileAlias{
+			Name:     file.Name,
+			MimeType: file.MimeType,
+			Size:     file.Size,
+			Hash:     hex.EncodeToString(file.Hash),
+			URL:      m.FileLocalURL,
+		}
+	}
+
+	if video := m.GetVideo(); video != nil {
+		item.Video = &VideoAlias{
+			DurationMs:   video.DurationMs,
+			Width:        video.Width,
+			Height:       video.Height,
+			URL:          m.VideoLocalURL,
+			ThumbnailURL: m.VideoThumbnailLocalURL,
+		}
+		if file := video.File; file != nil {
+			item.Video.MimeType = file.MimeType
+			item.Video.Size = file.Size
+		}
+	}
+
 	if discordMessage := m.GetDiscordMessage(); discordMessage != nil {
 		item.DiscordMessage = discordMessage
 	}
@@ -337,6 +399,19 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		AudioDurationMs uint64                           `json:"audioDurationMs"`
 		ParsedText      json.RawMessage                  `json:"parsedText"`
 		ContentType     protobuf.ChatMessage_ContentType `json:"contentType"`
+		File            *struct {
+			Name     string `json:"name"`
+			MimeType string `json:"mimeType"`
+			Size     uint64 `json:"size"`
+			Hash     string `json:"hash"`
+		} `json:"file"`
+		Video *struct {
+			MimeType   string `json:"mimeType"`
+			Size       uint64 `json:"size"`
+			DurationMs uint64 `json:"durationMs"`
+			Width      uint32 `json:"width"`
+			Height     uint32 `json:"height"`
+		} `json:"video"`
 	}{
 		Alias: (*Alias)(m),
 	}
@@ -351,6 +426,34 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 			Audio: &protobuf.AudioMessage{DurationMs: aux.AudioDurationMs},
 		}
 	}
+	if aux.ContentType == protobuf.ChatMessage_FILE && aux.File != nil {
+		hash, err := hex.DecodeString(aux.File.Hash)
+		if err != nil {
+			return err
+		}
+		m.Payload = &protobuf.ChatMessage_File{
+			File: &protobuf.FileMessage{
+				Name:     aux.File.Name,
+				MimeType: aux.File.MimeType,
+				Size:     aux.File.Size,
+				Hash:     hash,
+			},
+		}
+	}
+	// The video itself is read from VideoPath when sending, the client only provides its metadata
+	if aux.ContentType == protobuf.ChatMessage_VIDEO && aux.Video != nil {
+		m.Payload = &protobuf.ChatMessage_Video{
+			Video: &protobuf.VideoMessage{
+				File: &protobuf.FileMessage{
+					MimeType: aux.Video.MimeType,
+					Size:     aux.Video.Size,
+				},
+				DurationMs: aux.Video.DurationMs,
+				Width:      aux.Video.Width,
+				Height:     aux.Video.Height,
+			},
+		}
+	}
 	m.ResponseTo = aux.ResponseTo
 	m.EnsName = aux.EnsName
 	m.DisplayName = aux.DisplayName
@@ -550,6 +653,12 @@ func (m *Message) GetSimplifiedText(identity string, canonicalNames map[string]s
 	if m.ContentType == protobuf.ChatMessage_IMAGE {
 		return "Image", nil
 	}
+	if m.ContentType == protobuf.ChatMessage_FILE {
+		return "File", nil
+	}
+	if m.ContentType == protobuf.ChatMessage_VIDEO {
+		return "Video", nil
+	}
 	if m.ContentType == protobuf.ChatMessage_COMMUNITY {
 		return "Community", nil
 	}
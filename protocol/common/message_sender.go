@@ -36,6 +36,10 @@ const (
 	whisperPoWTime   = 5
 )
 
+// senderKeyAcksInterval is how often acks of group messages encrypted with a sender key
+// are sent, when they couldn't be sent along with a datasync payload
+const senderKeyAcksInterval = 5 * time.Second
+
 // SentMessage reprent a message that has been passed to the transport layer
 type SentMessage struct {
 	PublicKey  *ecdsa.PublicKey
@@ -78,8 +82,24 @@ type MessageSender struct {
 
 	featureFlags FeatureFlags
 
+	// paddingBuckets are the sizes encrypted payloads are padded to, padding is disabled if empty
+	paddingBuckets []int
+
 	// handleSharedSecrets is a callback that is called every time a new shared secret is negotiated
 	handleSharedSecrets func([]*sharedsecret.Secret) error
+
+	// senderKeyAcks are the acks of group messages encrypted with a sender key, by sender,
+	// waiting to be sent along with the next datasync payload to the sender
+	senderKeyAcks      map[string]*senderKeyAcks
+	senderKeyAcksMutex sync.Mutex
+
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+type senderKeyAcks struct {
+	publicKey *ecdsa.PublicKey
+	acks      [][]byte
 }
 
 func NewMessageSender(
@@ -115,6 +135,8 @@ func NewMessageSender(
 		logger:          logger,
 		ephemeralKeys:   make(map[string]*ecdsa.PrivateKey),
 		featureFlags:    features,
+		senderKeyAcks:   make(map[string]*senderKeyAcks),
+		quit:            make(chan struct{}),
 	}
 
 	// Initializing DataSync is required to encrypt and send messages.
@@ -128,6 +150,7 @@ func NewMessageSender(
 		// value
 		ds.Init(p.sendDataSync, transport.MaxMessageSize()/4*3, logger)
 		ds.Start(datasync.DatasyncTicker)
+		p.watchSenderKeyAcks()
 	}
 
 	return p, nil
@@ -139,12 +162,28 @@ func (s *MessageSender) Stop() {
 	}
 	s.messageEventsSubscriptions = nil
 	s.datasync.Stop() // idempotent op
+	s.quitOnce.Do(func() { close(s.quit) })
 }
 
 func (s *MessageSender) SetHandleSharedSecrets(handler func([]*sharedsecret.Secret) error) {
 	s.handleSharedSecrets = handler
 }
 
+// SetPaddingBuckets sets the sizes encrypted payloads are padded to, to hide their
+// actual size from relays and store nodes. Padding is disabled if buckets is empty.
+func (s *MessageSender) SetPaddingBuckets(buckets []int) {
+	s.paddingBuckets = buckets
+}
+
+// padMessage pads message to the configured size buckets
+func (s *MessageSender) padMessage(message *encryption.ProtocolMessage) {
+	if len(s.paddingBuckets) == 0 {
+		return
+	}
+	// Leave room for the transport layer, as datasync does
+	padProtocolMessage(message, s.paddingBuckets, int(s.transport.MaxMessageSize()/4*3))
+}
+
 // SendPrivate takes encoded data, encrypts it and sends through the wire.
 func (s *MessageSender) SendPrivate(
 	ctx context.Context,
@@ -214,6 +253,10 @@ func (s *MessageSender) SendGroup(
 	// Send to each recipients
 	for _, recipient := range recipients {
 		_, err = s.sendPrivate(ctx, recipient, &rawMessage)
+		// The message might target none of the installations of some members
+		if err == encryption.ErrNoInstallations {
+			continue
+		}
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to send message")
 		}
@@ -221,6 +264,106 @@ func (s *MessageSender) SendGroup(
 	return messageID, nil
 }
 
+// SendGroupWithSenderKey encrypts the message once with our sender key for the group
+// and sends it on the group topic. Our sender key is distributed to the recipients
+// beforehand, over the pairwise sessions, whenever they changed.
+func (s *MessageSender) SendGroupWithSenderKey(
+	ctx context.Context,
+	recipients []*ecdsa.PublicKey,
+	rawMessage RawMessage,
+) ([]byte, error) {
+	s.logger.Debug(
+		"sending a private group message with sender key",
+		zap.String("site", "SendGroupWithSenderKey"),
+	)
+	// Set sender if not specified
+	if rawMessage.Sender == nil {
+		rawMessage.Sender = s.identity
+	}
+
+	wrappedMessage, err := s.wrapMessageV1(&rawMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap message")
+	}
+	messageID := v1protocol.MessageID(&rawMessage.Sender.PublicKey, wrappedMessage)
+	rawMessage.ID = types.EncodeHex(messageID)
+	messageIDs := [][]byte{messageID}
+
+	messageSpec, keyExchangeSpecs, err := s.protocol.BuildSenderKeyMessage(rawMessage.Sender, rawMessage.LocalChatID, recipients, wrappedMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt message")
+	}
+
+	if len(keyExchangeSpecs) != 0 {
+		for i, spec := range keyExchangeSpecs {
+			if s.handleSharedSecrets != nil {
+				err := s.handleSharedSecrets([]*sharedsecret.Secret{spec.SharedSecret})
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			_, _, err = s.sendMessageSpec(ctx, recipients[i], spec, nil, false)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to send sender key")
+			}
+		}
+
+		err = s.protocol.ConfirmSenderKeyDistribution(rawMessage.Sender, rawMessage.LocalChatID, recipients)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Messages sent with a sender key don't go through datasync, recipients
+	// acknowledge them separately
+	if s.featureFlags.Datasync && rawMessage.ResendAutomatically {
+		sentAt := time.Now().Unix()
+		for _, recipient := range recipients {
+			if IsPubKeyEqual(recipient, &s.identity.PublicKey) {
+				continue
+			}
+			confirmation := &RawMessageConfirmation{
+				DataSyncID: GroupMessageAckID(messageID, recipient),
+				MessageID:  messageID,
+				PublicKey:  crypto.CompressPubkey(recipient),
+				SentAt:     sentAt,
+			}
+			err = s.persistence.InsertPendingConfirmation(confirmation)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	s.padMessage(messageSpec.Message)
+	payload, err := proto.Marshal(messageSpec.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal")
+	}
+
+	hash, newMessage, err := s.dispatchCommunityChatMessage(ctx, &rawMessage, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// The message has been sent to every recipient at once, push notifications
+	// are handled as if it had been sent to each of them
+	for _, recipient := range recipients {
+		if IsPubKeyEqual(recipient, &s.identity.PublicKey) {
+			continue
+		}
+		s.notifyOnSentMessage(&SentMessage{
+			PublicKey:  recipient,
+			Spec:       messageSpec,
+			MessageIDs: messageIDs,
+		})
+	}
+
+	s.transport.Track(messageIDs, hash, newMessage)
+	return messageID, nil
+}
+
 func (s *MessageSender) getMessageID(rawMessage *RawMessage) (types.HexBytes, error) {
 	wrappedMessage, err := s.wrapMessageV1(rawMessage)
 	if err != nil {
@@ -268,17 +411,22 @@ func (s *MessageSender) sendCommunity(
 	var hash []byte
 	var newMessage *types.NewMessage
 
+	groupID := rawMessage.CommunityID
+	if rawMessage.HashRatchetGroupID != nil {
+		groupID = rawMessage.HashRatchetGroupID
+	}
+
 	// Check if it's a key exchange message. In this case we send it
 	// to all the recipients
 	if rawMessage.CommunityKeyExMsgType != KeyExMsgNone {
-		keyExMessageSpecs, err := s.protocol.GetKeyExMessageSpecs(rawMessage.CommunityID, s.identity, rawMessage.Recipients, rawMessage.CommunityKeyExMsgType == KeyExMsgRekey)
+		keyExMessageSpecs, err := s.protocol.GetKeyExMessageSpecs(groupID, s.identity, rawMessage.Recipients, rawMessage.CommunityKeyExMsgType == KeyExMsgRekey)
 		if err != nil {
 			return nil, err
 		}
 
 		for i, spec := range keyExMessageSpecs {
 			recipient := rawMessage.Recipients[i]
-			_, _, err = s.sendMessageSpec(ctx, recipient, spec, messageIDs)
+			_, _, err = s.sendMessageSpec(ctx, recipient, spec, messageIDs, false)
 			if err != nil {
 				return nil, err
 			}
@@ -293,11 +441,12 @@ func (s *MessageSender) sendCommunity(
 
 	// If it's a chat message, we send it on the community chat topic
 	if ShouldCommunityMessageBeEncrypted(rawMessage.MessageType) {
-		messageSpec, err := s.protocol.BuildHashRatchetMessage(rawMessage.CommunityID, wrappedMessage)
+		messageSpec, err := s.protocol.BuildHashRatchetMessage(groupID, wrappedMessage)
 		if err != nil {
 			return nil, err
 		}
 
+		s.padMessage(messageSpec.Message)
 		payload, err := proto.Marshal(messageSpec.Message)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to marshal")
@@ -355,7 +504,10 @@ func (s *MessageSender) sendPrivate(
 	// earlier than the scheduled
 	s.notifyOnScheduledMessage(recipient, rawMessage)
 
-	if s.featureFlags.Datasync && rawMessage.ResendAutomatically {
+	installationsFilter := rawMessage.installationsFilter()
+
+	// Datasync encrypts its batches for all the installations of the recipient
+	if s.featureFlags.Datasync && rawMessage.ResendAutomatically && installationsFilter == nil {
 		// No need to call transport tracking.
 		// It is done in a data sync dispatch step.
 		datasyncID, err := s.addToDataSync(recipient, wrappedMessage)
@@ -389,7 +541,10 @@ func (s *MessageSender) sendPrivate(
 		s.transport.Track(messageIDs, hash, newMessage)
 
 	} else {
-		messageSpec, err := s.protocol.BuildEncryptedMessage(rawMessage.Sender, recipient, wrappedMessage)
+		messageSpec, err := s.protocol.BuildEncryptedMessageForInstallations(rawMessage.Sender, recipient, wrappedMessage, installationsFilter)
+		if err == encryption.ErrNoInstallations {
+			return messageID, err
+		}
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to encrypt message")
 		}
@@ -405,7 +560,7 @@ func (s *MessageSender) sendPrivate(
 		}
 
 		messageIDs := [][]byte{messageID}
-		hash, newMessage, err := s.sendMessageSpec(ctx, recipient, messageSpec, messageIDs)
+		hash, newMessage, err := s.sendMessageSpec(ctx, recipient, messageSpec, messageIDs, rawMessage.Ephemeral)
 		if err != nil {
 			s.logger.Error("failed to send a private message", zap.Error(err))
 			return nil, errors.Wrap(err, "failed to send a message spec")
@@ -440,7 +595,39 @@ func (s *MessageSender) SendPairInstallation(
 	messageID := v1protocol.MessageID(&s.identity.PublicKey, wrappedMessage)
 	messageIDs := [][]byte{messageID}
 
-	hash, newMessage, err := s.sendMessageSpec(ctx, recipient, messageSpec, messageIDs)
+	hash, newMessage, err := s.sendMessageSpec(ctx, recipient, messageSpec, messageIDs, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send a message spec")
+	}
+
+	s.transport.Track(messageIDs, hash, newMessage)
+
+	return messageID, nil
+}
+
+// SendSessionReset resets the sessions with the recipient and sends them the
+// reset message using DH, along with our bundle
+func (s *MessageSender) SendSessionReset(
+	ctx context.Context,
+	recipient *ecdsa.PublicKey,
+	rawMessage RawMessage,
+) ([]byte, error) {
+	s.logger.Debug("sending session reset", zap.String("recipient", types.EncodeHex(crypto.FromECDSAPub(recipient))))
+
+	wrappedMessage, err := s.wrapMessageV1(&rawMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap message")
+	}
+
+	messageSpec, err := s.protocol.BuildSessionResetMessage(s.identity, recipient, wrappedMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt message")
+	}
+
+	messageID := v1protocol.MessageID(&s.identity.PublicKey, wrappedMessage)
+	messageIDs := [][]byte{messageID}
+
+	hash, newMessage, err := s.sendMessageSpec(ctx, recipient, messageSpec, messageIDs, false)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to send a message spec")
 	}
@@ -508,6 +695,7 @@ func (s *MessageSender) dispatchCommunityChatMessage(ctx context.Context, rawMes
 		Payload:   wrappedMessage,
 		PowTarget: calculatePoW(wrappedMessage),
 		PowTime:   whisperPoWTime,
+		Ephemeral: rawMessage.Ephemeral,
 	}
 
 	// notify before dispatching
@@ -546,6 +734,7 @@ func (s *MessageSender) SendPublic(
 	}
 
 	if !rawMessage.SkipEncryption {
+		s.padMessage(messageSpec.Message)
 		newMessage, err = MessageSpecToWhisper(messageSpec)
 		if err != nil {
 			return nil, err
@@ -586,6 +775,90 @@ func (s *MessageSender) SendPublic(
 	return messageID, nil
 }
 
+// GroupMessageAckID returns the id with which recipient acknowledges a group message
+// encrypted with a sender key. As those are not sent through datasync, recipients
+// add their acks to the datasync payloads they send to the sender.
+func GroupMessageAckID(messageID []byte, recipient *ecdsa.PublicKey) []byte {
+	return crypto.Keccak256(messageID, crypto.CompressPubkey(recipient))
+}
+
+// acknowledgeSenderKeyMessage acknowledges a group message encrypted with a sender key.
+// Acks are batched by sender, and sent along with the next datasync payload to them,
+// or on their own every senderKeyAcksInterval, not one message for each ack.
+func (s *MessageSender) acknowledgeSenderKeyMessage(message *v1protocol.StatusMessage) {
+	sender := message.SigPubKey()
+	if !s.featureFlags.Datasync || sender == nil || IsPubKeyEqual(sender, &s.identity.PublicKey) {
+		return
+	}
+
+	s.senderKeyAcksMutex.Lock()
+	defer s.senderKeyAcksMutex.Unlock()
+
+	senderID := types.EncodeHex(crypto.FromECDSAPub(sender))
+	pending, ok := s.senderKeyAcks[senderID]
+	if !ok {
+		pending = &senderKeyAcks{publicKey: sender}
+		s.senderKeyAcks[senderID] = pending
+	}
+	pending.acks = append(pending.acks, GroupMessageAckID(message.ID, &s.identity.PublicKey))
+}
+
+// popSenderKeyAcks returns the pending acks of group messages sent by publicKey
+func (s *MessageSender) popSenderKeyAcks(publicKey *ecdsa.PublicKey) [][]byte {
+	s.senderKeyAcksMutex.Lock()
+	defer s.senderKeyAcksMutex.Unlock()
+
+	senderID := types.EncodeHex(crypto.FromECDSAPub(publicKey))
+	pending, ok := s.senderKeyAcks[senderID]
+	if !ok {
+		return nil
+	}
+	delete(s.senderKeyAcks, senderID)
+	return pending.acks
+}
+
+// watchSenderKeyAcks regularly sends the acks that haven't been sent along with other payloads
+func (s *MessageSender) watchSenderKeyAcks() {
+	go func() {
+		for {
+			select {
+			case <-time.After(senderKeyAcksInterval):
+				s.sendSenderKeyAcks()
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (s *MessageSender) sendSenderKeyAcks() {
+	s.senderKeyAcksMutex.Lock()
+	var senders []*ecdsa.PublicKey
+	for _, pending := range s.senderKeyAcks {
+		senders = append(senders, pending.publicKey)
+	}
+	s.senderKeyAcksMutex.Unlock()
+
+	for _, sender := range senders {
+		// The acks might have been sent along with a datasync payload in the meantime
+		acks := s.popSenderKeyAcks(sender)
+		if len(acks) == 0 {
+			continue
+		}
+
+		payload := &datasyncproto.Payload{Acks: acks}
+		marshalledPayload, err := proto.Marshal(payload)
+		if err != nil {
+			s.logger.Error("failed to marshal acknowledgements", zap.Error(err))
+			return
+		}
+
+		if err := s.sendDataSync(context.Background(), sender, marshalledPayload, payload); err != nil {
+			s.logger.Error("failed to acknowledge group messages", zap.Error(err))
+		}
+	}
+}
+
 // unwrapDatasyncMessage tries to unwrap message as datasync one and in case of success
 // returns cloned messages with replaced payloads
 func unwrapDatasyncMessage(m *v1protocol.StatusMessage, datasync *datasync.DataSync) ([]*v1protocol.StatusMessage, [][]byte, error) {
@@ -633,8 +906,13 @@ func (s *MessageSender) HandleMessages(shhMessage *types.Message, applicationLay
 		hlogger.Debug("failed to handle an encryption message", zap.Error(err))
 	}
 
+	// The message can't be decrypted, the application layer resets the session
+	if statusMessage.SessionOutOfSync {
+		return []*v1protocol.StatusMessage{&statusMessage}, nil, nil
+	}
+
 	// Hash ratchet with a group id not found yet
-	if err == encryption.ErrHashRatchetGroupIDNotFound && len(statusMessage.HashRatchetInfo) == 1 {
+	if errors.Cause(err) == encryption.ErrHashRatchetGroupIDNotFound && len(statusMessage.HashRatchetInfo) == 1 {
 		info := statusMessage.HashRatchetInfo[0]
 		err := s.persistence.SaveHashRatchetMessage(info.GroupID, info.KeyID, shhMessage)
 		return nil, nil, err
@@ -654,11 +932,16 @@ func (s *MessageSender) HandleMessages(shhMessage *types.Message, applicationLay
 				hlogger.Error("failed to handle transport layer message", zap.Error(err))
 				return nil, nil, err
 			}
+			err = s.handleEncryptionLayer(context.Background(), &statusMessage)
+			if err != nil {
+				hlogger.Debug("failed to handle an encryption message", zap.Error(err))
+				continue
+			}
 			stms, as, err := unwrapDatasyncMessage(&statusMessage, s.datasync)
 			if err != nil {
 				hlogger.Debug("failed to handle datasync message", zap.Error(err))
 				//that wasn't a datasync message, so use the original payload
-				statusMessages = append(stms, &statusMessage)
+				statusMessages = append(statusMessages, &statusMessage)
 
 			} else {
 				statusMessages = append(statusMessages, stms...)
@@ -671,7 +954,7 @@ func (s *MessageSender) HandleMessages(shhMessage *types.Message, applicationLay
 	if err != nil {
 		hlogger.Debug("failed to handle datasync message", zap.Error(err))
 		//that wasn't a datasync message, so use the original payload
-		statusMessages = append(stms, &statusMessage)
+		statusMessages = append(statusMessages, &statusMessage)
 	} else {
 		statusMessages = append(statusMessages, stms...)
 		acks = append(acks, as...)
@@ -689,6 +972,10 @@ func (s *MessageSender) HandleMessages(shhMessage *types.Message, applicationLay
 				hlogger.Error("failed to handle application layer message", zap.Error(err))
 			}
 		}
+
+		if statusMessage.SenderKey && statusMessage.ID != nil {
+			s.acknowledgeSenderKeyMessage(statusMessage)
+		}
 	}
 
 	return statusMessages, acks, nil
@@ -750,7 +1037,7 @@ func (s *MessageSender) handleErrDeviceNotFound(ctx context.Context, publicKey *
 	defer cancel()
 	// We don't pass an array of messageIDs as no action needs to be taken
 	// when sending a bundle
-	_, _, err = s.sendMessageSpec(ctx, publicKey, messageSpec, nil)
+	_, _, err = s.sendMessageSpec(ctx, publicKey, messageSpec, nil, false)
 	if err != nil {
 		return err
 	}
@@ -791,6 +1078,16 @@ func (s *MessageSender) addToDataSync(publicKey *ecdsa.PublicKey, message []byte
 // sendDataSync sends a message scheduled by the data sync layer.
 // Data Sync layer calls this method "dispatch" function.
 func (s *MessageSender) sendDataSync(ctx context.Context, publicKey *ecdsa.PublicKey, marshalledDatasyncPayload []byte, payload *datasyncproto.Payload) error {
+	// Group messages encrypted with a sender key are acknowledged along with datasync ones
+	if acks := s.popSenderKeyAcks(publicKey); len(acks) != 0 {
+		payload.Acks = append(payload.Acks, acks...)
+		var err error
+		marshalledDatasyncPayload, err = proto.Marshal(payload)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Calculate the messageIDs
 	messageIDs := make([][]byte, 0, len(payload.Messages))
 	hexMessageIDs := make([]string, 0, len(payload.Messages))
@@ -815,7 +1112,7 @@ func (s *MessageSender) sendDataSync(ctx context.Context, publicKey *ecdsa.Publi
 
 	}
 
-	hash, newMessage, err := s.sendMessageSpec(ctx, publicKey, messageSpec, messageIDs)
+	hash, newMessage, err := s.sendMessageSpec(ctx, publicKey, messageSpec, messageIDs, false)
 	if err != nil {
 		s.logger.Error("failed to send a datasync message", zap.Error(err))
 		return err
@@ -835,6 +1132,7 @@ func (s *MessageSender) sendPrivateRawMessage(ctx context.Context, rawMessage *R
 		Payload:   payload,
 		PowTarget: calculatePoW(payload),
 		PowTime:   whisperPoWTime,
+		Ephemeral: rawMessage.Ephemeral,
 	}
 	var hash []byte
 	var err error
@@ -870,11 +1168,14 @@ func (s *MessageSender) dispatchCommunityMessage(ctx context.Context, publicKey
 }
 
 // sendMessageSpec analyses the spec properties and selects a proper transport method.
-func (s *MessageSender) sendMessageSpec(ctx context.Context, publicKey *ecdsa.PublicKey, messageSpec *encryption.ProtocolMessageSpec, messageIDs [][]byte) ([]byte, *types.NewMessage, error) {
+// Ephemeral messages are not meant to be stored by store nodes.
+func (s *MessageSender) sendMessageSpec(ctx context.Context, publicKey *ecdsa.PublicKey, messageSpec *encryption.ProtocolMessageSpec, messageIDs [][]byte, ephemeral bool) ([]byte, *types.NewMessage, error) {
+	s.padMessage(messageSpec.Message)
 	newMessage, err := MessageSpecToWhisper(messageSpec)
 	if err != nil {
 		return nil, nil, err
 	}
+	newMessage.Ephemeral = ephemeral
 
 	logger := s.logger.With(zap.String("site", "sendMessageSpec"))
 
@@ -230,3 +230,29 @@ func (s *MessageSenderSuite) TestHandleDecodedMessagesDatasyncEncrypted() {
 	s.Require().True(proto.Equal(&s.testMessage, &parsedMessage))
 	s.Require().Equal(protobuf.ApplicationMetadataMessage_CHAT_MESSAGE, decodedMessages[0].Type)
 }
+
+func (s *MessageSenderSuite) TestSenderKeyAcksAreBatched() {
+	s.sender.featureFlags.Datasync = true
+
+	alice, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bob, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	for _, id := range []string{"message-1", "message-2"} {
+		s.sender.acknowledgeSenderKeyMessage(&v1protocol.StatusMessage{
+			ID:                      []byte(id),
+			TransportLayerSigPubKey: &alice.PublicKey,
+			SenderKey:               true,
+		})
+	}
+
+	s.Require().Empty(s.sender.popSenderKeyAcks(&bob.PublicKey))
+
+	acks := s.sender.popSenderKeyAcks(&alice.PublicKey)
+	s.Require().Equal([][]byte{
+		GroupMessageAckID([]byte("message-1"), &s.sender.identity.PublicKey),
+		GroupMessageAckID([]byte("message-2"), &s.sender.identity.PublicKey),
+	}, acks)
+	s.Require().Empty(s.sender.popSenderKeyAcks(&alice.PublicKey))
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -128,3 +129,52 @@ func TestPrepareSimplifiedText(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "hey "+canonicalName1+" "+canonicalName2, simplifiedText)
 }
+
+func TestVideoMessageJSONRoundTrip(t *testing.T) {
+	message := &Message{}
+	message.ContentType = protobuf.ChatMessage_VIDEO
+	message.Payload = &protobuf.ChatMessage_Video{
+		Video: &protobuf.VideoMessage{
+			File:       &protobuf.FileMessage{Name: "video.mp4", MimeType: "video/mp4", Size: 1024},
+			DurationMs: 1500,
+			Width:      640,
+			Height:     480,
+		},
+	}
+
+	data, err := json.Marshal(message)
+	require.NoError(t, err)
+
+	var decoded Message
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, protobuf.ChatMessage_VIDEO, decoded.ContentType)
+
+	video := decoded.GetVideo()
+	require.NotNil(t, video)
+	require.Equal(t, uint64(1500), video.DurationMs)
+	require.Equal(t, uint32(640), video.Width)
+	require.Equal(t, uint32(480), video.Height)
+	require.Equal(t, "video/mp4", video.File.MimeType)
+	require.Equal(t, uint64(1024), video.File.Size)
+}
+
+func TestFileMessageJSONRoundTrip(t *testing.T) {
+	message := &Message{}
+	message.ContentType = protobuf.ChatMessage_FILE
+	message.Payload = &protobuf.ChatMessage_File{
+		File: &protobuf.FileMessage{Name: "document.pdf", MimeType: "application/pdf", Size: 1024, Hash: []byte{0xde, 0xad}},
+	}
+
+	data, err := json.Marshal(message)
+	require.NoError(t, err)
+
+	var decoded Message
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	file := decoded.GetFile()
+	require.NotNil(t, file)
+	require.Equal(t, "document.pdf", file.Name)
+	require.Equal(t, "application/pdf", file.MimeType)
+	require.Equal(t, uint64(1024), file.Size)
+	require.Equal(t, []byte{0xde, 0xad}, file.Hash)
+}
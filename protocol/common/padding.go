@@ -0,0 +1,71 @@
+package common
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/encryption"
+)
+
+// DefaultPaddingBuckets are the sizes, in bytes, encrypted payloads are padded to.
+// Larger buckets leak less about the size of a message, at the cost of bandwidth.
+var DefaultPaddingBuckets = []int{512, 1024, 2048, 4096, 8192, 16384, 32768, 65536}
+
+// paddingFieldOverhead is the size of the tag of the padding field of a ProtocolMessage
+const paddingFieldOverhead = 2
+
+// paddingTargets returns the sizes a payload of the given size can be padded to, in
+// order of preference. Payloads larger than the largest bucket are padded to a
+// multiple of it.
+func paddingTargets(size int, buckets []int) []int {
+	var targets []int
+	for _, bucket := range buckets {
+		if bucket >= size {
+			targets = append(targets, bucket)
+		}
+	}
+
+	if len(buckets) != 0 {
+		largest := buckets[len(buckets)-1]
+		multiple := (size/largest + 1) * largest
+		targets = append(targets, multiple, multiple+largest)
+	}
+
+	return targets
+}
+
+// paddingLength returns the length of the padding field that makes a ProtocolMessage
+// of the given size exactly target bytes long, or false if there is none
+func paddingLength(size int, target int) (int, bool) {
+	if size == target {
+		return 0, true
+	}
+
+	for lengthSize := 1; lengthSize <= 4; lengthSize++ {
+		length := target - size - paddingFieldOverhead - lengthSize
+		if length > 0 && proto.SizeVarint(uint64(length)) == lengthSize {
+			return length, true
+		}
+	}
+
+	return 0, false
+}
+
+// padProtocolMessage pads message to the smallest of the buckets its encoded size fits in,
+// never exceeding maxSize. The padding is a protobuf field, which is ignored on receipt.
+func padProtocolMessage(message *encryption.ProtocolMessage, buckets []int, maxSize int) {
+	message.Padding = nil
+	size := proto.Size(message)
+
+	for _, target := range paddingTargets(size, buckets) {
+		if maxSize != 0 && target > maxSize {
+			return
+		}
+
+		if length, ok := paddingLength(size, target); ok {
+			if length != 0 {
+				message.Padding = make([]byte, length)
+			}
+			return
+		}
+	}
+}
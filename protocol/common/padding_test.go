@@ -0,0 +1,47 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/encryption"
+)
+
+func TestPadProtocolMessage(t *testing.T) {
+	buckets := []int{256, 512, 1024}
+
+	for size := 0; size < 3000; size++ {
+		payload := make([]byte, size)
+		message := &encryption.ProtocolMessage{
+			InstallationId: "installation-id",
+			PublicMessage:  payload,
+		}
+		padProtocolMessage(message, buckets, 0)
+
+		encoded, err := proto.Marshal(message)
+		require.NoError(t, err)
+
+		paddedSize := len(encoded)
+		if paddedSize <= 1024 {
+			require.Contains(t, buckets, paddedSize, "payload of %d bytes", size)
+		} else {
+			require.Zero(t, paddedSize%1024, "payload of %d bytes", size)
+		}
+
+		var decoded encryption.ProtocolMessage
+		require.NoError(t, proto.Unmarshal(encoded, &decoded))
+		require.True(t, bytes.Equal(payload, decoded.PublicMessage))
+	}
+}
+
+func TestPadProtocolMessageMaxSize(t *testing.T) {
+	message := &encryption.ProtocolMessage{PublicMessage: make([]byte, 600)}
+	padProtocolMessage(message, DefaultPaddingBuckets, 1000)
+	require.Nil(t, message.Padding)
+
+	padProtocolMessage(message, DefaultPaddingBuckets, 2000)
+	require.Equal(t, 1024, proto.Size(message))
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"crypto/ecdsa"
 
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -20,8 +21,10 @@ type RawMessage struct {
 	ID                    string
 	LocalChatID           string
 	LastSent              uint64
+	FirstSent             uint64
 	SendCount             int
 	Sent                  bool
+	Failed                bool
 	ResendAutomatically   bool
 	SkipEncryption        bool
 	SendPushNotification  bool
@@ -33,5 +36,36 @@ type RawMessage struct {
 	SendOnPersonalTopic   bool
 	CommunityID           []byte
 	CommunityKeyExMsgType CommKeyExMsgType
+	HashRatchetGroupID    []byte
 	Ephemeral             bool
+	// Installations, when set, are the only installations of the recipients
+	// private messages are encrypted for
+	Installations []string
+	// SkipInstallations are the installations of the recipients private messages
+	// are not encrypted for
+	SkipInstallations []string
+}
+
+// installationsFilter returns which installations of a recipient the message is
+// encrypted for, or nil if it is encrypted for all of them
+func (r *RawMessage) installationsFilter() func(*multidevice.Installation) bool {
+	if len(r.Installations) == 0 && len(r.SkipInstallations) == 0 {
+		return nil
+	}
+
+	return func(installation *multidevice.Installation) bool {
+		if len(r.Installations) != 0 && !stringsContain(r.Installations, installation.ID) {
+			return false
+		}
+		return !stringsContain(r.SkipInstallations, installation.ID)
+	}
+}
+
+func stringsContain(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/gob"
+	"strings"
 	"time"
 
 	"github.com/status-im/status-go/eth-node/crypto"
@@ -21,6 +22,9 @@ type RawMessageConfirmation struct {
 	PublicKey []byte
 	// ConfirmedAt is the unix timestamp in seconds of when the message was confirmed
 	ConfirmedAt int64
+	// SentAt is the unix timestamp in seconds of when the message was sent, only set for
+	// group messages encrypted with a sender key, which are acknowledged outside of datasync
+	SentAt int64
 }
 
 type RawMessagesPersistence struct {
@@ -60,9 +64,13 @@ func (db RawMessagesPersistence) SaveRawMessage(message *RawMessage) error {
 	           send_push_notification,
 		   skip_group_message_wrap,
 		   send_on_personal_topic,
-		   payload
+		   payload,
+		   first_sent,
+		   failed,
+		   installations,
+		   skip_installations
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		message.ID,
 		message.LocalChatID,
 		message.LastSent,
@@ -75,7 +83,11 @@ func (db RawMessagesPersistence) SaveRawMessage(message *RawMessage) error {
 		message.SendPushNotification,
 		message.SkipGroupMessageWrap,
 		message.SendOnPersonalTopic,
-		message.Payload)
+		message.Payload,
+		message.FirstSent,
+		message.Failed,
+		strings.Join(message.Installations, ","),
+		strings.Join(message.SkipInstallations, ","))
 	return err
 }
 
@@ -84,6 +96,8 @@ func (db RawMessagesPersistence) RawMessageByID(id string) (*RawMessage, error)
 	var encodedRecipients []byte
 	var skipGroupMessageWrap sql.NullBool
 	var sendOnPersonalTopic sql.NullBool
+	var installations string
+	var skipInstallations string
 	message := &RawMessage{}
 
 	err := db.db.QueryRow(`
@@ -100,7 +114,11 @@ func (db RawMessagesPersistence) RawMessageByID(id string) (*RawMessage, error)
 		          send_push_notification,
 			  skip_group_message_wrap,
 			  send_on_personal_topic,
-		          payload
+		          payload,
+			  first_sent,
+			  failed,
+			  installations,
+			  skip_installations
 			FROM
 				raw_messages
 			WHERE
@@ -120,11 +138,23 @@ func (db RawMessagesPersistence) RawMessageByID(id string) (*RawMessage, error)
 		&skipGroupMessageWrap,
 		&sendOnPersonalTopic,
 		&message.Payload,
+		&message.FirstSent,
+		&message.Failed,
+		&installations,
+		&skipInstallations,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(installations) != 0 {
+		message.Installations = strings.Split(installations, ",")
+	}
+
+	if len(skipInstallations) != 0 {
+		message.SkipInstallations = strings.Split(skipInstallations, ",")
+	}
+
 	if rawPubKeys != nil {
 		// Restore recipients
 		decoder := gob.NewDecoder(bytes.NewBuffer(encodedRecipients))
@@ -236,19 +266,71 @@ func (db RawMessagesPersistence) MarkAsConfirmed(dataSyncID []byte, atLeastOne b
 	return
 }
 
+// PendingConfirmationMessageIDs returns the ids of the last limit messages sent to
+// publicKey that have not been confirmed yet, most recent first
+func (db RawMessagesPersistence) PendingConfirmationMessageIDs(publicKey []byte, limit int) ([]string, error) {
+	var ids []string
+
+	rows, err := db.db.Query(`SELECT message_id FROM raw_message_confirmations WHERE public_key = ? GROUP BY message_id HAVING MAX(confirmed_at) = 0 ORDER BY MAX(rowid) DESC LIMIT ?`, publicKey, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id []byte
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, types.EncodeHex(id))
+	}
+
+	return ids, nil
+}
+
 func (db RawMessagesPersistence) InsertPendingConfirmation(confirmation *RawMessageConfirmation) error {
 
 	_, err := db.db.Exec(`INSERT INTO raw_message_confirmations
-		 (datasync_id, message_id, public_key)
+		 (datasync_id, message_id, public_key, sent_at)
 		 VALUES
-		 (?,?,?)`,
+		 (?,?,?,?)`,
 		confirmation.DataSyncID,
 		confirmation.MessageID,
 		confirmation.PublicKey,
+		confirmation.SentAt,
 	)
 	return err
 }
 
+// PendingSenderKeyConfirmations returns the confirmations of group messages encrypted
+// with a sender key, sent before sentBefore, that have not been acknowledged yet
+func (db RawMessagesPersistence) PendingSenderKeyConfirmations(sentBefore int64) ([]*RawMessageConfirmation, error) {
+	var confirmations []*RawMessageConfirmation
+
+	rows, err := db.db.Query(`SELECT datasync_id, message_id, public_key, sent_at FROM raw_message_confirmations WHERE confirmed_at = 0 AND sent_at != 0 AND sent_at < ?`, sentBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		confirmation := &RawMessageConfirmation{}
+		if err := rows.Scan(&confirmation.DataSyncID, &confirmation.MessageID, &confirmation.PublicKey, &confirmation.SentAt); err != nil {
+			return nil, err
+		}
+		confirmations = append(confirmations, confirmation)
+	}
+
+	return confirmations, nil
+}
+
+// MessageDeliveryCount returns the number of recipients who acknowledged the message,
+// and the number of recipients it was sent to with datasync
+func (db RawMessagesPersistence) MessageDeliveryCount(messageID []byte) (delivered uint32, recipients uint32, err error) {
+	err = db.db.QueryRow(`SELECT COUNT(CASE WHEN confirmed_at > 0 THEN 1 END), COUNT(*) FROM raw_message_confirmations WHERE message_id = ?`, messageID).Scan(&delivered, &recipients)
+	return
+}
+
 func (db RawMessagesPersistence) SaveHashRatchetMessage(groupID []byte, keyID uint32, m *types.Message) error {
 	_, err := db.db.Exec(`INSERT INTO hash_ratchet_encrypted_messages(hash, sig, TTL, timestamp, topic, payload, dst, p2p, group_id, key_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, m.Hash, m.Sig, m.TTL, m.Timestamp, types.TopicTypeToByteArray(m.Topic), m.Payload, m.Dst, m.P2P, groupID, keyID)
 	return err
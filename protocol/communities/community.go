@@ -112,12 +112,16 @@ func (o *Community) MarshalPublicAPIJSON() ([]byte, error) {
 		CommunityAdminSettings CommunityAdminSettings          `json:"adminSettings"`
 		Encrypted              bool                            `json:"encrypted"`
 		BanList                []string                        `json:"banList"`
+		CustomEmojis           []*CustomEmoji                  `json:"customEmojis,omitempty"`
+		Shard                  uint32                          `json:"shard"`
 	}{
-		ID:         o.ID(),
-		Verified:   o.config.Verified,
-		Chats:      make(map[string]CommunityChat),
-		Categories: make(map[string]CommunityCategory),
-		Tags:       o.Tags(),
+		ID:           o.ID(),
+		Verified:     o.config.Verified,
+		Chats:        make(map[string]CommunityChat),
+		Categories:   make(map[string]CommunityCategory),
+		Tags:         o.Tags(),
+		CustomEmojis: o.CustomEmojis(),
+		Shard:        o.Shard(),
 	}
 	if o.config.CommunityDescription != nil {
 		for id, c := range o.config.CommunityDescription.Categories {
@@ -210,6 +214,8 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		CommunityAdminSettings      CommunityAdminSettings               `json:"adminSettings"`
 		Encrypted                   bool                                 `json:"encrypted"`
 		BanList                     []string                             `json:"banList"`
+		CustomEmojis                []*CustomEmoji                       `json:"customEmojis,omitempty"`
+		Shard                       uint32                               `json:"shard"`
 	}{
 		ID:                          o.ID(),
 		Admin:                       o.IsAdmin(),
@@ -227,6 +233,8 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		Muted:                       o.config.Muted,
 		Tags:                        o.Tags(),
 		Encrypted:                   o.Encrypted(),
+		CustomEmojis:                o.CustomEmojis(),
+		Shard:                       o.Shard(),
 	}
 	if o.config.CommunityDescription != nil {
 		for id, c := range o.config.CommunityDescription.Categories {
@@ -690,6 +698,69 @@ func (o *Community) IsMemberInChat(pk *ecdsa.PublicKey, chatID string) bool {
 	return ok
 }
 
+// ChatEncrypted returns whether the messages of a chat are encrypted with a key
+// that is only distributed to the members that can access it
+func (o *Community) ChatEncrypted(chatID string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	chat, ok := o.config.CommunityDescription.Chats[chatID]
+	return ok && chat.Permissions != nil && chat.Permissions.Private
+}
+
+// ChatHashRatchetGroupID returns the id of the hash ratchet used to encrypt the messages of a private chat
+func (o *Community) ChatHashRatchetGroupID(chatID string) []byte {
+	return crypto.Keccak256([]byte(o.IDString() + chatID))
+}
+
+// ChatMemberPubkeys returns the public keys of the members that can access a chat
+func (o *Community) ChatMemberPubkeys(chatID string) []*ecdsa.PublicKey {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	chat, ok := o.config.CommunityDescription.Chats[chatID]
+	if !ok {
+		return nil
+	}
+
+	var pubkeys []*ecdsa.PublicKey
+	for hex := range o.config.CommunityDescription.Members {
+		pk, err := common.HexToPubkey(hex)
+		if err != nil {
+			continue
+		}
+		if o.canAccessChat(pk, chat) {
+			pubkeys = append(pubkeys, pk)
+		}
+	}
+	return pubkeys
+}
+
+// canAccessChat returns whether pk is a member of the chat, or has one of the roles it's restricted to
+func (o *Community) canAccessChat(pk *ecdsa.PublicKey, chat *protobuf.CommunityChat) bool {
+	if common.IsPubKeyEqual(pk, o.config.ID) {
+		return true
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return false
+	}
+
+	if _, ok := chat.Members[common.PubkeyToHex(pk)]; ok {
+		return true
+	}
+
+	// Members with all the roles, like the owner, can access any chat
+	roles := map[protobuf.CommunityMember_Roles]bool{protobuf.CommunityMember_ROLE_ALL: true}
+	if chat.Permissions != nil {
+		for _, role := range chat.Permissions.Roles {
+			roles[role] = true
+		}
+	}
+	return o.hasMemberPermission(member, roles)
+}
+
 func (o *Community) RemoveUserFromChat(pk *ecdsa.PublicKey, chatID string) (*protobuf.CommunityDescription, error) {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -1415,6 +1486,11 @@ func (o *Community) CanPost(pk *ecdsa.PublicKey, chatID string, grantBytes []byt
 		return false, nil
 	}
 
+	// private chats can only be accessed by their members
+	if chat.Permissions != nil && chat.Permissions.Private {
+		return o.canAccessChat(pk, chat), nil
+	}
+
 	// If both the chat & the org have no permissions, the user is allowed to post
 	if o.config.CommunityDescription.Permissions.Access == protobuf.CommunityPermissions_NO_MEMBERSHIP && chat.Permissions.Access == protobuf.CommunityPermissions_NO_MEMBERSHIP {
 		return true, nil
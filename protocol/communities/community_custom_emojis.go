@@ -0,0 +1,136 @@
+package communities
+
+import (
+	"regexp"
+
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// MaxCustomEmojis is the maximum number of custom emojis of a community,
+	// they are carried in the community description so they need to stay small
+	MaxCustomEmojis = 50
+	// MaxCustomEmojiSize is the maximum size in bytes of the image of a custom emoji
+	MaxCustomEmojiSize = 16 * 1024
+	// MaxCustomEmojisTotalSize is the maximum size in bytes of all the images of
+	// the custom emojis, kept well below the envelope limit so that the rest of
+	// the community description still fits
+	MaxCustomEmojisTotalSize = 128 * 1024
+)
+
+var customEmojiNameRegexp = regexp.MustCompile(`^[a-z0-9_]{2,32}$`)
+
+// CustomEmoji is a community defined emoji that members can react with
+type CustomEmoji struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// URL is where the emoji is served by the media server
+	URL string `json:"url,omitempty"`
+}
+
+func validateCommunityCustomEmoji(emoji *protobuf.CommunityCustomEmoji) error {
+	if emoji == nil || !customEmojiNameRegexp.MatchString(emoji.Name) {
+		return ErrInvalidCustomEmojiName
+	}
+
+	if len(emoji.Payload) == 0 || len(emoji.Payload) > MaxCustomEmojiSize {
+		return ErrInvalidCustomEmojiImage
+	}
+
+	if images.GetType(emoji.Payload) == images.UNKNOWN {
+		return ErrInvalidCustomEmojiImage
+	}
+
+	return nil
+}
+
+func customEmojisTotalSize(emojis map[string]*protobuf.CommunityCustomEmoji) int {
+	total := 0
+	for _, e := range emojis {
+		total += len(e.Payload)
+	}
+	return total
+}
+
+func (o *Community) AddCustomEmoji(emojiID string, emoji *protobuf.CommunityCustomEmoji) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if err := validateCommunityCustomEmoji(emoji); err != nil {
+		return nil, err
+	}
+
+	if o.config.CommunityDescription.CustomEmojis == nil {
+		o.config.CommunityDescription.CustomEmojis = make(map[string]*protobuf.CommunityCustomEmoji)
+	}
+
+	if len(o.config.CommunityDescription.CustomEmojis) >= MaxCustomEmojis {
+		return nil, ErrTooManyCustomEmojis
+	}
+
+	if customEmojisTotalSize(o.config.CommunityDescription.CustomEmojis)+len(emoji.Payload) > MaxCustomEmojisTotalSize {
+		return nil, ErrCustomEmojisTooLarge
+	}
+
+	for _, e := range o.config.CommunityDescription.CustomEmojis {
+		if e.Name == emoji.Name {
+			return nil, ErrCustomEmojiAlreadyExists
+		}
+	}
+
+	o.config.CommunityDescription.CustomEmojis[emojiID] = emoji
+	o.increaseClock()
+
+	return o.emptyCommunityChanges(), nil
+}
+
+func (o *Community) RemoveCustomEmoji(emojiID string) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if _, ok := o.config.CommunityDescription.CustomEmojis[emojiID]; !ok {
+		return nil, ErrCustomEmojiNotFound
+	}
+
+	delete(o.config.CommunityDescription.CustomEmojis, emojiID)
+	o.increaseClock()
+
+	return o.emptyCommunityChanges(), nil
+}
+
+func (o *Community) HasCustomEmoji(emojiID string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription == nil {
+		return false
+	}
+
+	_, ok := o.config.CommunityDescription.CustomEmojis[emojiID]
+	return ok
+}
+
+// CustomEmojis returns the custom emojis of the community, without their images
+func (o *Community) CustomEmojis() []*CustomEmoji {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription == nil {
+		return nil
+	}
+
+	var result []*CustomEmoji
+	for id, e := range o.config.CommunityDescription.CustomEmojis {
+		result = append(result, &CustomEmoji{ID: id, Name: e.Name})
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package communities
+
+import (
+	"fmt"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var testCustomEmojiPayload = []byte("GIF89a")
+
+func (s *CommunitySuite) TestAddCustomEmoji() {
+	emojiID := "emoji-id"
+	emoji := &protobuf.CommunityCustomEmoji{Name: "party_parrot", Payload: testCustomEmojiPayload}
+
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = nil
+
+	_, err := org.AddCustomEmoji(emojiID, emoji)
+	s.Require().Equal(ErrNotAdmin, err)
+
+	org.config.PrivateKey = s.identity
+
+	_, err = org.AddCustomEmoji(emojiID, &protobuf.CommunityCustomEmoji{Name: "Party Parrot", Payload: testCustomEmojiPayload})
+	s.Require().Equal(ErrInvalidCustomEmojiName, err)
+
+	_, err = org.AddCustomEmoji(emojiID, &protobuf.CommunityCustomEmoji{Name: "party_parrot", Payload: []byte("not an image")})
+	s.Require().Equal(ErrInvalidCustomEmojiImage, err)
+
+	_, err = org.AddCustomEmoji(emojiID, &protobuf.CommunityCustomEmoji{Name: "party_parrot", Payload: make([]byte, MaxCustomEmojiSize+1)})
+	s.Require().Equal(ErrInvalidCustomEmojiImage, err)
+
+	changes, err := org.AddCustomEmoji(emojiID, emoji)
+	s.Require().NoError(err)
+	s.Require().NotNil(changes)
+	s.Require().Equal(uint64(2), org.config.CommunityDescription.Clock)
+	s.Require().True(org.HasCustomEmoji(emojiID))
+
+	emojis := org.CustomEmojis()
+	s.Require().Len(emojis, 1)
+	s.Require().Equal(emojiID, emojis[0].ID)
+	s.Require().Equal("party_parrot", emojis[0].Name)
+
+	_, err = org.AddCustomEmoji("other-emoji-id", emoji)
+	s.Require().Equal(ErrCustomEmojiAlreadyExists, err)
+
+	for i := 1; i < MaxCustomEmojis; i++ {
+		_, err = org.AddCustomEmoji(fmt.Sprintf("emoji-id-%d", i), &protobuf.CommunityCustomEmoji{
+			Name:    fmt.Sprintf("emoji_%d", i),
+			Payload: testCustomEmojiPayload,
+		})
+		s.Require().NoError(err)
+	}
+
+	_, err = org.AddCustomEmoji("one-too-many", &protobuf.CommunityCustomEmoji{Name: "one_too_many", Payload: testCustomEmojiPayload})
+	s.Require().Equal(ErrTooManyCustomEmojis, err)
+}
+
+func (s *CommunitySuite) TestRemoveCustomEmoji() {
+	emojiID := "emoji-id"
+
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = s.identity
+
+	_, err := org.RemoveCustomEmoji(emojiID)
+	s.Require().Equal(ErrCustomEmojiNotFound, err)
+
+	_, err = org.AddCustomEmoji(emojiID, &protobuf.CommunityCustomEmoji{Name: "party_parrot", Payload: testCustomEmojiPayload})
+	s.Require().NoError(err)
+
+	org.config.PrivateKey = nil
+	_, err = org.RemoveCustomEmoji(emojiID)
+	s.Require().Equal(ErrNotAdmin, err)
+
+	org.config.PrivateKey = s.identity
+	_, err = org.RemoveCustomEmoji(emojiID)
+	s.Require().NoError(err)
+	s.Require().False(org.HasCustomEmoji(emojiID))
+	s.Require().Empty(org.CustomEmojis())
+}
+
+func (s *CommunitySuite) TestCustomEmojisTotalSizeIsCapped() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = s.identity
+
+	payload := make([]byte, MaxCustomEmojiSize)
+	copy(payload, testCustomEmojiPayload)
+
+	count := MaxCustomEmojisTotalSize / MaxCustomEmojiSize
+	for i := 0; i < count; i++ {
+		_, err := org.AddCustomEmoji(fmt.Sprintf("emoji-id-%d", i), &protobuf.CommunityCustomEmoji{
+			Name:    fmt.Sprintf("emoji_%d", i),
+			Payload: payload,
+		})
+		s.Require().NoError(err)
+	}
+
+	_, err := org.AddCustomEmoji("one-too-large", &protobuf.CommunityCustomEmoji{Name: "one_too_large", Payload: testCustomEmojiPayload})
+	s.Require().Equal(ErrCustomEmojisTooLarge, err)
+
+	description := org.config.CommunityDescription
+	description.CustomEmojis["one-too-large"] = &protobuf.CommunityCustomEmoji{Name: "one_too_large", Payload: testCustomEmojiPayload}
+	s.Require().Equal(ErrCustomEmojisTooLarge, ValidateCommunityDescription(description))
+}
@@ -0,0 +1,70 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestChatEncrypted() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	s.Require().False(org.ChatEncrypted(testChatID1))
+	s.Require().False(org.ChatEncrypted("unknown-chat-id"))
+
+	org.config.CommunityDescription.Chats[testChatID1].Permissions.Private = true
+	s.Require().True(org.ChatEncrypted(testChatID1))
+
+	groupID := org.ChatHashRatchetGroupID(testChatID1)
+	s.Require().Equal(crypto.Keccak256([]byte(org.IDString()+testChatID1)), groupID)
+
+	other := s.buildCommunity(&s.member3.PublicKey)
+	s.Require().NotEqual(groupID, other.ChatHashRatchetGroupID(testChatID1))
+}
+
+func (s *CommunitySuite) TestChatMemberPubkeys() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	chat := org.config.CommunityDescription.Chats[testChatID1]
+	chat.Permissions.Private = true
+
+	pubkeys := org.ChatMemberPubkeys(testChatID1)
+	s.Require().Len(pubkeys, 1)
+	s.Require().Equal(s.member1Key, common.PubkeyToHex(pubkeys[0]))
+
+	// Members having one of the roles of the chat can access it too
+	chat.Permissions.Roles = []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}
+	org.config.CommunityDescription.Members[s.member2Key].Roles = []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}
+
+	pubkeys = org.ChatMemberPubkeys(testChatID1)
+	s.Require().Len(pubkeys, 2)
+
+	s.Require().Empty(org.ChatMemberPubkeys("unknown-chat-id"))
+}
+
+func (s *CommunitySuite) TestCanPostPrivateChat() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	chat := org.config.CommunityDescription.Chats[testChatID1]
+	chat.Permissions.Access = protobuf.CommunityPermissions_NO_MEMBERSHIP
+	org.config.CommunityDescription.Permissions.Access = protobuf.CommunityPermissions_NO_MEMBERSHIP
+
+	canPost, err := org.CanPost(&s.member2.PublicKey, testChatID1, nil)
+	s.Require().NoError(err)
+	s.Require().True(canPost)
+
+	chat.Permissions.Private = true
+
+	canPost, err = org.CanPost(&s.member1.PublicKey, testChatID1, nil)
+	s.Require().NoError(err)
+	s.Require().True(canPost)
+
+	canPost, err = org.CanPost(&s.member2.PublicKey, testChatID1, nil)
+	s.Require().NoError(err)
+	s.Require().False(canPost)
+
+	canPost, err = org.CanPost(&s.member3.PublicKey, testChatID1, nil)
+	s.Require().NoError(err)
+	s.Require().False(canPost)
+
+	canPost, err = org.CanPost(&s.identity.PublicKey, testChatID1, nil)
+	s.Require().NoError(err)
+	s.Require().True(canPost)
+}
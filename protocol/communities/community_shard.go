@@ -0,0 +1,63 @@
+package communities
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// NumShards is the number of Waku v2 shards communities are spread over
+	NumShards = 8
+	// shardsClusterID is the cluster of the static sharding pubsub topics used by communities
+	shardsClusterID = 16
+)
+
+// ShardPubsubTopic returns the Waku v2 pubsub topic of a shard
+func ShardPubsubTopic(shard uint32) string {
+	return fmt.Sprintf("/waku/2/rs/%d/%d", shardsClusterID, shard)
+}
+
+// DeriveShard returns the shard of a community with the given id, when the owner
+// hasn't set one
+func DeriveShard(communityID []byte) uint32 {
+	hash := crypto.Keccak256(communityID)
+	return binary.BigEndian.Uint32(hash[:4]) % NumShards
+}
+
+// Shard returns the shard the traffic of the community chats is relayed on
+func (o *Community) Shard() uint32 {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription != nil && o.config.CommunityDescription.Shard != nil {
+		return o.config.CommunityDescription.Shard.Index
+	}
+	return DeriveShard(o.ID())
+}
+
+// PubsubTopic returns the Waku v2 pubsub topic of the community chats
+func (o *Community) PubsubTopic() string {
+	return ShardPubsubTopic(o.Shard())
+}
+
+// SetShard moves the community to shard, or back to the shard derived from its id if nil
+func (o *Community) SetShard(shard *protobuf.CommunityShard) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if shard != nil && shard.Index >= NumShards {
+		return nil, ErrInvalidCommunityShard
+	}
+
+	o.config.CommunityDescription.Shard = shard
+	o.increaseClock()
+
+	return o.emptyCommunityChanges(), nil
+}
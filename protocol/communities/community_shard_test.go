@@ -0,0 +1,46 @@
+package communities
+
+import (
+	"fmt"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestShard() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+
+	derived := DeriveShard(org.ID())
+	s.Require().Less(derived, uint32(NumShards))
+	s.Require().Equal(derived, org.Shard())
+	s.Require().Equal(ShardPubsubTopic(derived), org.PubsubTopic())
+
+	org.config.PrivateKey = nil
+	_, err := org.SetShard(&protobuf.CommunityShard{Index: 1})
+	s.Require().Equal(ErrNotAdmin, err)
+
+	org.config.PrivateKey = s.identity
+
+	_, err = org.SetShard(&protobuf.CommunityShard{Index: NumShards})
+	s.Require().Equal(ErrInvalidCommunityShard, err)
+
+	shard := (derived + 1) % NumShards
+	changes, err := org.SetShard(&protobuf.CommunityShard{Index: shard})
+	s.Require().NoError(err)
+	s.Require().NotNil(changes)
+	s.Require().Equal(uint64(2), org.config.CommunityDescription.Clock)
+	s.Require().Equal(shard, org.Shard())
+	s.Require().Equal(fmt.Sprintf("/waku/2/rs/16/%d", shard), org.PubsubTopic())
+
+	_, err = org.SetShard(nil)
+	s.Require().NoError(err)
+	s.Require().Equal(derived, org.Shard())
+}
+
+func (s *CommunitySuite) TestValidateCommunityShard() {
+	description := s.buildCommunityDescription()
+	description.Shard = &protobuf.CommunityShard{Index: NumShards - 1}
+	s.Require().NoError(ValidateCommunityDescription(description))
+
+	description.Shard = &protobuf.CommunityShard{Index: NumShards}
+	s.Require().Equal(ErrInvalidCommunityShard, ValidateCommunityDescription(description))
+}
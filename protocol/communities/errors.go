@@ -29,3 +29,10 @@ var ErrAlreadyMember = errors.New("already a member")
 var ErrAlreadyJoined = errors.New("already joined")
 var ErrInvalidMessage = errors.New("invalid community description message")
 var ErrMemberNotFound = errors.New("member not found")
+var ErrInvalidCustomEmojiName = errors.New("invalid custom emoji name")
+var ErrInvalidCustomEmojiImage = errors.New("invalid custom emoji image")
+var ErrTooManyCustomEmojis = errors.New("too many custom emojis")
+var ErrCustomEmojisTooLarge = errors.New("custom emojis too large")
+var ErrCustomEmojiAlreadyExists = errors.New("custom emoji already exists")
+var ErrCustomEmojiNotFound = errors.New("custom emoji not found")
+var ErrInvalidCommunityShard = errors.New("invalid community shard")
@@ -750,6 +750,94 @@ func (m *Manager) DeleteCategory(request *requests.DeleteCommunityCategory) (*Co
 	return community, changes, nil
 }
 
+func (m *Manager) AddCustomEmoji(request *requests.AddCommunityCustomEmoji) (*Community, *CommunityChanges, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	payload, err := os.ReadFile(request.ImagePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes, err := community.AddCustomEmoji(uuid.New().String(), &protobuf.CommunityCustomEmoji{
+		Name:    request.Name,
+		Payload: payload,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, changes, nil
+}
+
+func (m *Manager) RemoveCustomEmoji(request *requests.RemoveCommunityCustomEmoji) (*Community, *CommunityChanges, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	changes, err := community.RemoveCustomEmoji(request.EmojiID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, changes, nil
+}
+
+func (m *Manager) SetShard(request *requests.SetCommunityShard) (*Community, *CommunityChanges, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	var shard *protobuf.CommunityShard
+	if request.Shard != nil {
+		shard = &protobuf.CommunityShard{Index: *request.Shard}
+	}
+
+	changes, err := community.SetShard(shard)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, changes, nil
+}
+
 func (m *Manager) HandleCommunityDescriptionMessage(signer *ecdsa.PublicKey, description *protobuf.CommunityDescription, payload []byte) (*CommunityResponse, error) {
 	id := crypto.CompressPubkey(signer)
 	community, err := m.persistence.GetByID(&m.identity.PublicKey, id)
@@ -1484,6 +1572,20 @@ func (m *Manager) IsEncrypted(communityID string) (bool, error) {
 	return community.Encrypted(), nil
 
 }
+
+// ChatHashRatchetGroupID returns the id of the hash ratchet used to encrypt the messages
+// of a chat, or nil if the chat is not private
+func (m *Manager) ChatHashRatchetGroupID(communityID string, chatID string) ([]byte, error) {
+	community, err := m.GetByIDString(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil || !community.ChatEncrypted(chatID) {
+		return nil, nil
+	}
+	return community.ChatHashRatchetGroupID(chatID), nil
+}
+
 func (m *Manager) ShouldHandleSyncCommunity(community *protobuf.SyncCommunity) (bool, error) {
 	return m.persistence.ShouldHandleSyncCommunity(community)
 }
@@ -1655,6 +1757,15 @@ func (m *Manager) GetLastMessageArchiveEndDate(communityID types.HexBytes) (uint
 	return m.persistence.GetLastMessageArchiveEndDate(communityID)
 }
 
+// GetChannelKeyRecipients returns the members the key of a private chat was last distributed to
+func (m *Manager) GetChannelKeyRecipients(chatID string) ([]string, error) {
+	return m.persistence.GetChannelKeyRecipients(chatID)
+}
+
+func (m *Manager) SaveChannelKeyRecipients(chatID string, recipients []string) error {
+	return m.persistence.SaveChannelKeyRecipients(chatID, recipients)
+}
+
 func (m *Manager) GetHistoryArchivePartitionStartTimestamp(communityID types.HexBytes) (uint64, error) {
 	filters, err := m.GetCommunityChatsFilters(communityID)
 	if err != nil {
@@ -1,6 +1,7 @@
 package communities
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"database/sql"
@@ -27,7 +28,7 @@ var ErrOldRequestToLeave = errors.New("old request to leave")
 const OR = " OR "
 const communitiesBaseQuery = `SELECT c.id, c.private_key, c.description,c.joined,c.spectated,c.verified,c.muted,r.clock FROM communities_communities c LEFT JOIN communities_requests_to_join r ON c.id = r.community_id AND r.public_key = ?`
 
-func (p *Persistence) SaveCommunity(community *Community) error {
+func (p *Persistence) SaveCommunity(community *Community) (err error) {
 	id := community.ID()
 	privateKey := community.PrivateKey()
 	description, err := community.ToBytes()
@@ -35,12 +36,90 @@ func (p *Persistence) SaveCommunity(community *Community) error {
 		return err
 	}
 
-	_, err = p.db.Exec(`INSERT INTO communities_communities (id, private_key, description, joined, spectated, verified) VALUES (?, ?, ?, ?, ?, ?)`, id, crypto.FromECDSA(privateKey), description, community.config.Joined, community.config.Spectated, community.config.Verified)
-	return err
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`INSERT INTO communities_communities (id, private_key, description, joined, spectated, verified) VALUES (?, ?, ?, ?, ?, ?)`, id, crypto.FromECDSA(privateKey), description, community.config.Joined, community.config.Spectated, community.config.Verified)
+	if err != nil {
+		return err
+	}
+
+	return saveCommunityCustomEmojis(tx, community)
+}
+
+func customEmojiHash(emoji *protobuf.CommunityCustomEmoji) []byte {
+	return crypto.Keccak256([]byte(emoji.Name), []byte{0}, emoji.Payload)
+}
+
+// saveCommunityCustomEmojis stores the custom emojis of the community separately,
+// so that the media server can serve them without decoding the community description.
+// Only the emojis added, changed or removed since they were last stored are written.
+func saveCommunityCustomEmojis(tx *sql.Tx, community *Community) error {
+	rows, err := tx.Query(`SELECT id, hash FROM communities_custom_emojis WHERE community_id = ?`, community.ID())
+	if err != nil {
+		return err
+	}
+	stored := make(map[string][]byte)
+	for rows.Next() {
+		var emojiID string
+		var hash []byte
+		if err := rows.Scan(&emojiID, &hash); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		stored[emojiID] = hash
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var emojis map[string]*protobuf.CommunityCustomEmoji
+	if community.config.CommunityDescription != nil {
+		emojis = community.config.CommunityDescription.CustomEmojis
+	}
+
+	for emojiID := range stored {
+		if _, ok := emojis[emojiID]; ok {
+			continue
+		}
+		_, err := tx.Exec(`DELETE FROM communities_custom_emojis WHERE community_id = ? AND id = ?`, community.ID(), emojiID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for emojiID, emoji := range emojis {
+		hash := customEmojiHash(emoji)
+		if bytes.Equal(stored[emojiID], hash) {
+			continue
+		}
+		_, err := tx.Exec(`INSERT INTO communities_custom_emojis (community_id, id, name, payload, hash) VALUES (?, ?, ?, ?, ?)`, community.ID(), emojiID, emoji.Name, emoji.Payload, hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (p *Persistence) DeleteCommunity(id types.HexBytes) error {
 	_, err := p.db.Exec("DELETE FROM communities_communities WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec("DELETE FROM communities_custom_emojis WHERE community_id = ?", id)
 	return err
 }
 
@@ -817,3 +896,50 @@ func (p *Persistence) GetCommunityChatIDs(communityID types.HexBytes) ([]string,
 	}
 	return ids, nil
 }
+
+func (p *Persistence) GetChannelKeyRecipients(chatID string) ([]string, error) {
+	rows, err := p.db.Query(`SELECT public_key FROM communities_channel_key_recipients WHERE chat_id = ?`, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recipients := []string{}
+	for rows.Next() {
+		recipient := ""
+		err := rows.Scan(&recipient)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
+
+func (p *Persistence) SaveChannelKeyRecipients(chatID string, recipients []string) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM communities_channel_key_recipients WHERE chat_id = ?`, chatID)
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range recipients {
+		_, err = tx.Exec(`INSERT INTO communities_channel_key_recipients (chat_id, public_key) VALUES (?, ?)`, chatID, recipient)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -72,6 +72,66 @@ func (s *PersistenceSuite) TestSaveCommunity() {
 	s.Equal(true, communities[1].Verified())
 }
 
+// customEmojiRowIDs returns the rowid of each stored custom emoji, which changes
+// when the emoji is written again
+func (s *PersistenceSuite) customEmojiRowIDs(communityID types.HexBytes) map[string]int64 {
+	rows, err := s.db.db.Query(`SELECT id, rowid FROM communities_custom_emojis WHERE community_id = ?`, communityID)
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	rowIDs := make(map[string]int64)
+	for rows.Next() {
+		var emojiID string
+		var rowID int64
+		s.Require().NoError(rows.Scan(&emojiID, &rowID))
+		rowIDs[emojiID] = rowID
+	}
+	s.Require().NoError(rows.Err())
+	return rowIDs
+}
+
+func (s *PersistenceSuite) TestSaveCommunityCustomEmojis() {
+	id, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	community := Community{
+		config: &Config{
+			PrivateKey: id,
+			ID:         &id.PublicKey,
+			CommunityDescription: &protobuf.CommunityDescription{
+				CustomEmojis: map[string]*protobuf.CommunityCustomEmoji{
+					"1": {Name: "party_parrot", Payload: testCustomEmojiPayload},
+					"2": {Name: "shipit", Payload: testCustomEmojiPayload},
+				},
+			},
+		},
+	}
+	s.Require().NoError(s.db.SaveCommunity(&community))
+	saved := s.customEmojiRowIDs(community.ID())
+	s.Require().Len(saved, 2)
+
+	// Unchanged emojis aren't written again
+	s.Require().NoError(s.db.SaveCommunity(&community))
+	s.Require().Equal(saved, s.customEmojiRowIDs(community.ID()))
+
+	emojis := community.config.CommunityDescription.CustomEmojis
+	emojis["2"] = &protobuf.CommunityCustomEmoji{Name: "ship_it", Payload: testCustomEmojiPayload}
+	delete(emojis, "1")
+	emojis["3"] = &protobuf.CommunityCustomEmoji{Name: "lgtm", Payload: testCustomEmojiPayload}
+	s.Require().NoError(s.db.SaveCommunity(&community))
+
+	updated := s.customEmojiRowIDs(community.ID())
+	s.Require().Len(updated, 2)
+	s.Require().NotContains(updated, "1")
+	s.Require().NotEqual(saved["2"], updated["2"])
+	s.Require().Contains(updated, "3")
+
+	var name string
+	err = s.db.db.QueryRow(`SELECT name FROM communities_custom_emojis WHERE community_id = ? AND id = ?`, community.ID(), "2").Scan(&name)
+	s.Require().NoError(err)
+	s.Require().Equal("ship_it", name)
+}
+
 func (s *PersistenceSuite) TestShouldHandleSyncCommunity() {
 	sc := &protobuf.SyncCommunity{
 		Id:          []byte("0x123456"),
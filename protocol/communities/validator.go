@@ -79,5 +79,23 @@ func ValidateCommunityDescription(desc *protobuf.CommunityDescription) error {
 		}
 	}
 
+	if len(desc.CustomEmojis) > MaxCustomEmojis {
+		return ErrTooManyCustomEmojis
+	}
+
+	if customEmojisTotalSize(desc.CustomEmojis) > MaxCustomEmojisTotalSize {
+		return ErrCustomEmojisTooLarge
+	}
+
+	for _, emoji := range desc.CustomEmojis {
+		if err := validateCommunityCustomEmoji(emoji); err != nil {
+			return err
+		}
+	}
+
+	if desc.Shard != nil && desc.Shard.Index >= NumShards {
+		return ErrInvalidCommunityShard
+	}
+
 	return nil
 }
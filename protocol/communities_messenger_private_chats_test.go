@@ -0,0 +1,118 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func (s *MessengerCommunitiesSuite) waitForCommunityChat(user *Messenger, chatID string) {
+	err := tt.RetryWithBackOff(func() error {
+		_, err := user.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if _, ok := user.allChats.Load(chatID); !ok {
+			return errors.New("chat not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunitiesSuite) sendToPrivateChat(chatID string, text string, recipient *Messenger, outsider *Messenger) {
+	inputMessage := &common.Message{}
+	inputMessage.ChatId = chatID
+	inputMessage.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	inputMessage.Text = text
+
+	_, err := s.admin.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		recipient,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == text {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+
+	// Members that can't access the chat don't have the key to read it
+	response, err := outsider.RetrieveAll()
+	s.Require().NoError(err)
+	for _, message := range response.Messages() {
+		s.Require().NotEqual(text, message.Text)
+	}
+}
+
+func (s *MessengerCommunitiesSuite) TestPrivateCommunityChat() {
+	community := s.createCommunity()
+
+	s.advertiseCommunityTo(community, s.bob)
+	s.advertiseCommunityTo(community, s.alice)
+	s.joinCommunity(community, s.bob)
+	s.joinCommunity(community, s.alice)
+
+	orgChat := &protobuf.CommunityChat{
+		Members: map[string]*protobuf.CommunityMember{
+			common.PubkeyToHex(&s.bob.identity.PublicKey): {},
+		},
+		Permissions: &protobuf.CommunityPermissions{
+			Access:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+			Private: true,
+		},
+		Identity: &protobuf.ChatIdentity{
+			DisplayName: "status-private",
+			Description: "status private community chat",
+		},
+	}
+
+	response, err := s.admin.CreateCommunityChat(community.ID(), orgChat)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+
+	chat := response.Chats()[0]
+	community = response.Communities()[0]
+	s.Require().True(community.ChatEncrypted(chat.CommunityChatID()))
+
+	groupID := community.ChatHashRatchetGroupID(chat.CommunityChatID())
+	keyID, err := s.admin.encryptor.GetCurrentKeyForGroup(groupID)
+	s.Require().NoError(err)
+	s.Require().NotZero(keyID)
+
+	s.waitForCommunityChat(s.bob, chat.ID)
+	s.waitForCommunityChat(s.alice, chat.ID)
+
+	// Only members of the chat can post
+	inputMessage := &common.Message{}
+	inputMessage.ChatId = chat.ID
+	inputMessage.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	inputMessage.Text = "not a member"
+	_, err = s.alice.SendChatMessage(context.Background(), inputMessage)
+	s.Require().Error(err)
+
+	s.sendToPrivateChat(chat.ID, "hello bob", s.bob, s.alice)
+
+	// Bob loses access to the chat, the key is rotated
+	orgChat.Members = map[string]*protobuf.CommunityMember{
+		common.PubkeyToHex(&s.alice.identity.PublicKey): {},
+	}
+	response, err = s.admin.EditCommunityChat(community.ID(), chat.CommunityChatID(), orgChat)
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+
+	rotatedKeyID, err := s.admin.encryptor.GetCurrentKeyForGroup(groupID)
+	s.Require().NoError(err)
+	s.Require().NotEqual(keyID, rotatedKeyID)
+
+	s.sendToPrivateChat(chat.ID, "hello alice", s.alice, s.bob)
+}
@@ -0,0 +1,83 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func (s *MessengerCommunitiesSuite) requireFilterOnPubsubTopic(user *Messenger, chatID string, pubsubTopic string) {
+	filter := user.transport.FilterByChatID(chatID)
+	s.Require().NotNil(filter)
+	s.Require().Equal(pubsubTopic, filter.PubsubTopic)
+}
+
+func (s *MessengerCommunitiesSuite) TestCommunityShard() {
+	s.admin.featureFlags.CommunitySharding = true
+	s.bob.featureFlags.CommunitySharding = true
+
+	community := s.createCommunity()
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.bob)
+
+	var chatID string
+	for id := range community.Chats() {
+		chatID = community.IDString() + id
+	}
+
+	// Chats are on the shard of the community, its control topics on the default pubsub topic
+	s.requireFilterOnPubsubTopic(s.admin, chatID, community.PubsubTopic())
+	s.requireFilterOnPubsubTopic(s.bob, chatID, community.PubsubTopic())
+	s.requireFilterOnPubsubTopic(s.bob, community.IDString(), "")
+
+	shard := (community.Shard() + 1) % communities.NumShards
+	response, err := s.admin.SetCommunityShard(&requests.SetCommunityShard{CommunityID: community.ID(), Shard: &shard})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().Equal(shard, response.Communities()[0].Shard())
+	s.requireFilterOnPubsubTopic(s.admin, chatID, communities.ShardPubsubTopic(shard))
+
+	// Members follow the community to its new shard
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if s.bob.transport.FilterByChatID(chatID).PubsubTopic != communities.ShardPubsubTopic(shard) {
+			return errors.New("community shard not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	inputMessage := &common.Message{}
+	inputMessage.ChatId = chatID
+	inputMessage.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	inputMessage.Text = "on another shard"
+
+	_, err = s.admin.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool {
+			return len(r.Messages()) > 0 && r.Messages()[0].Text == inputMessage.Text
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunitiesSuite) TestCommunityShardNotOwner() {
+	community := s.createCommunity()
+	s.advertiseCommunityTo(community, s.bob)
+
+	shard := uint32(1)
+	_, err := s.bob.SetCommunityShard(&requests.SetCommunityShard{CommunityID: community.ID(), Shard: &shard})
+	s.Require().Equal(communities.ErrNotAdmin, err)
+}
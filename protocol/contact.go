@@ -74,6 +74,9 @@ const (
 	VerificationStatusUNVERIFIED VerificationStatus = iota
 	VerificationStatusVERIFYING
 	VerificationStatusVERIFIED
+	// VerificationStatusREVERIFY is set on verified contacts that started
+	// using an unknown installation, until they are verified again
+	VerificationStatusREVERIFY
 )
 
 // Contact has information about a "Contact"
@@ -135,6 +138,10 @@ func (c Contact) IsUnverified() bool {
 	return c.VerificationStatus == VerificationStatusUNVERIFIED
 }
 
+func (c Contact) NeedsReverification() bool {
+	return c.VerificationStatus == VerificationStatusREVERIFY
+}
+
 func (c Contact) IsUntrustworthy() bool {
 	return c.TrustStatus == verification.TrustStatusUNTRUSTWORTHY
 }
@@ -4,6 +4,9 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/proto"
 
@@ -27,9 +30,85 @@ type EmojiReaction struct {
 	LocalChatID string `json:"localChatId"`
 }
 
-// ID is the Keccak256() contatenation of From-MessageID-EmojiType
+// maxEmojiLength is the maximum length in bytes of an emoji sequence, long enough
+// for ZWJ sequences such as families with skin tones
+const maxEmojiLength = 64
+
+// legacyEmojiReactions maps the emojis that had a dedicated reaction type to it,
+// so that clients that only know about the type can still display them
+var legacyEmojiReactions = map[string]protobuf.EmojiReaction_Type{
+	"❤️": protobuf.EmojiReaction_LOVE,
+	"👍":  protobuf.EmojiReaction_THUMBS_UP,
+	"👎":  protobuf.EmojiReaction_THUMBS_DOWN,
+	"😂":  protobuf.EmojiReaction_LAUGH,
+	"😢":  protobuf.EmojiReaction_SAD,
+	"😡":  protobuf.EmojiReaction_ANGRY,
+}
+
+// ID is the Keccak256() contatenation of From-MessageID-EmojiType.
+// Reactions without a type use the emoji sequence or the custom emoji ID instead.
 func (e EmojiReaction) ID() string {
-	return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%d", e.From, e.MessageId, e.Type))))
+	var key string
+	switch {
+	case e.Type != protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE:
+		key = fmt.Sprintf("%d", e.Type)
+	case e.CustomEmojiId != "":
+		key = "custom:" + e.CustomEmojiId
+	default:
+		key = e.Emoji
+	}
+	return types.EncodeHex(crypto.Keccak256([]byte(e.From + e.MessageId + key)))
+}
+
+// normalizeEmojiReaction sets the type of reactions with an emoji that has one,
+// and the emoji of reactions sent by clients that only set the type
+func normalizeEmojiReaction(e *protobuf.EmojiReaction) {
+	if e.CustomEmojiId != "" {
+		return
+	}
+
+	if e.Emoji == "" {
+		for emoji, t := range legacyEmojiReactions {
+			if t == e.Type {
+				e.Emoji = emoji
+				return
+			}
+		}
+		return
+	}
+
+	if t, ok := legacyEmojiReactions[e.Emoji]; ok {
+		e.Type = t
+	}
+}
+
+// isEmojiSequence returns whether s looks like a single emoji sequence, it doesn't
+// check the sequence is one defined by Unicode, only that it is made of emoji code points
+func isEmojiSequence(s string) bool {
+	if len(s) == 0 || len(s) > maxEmojiLength || !utf8.ValidString(s) {
+		return false
+	}
+
+	hasSymbol := false
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.So, r), r >= 0x1F000 && r <= 0x1FAFF:
+			// The emoji blocks are checked as well, as recent emojis might
+			// not be in the unicode tables of the go version we are built with
+			hasSymbol = true
+		case unicode.Is(unicode.Sk, r), unicode.Is(unicode.Me, r):
+			// Skin tone modifiers and the combining enclosing keycap
+		case r == 0x200D, r == 0xFE0E, r == 0xFE0F:
+			// Zero width joiner and variation selectors
+		case r >= 0xE0020 && r <= 0xE007F:
+			// Tags, used by subdivision flags
+		case r == '#', r == '*', r >= '0' && r <= '9':
+			// Keycap bases
+		default:
+			return false
+		}
+	}
+	return hasSymbol || strings.ContainsRune(s, 0x20E3)
 }
 
 // GetSigPubKey returns an ecdsa encoded public key
@@ -61,6 +140,8 @@ func (e EmojiReaction) MarshalJSON() ([]byte, error) {
 		MessageType protobuf.MessageType        `json:"messageType,omitempty"`
 		Retracted   bool                        `json:"retracted,omitempty"`
 		EmojiID     protobuf.EmojiReaction_Type `json:"emojiId,omitempty"`
+		Emoji       string                      `json:"emoji,omitempty"`
+		CustomEmoji string                      `json:"customEmojiId,omitempty"`
 	}{
 
 		ID:          e.ID(),
@@ -72,6 +153,8 @@ func (e EmojiReaction) MarshalJSON() ([]byte, error) {
 		MessageType: e.MessageType,
 		Retracted:   e.Retracted,
 		EmojiID:     e.Type,
+		Emoji:       e.Emoji,
+		CustomEmoji: e.CustomEmojiId,
 	}
 
 	return json.Marshal(item)
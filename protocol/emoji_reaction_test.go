@@ -0,0 +1,47 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestIsEmojiSequence(t *testing.T) {
+	for _, emoji := range []string{"🚀", "❤️", "👍🏽", "👩🏽‍🚀", "🇨🇭", "#️⃣", "🏴󠁧󠁢󠁳󠁣󠁴󠁿"} {
+		require.True(t, isEmojiSequence(emoji), emoji)
+	}
+
+	for _, notEmoji := range []string{"", "a", "1", "🚀a", "<3", "\xff"} {
+		require.False(t, isEmojiSequence(notEmoji), notEmoji)
+	}
+}
+
+func TestNormalizeEmojiReaction(t *testing.T) {
+	// Reactions sent by older clients only have a type
+	legacy := &protobuf.EmojiReaction{Type: protobuf.EmojiReaction_THUMBS_UP}
+	normalizeEmojiReaction(legacy)
+	require.Equal(t, "👍", legacy.Emoji)
+
+	// Emojis with a legacy type are sent with it, so older clients can display them
+	unicode := &protobuf.EmojiReaction{Emoji: "👍"}
+	normalizeEmojiReaction(unicode)
+	require.Equal(t, protobuf.EmojiReaction_THUMBS_UP, unicode.Type)
+
+	custom := &protobuf.EmojiReaction{CustomEmojiId: "emoji-id"}
+	normalizeEmojiReaction(custom)
+	require.Empty(t, custom.Emoji)
+	require.Equal(t, protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE, custom.Type)
+}
+
+func TestEmojiReactionIDLegacyCompatibility(t *testing.T) {
+	legacy := EmojiReaction{EmojiReaction: protobuf.EmojiReaction{MessageId: "message-id", Type: protobuf.EmojiReaction_LOVE}, From: "from"}
+	unicode := EmojiReaction{EmojiReaction: protobuf.EmojiReaction{MessageId: "message-id", Emoji: "❤️"}, From: "from"}
+	normalizeEmojiReaction(&unicode.EmojiReaction)
+
+	require.Equal(t, legacy.ID(), unicode.ID())
+
+	other := EmojiReaction{EmojiReaction: protobuf.EmojiReaction{MessageId: "message-id", Emoji: "🚀"}, From: "from"}
+	require.NotEqual(t, legacy.ID(), other.ID())
+}
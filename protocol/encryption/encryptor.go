@@ -344,6 +344,15 @@ func (s *encryptor) DecryptPayload(myIdentityKey *ecdsa.PrivateKey, theirIdentit
 	return nil, errors.New("no key specified")
 }
 
+// ResetSession deletes the sessions with the given installation of theirIdentityKey,
+// or with all of their installations if installationID is empty
+func (s *encryptor) ResetSession(theirIdentityKey *ecdsa.PublicKey, installationID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.persistence.DeleteRatchetInfo(crypto.CompressPubkey(theirIdentityKey), installationID)
+}
+
 func (s *encryptor) createNewSession(drInfo *RatchetInfo, sk []byte, keyPair crypto.DHPair) (dr.Session, error) {
 	var err error
 	var session dr.Session
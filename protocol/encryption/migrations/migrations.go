@@ -19,6 +19,9 @@
 // 1632236298_add_communities.down.sql (151B)
 // 1632236298_add_communities.up.sql (584B)
 // 1636536507_add_index_bundles.up.sql (347B)
+// 1674300005_add_sender_keys.up.sql (130B)
+// 1674300007_add_installations_revoked.up.sql (77B)
+// 1674300008_add_installations_capabilities.up.sql (76B)
 // doc.go (377B)
 
 package migrations
@@ -468,6 +471,66 @@ func _1636536507_add_index_bundlesUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1674300005_add_sender_keysUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\xcb\xb1\x0e\xc2\x20\x14\x05\xd0\x9d\xaf\xb8\xa3\x26\xfe\x81\x13\x90\x67\xd2\xf8\x84\x86\xe0\xd0\x89\x54\x21\x42\x9a\xb4\x06\xe8\xe0\xdf\xeb\xea\xd9\x8f\x76\x24\x3d\xc1\x4b\xc5\x84\x3c\xb7\x1c\xea\xdc\x9f\x39\xf5\xd0\xd2\x1a\x53\x0d\x4b\xfa\x34\x1c\x04\xf0\xaa\xdb\xfe\x0e\x25\x42\xb1\x55\x30\xd6\xc3\xdc\x99\x31\xba\xe1\x26\xdd\x84\x2b\x4d\xb0\x06\xda\x9a\x0b\x0f\xda\xc3\xd1\xc8\x52\xd3\xe9\x37\x63\x69\xbd\x96\xc7\xde\xcb\xb6\xfe\x6f\x71\x3c\x8b\x2f\x44\x7a\x3a\x3c\x82\x00\x00\x00")
+
+func _1674300005_add_sender_keysUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300005_add_sender_keysUpSql,
+		"1674300005_add_sender_keys.up.sql",
+	)
+}
+
+func _1674300005_add_sender_keysUpSql() (*asset, error) {
+	bytes, err := _1674300005_add_sender_keysUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300005_add_sender_keys.up.sql", size: 130, mode: os.FileMode(0644), modTime: time.Unix(1674300005, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9b, 0x3c, 0x1d, 0xdc, 0xd6, 0xc6, 0x8d, 0x73, 0xa5, 0xc9, 0x32, 0xde, 0x80, 0x93, 0xab, 0x46, 0x32, 0x5a, 0x5, 0x92, 0x17, 0xc0, 0xa1, 0x9a, 0xdc, 0x38, 0xeb, 0xed, 0x1b, 0x25, 0x95, 0xcd}}
+	return a, nil
+}
+
+var __1674300007_add_installations_revokedUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\xc1\x0d\x80\x20\x0c\x05\xd0\xbb\x53\xfc\x3d\x3c\x15\x29\xa7\x4a\x13\x2d\x03\x90\xc8\x81\x48\x20\x11\xe3\xfc\xbe\x47\x62\x7c\xc0\xc8\x09\xa3\xf6\xf9\xe6\xd6\xf2\x5b\x47\x9f\x20\xef\xb1\xa9\xa4\x3d\xe2\x29\xdf\xb8\xcb\x05\xa7\x2a\x4c\x11\x51\x0d\x31\x89\xc0\x73\xa0\x24\x86\x40\x72\xf2\xba\xfc\xba\x4c\x6a\xed\x4d\x00\x00\x00")
+
+func _1674300007_add_installations_revokedUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300007_add_installations_revokedUpSql,
+		"1674300007_add_installations_revoked.up.sql",
+	)
+}
+
+func _1674300007_add_installations_revokedUpSql() (*asset, error) {
+	bytes, err := _1674300007_add_installations_revokedUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300007_add_installations_revoked.up.sql", size: 77, mode: os.FileMode(0644), modTime: time.Unix(1674300007, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x35, 0xb1, 0x20, 0xda, 0x22, 0x21, 0x8c, 0xf, 0x7b, 0x9b, 0xbb, 0x1b, 0x1a, 0xac, 0xd9, 0x19, 0x39, 0xd9, 0x11, 0x66, 0xb8, 0x24, 0x88, 0x3f, 0x48, 0x7a, 0x88, 0xb5, 0x3a, 0xee, 0xfa, 0xf5}}
+	return a, nil
+}
+
+var __1674300008_add_installations_capabilitiesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\xc8\xcc\x2b\x2e\x49\xcc\xc9\x49\x2c\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x4e\x2c\x48\x4c\xca\xcc\xc9\x2c\xc9\x4c\x2d\x56\x08\x71\x8d\x08\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x75\x75\x6b\x2e\x00\xff\x6e\x21\xc6\x4c\x00\x00\x00")
+
+func _1674300008_add_installations_capabilitiesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300008_add_installations_capabilitiesUpSql,
+		"1674300008_add_installations_capabilities.up.sql",
+	)
+}
+
+func _1674300008_add_installations_capabilitiesUpSql() (*asset, error) {
+	bytes, err := _1674300008_add_installations_capabilitiesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300008_add_installations_capabilities.up.sql", size: 76, mode: os.FileMode(0644), modTime: time.Unix(1674300008, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf2, 0xbc, 0xd3, 0x4b, 0x75, 0xe6, 0x31, 0x74, 0x9, 0xf7, 0xe3, 0x26, 0xa5, 0x96, 0x99, 0xbb, 0x39, 0xc2, 0x8b, 0xfc, 0xa2, 0x79, 0x34, 0x13, 0x43, 0x93, 0xd4, 0xc2, 0xfb, 0xb0, 0x27, 0xc6}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\xbb\x6e\xc3\x30\x0c\x45\x77\x7f\xc5\x45\x96\x2c\xb5\xb4\x74\xea\xd6\xb1\x7b\x7f\x80\x91\x68\x89\x88\x1e\xae\x48\xe7\xf1\xf7\x85\xd3\x02\xcd\xd6\xf5\x00\xe7\xf0\xd2\x7b\x7c\x66\x51\x2c\x52\x18\xa2\x68\x1c\x58\x95\xc6\x1d\x27\x0e\xb4\x29\xe3\x90\xc4\xf2\x76\x72\xa1\x57\xaf\x46\xb6\xe9\x2c\xd5\x57\x49\x83\x8c\xfd\xe5\xf5\x30\x79\x8f\x40\xed\x68\xc8\xd4\x62\xe1\x47\x4b\xa1\x46\xc3\xa4\x25\x5c\xc5\x32\x08\xeb\xe0\x45\x6e\x0e\xef\x86\xc2\xa4\x06\xcb\x64\x47\x85\x65\x46\x20\xe5\x3d\xb3\xf4\x81\xd4\xe7\x93\xb4\x48\x46\x6e\x47\x1f\xcb\x13\xd9\x17\x06\x2a\x85\x23\x96\xd1\xeb\xc3\x55\xaa\x8c\x28\x83\x83\xf5\x71\x7f\x01\xa9\xb2\xa1\x51\x65\xdd\xfd\x4c\x17\x46\xeb\xbf\xe7\x41\x2d\xfe\xff\x11\xae\x7d\x9c\x15\xa4\xe0\xdb\xca\xc1\x38\xba\x69\x5a\x29\x9c\x29\x31\xf4\xab\x88\xf1\x34\x79\x9f\xfa\x5b\xe2\xc6\xbb\xf5\xbc\x71\x5e\xcf\x09\x3f\x35\xe9\x4d\x31\x77\x38\xe7\xff\x80\x4b\x1d\x6e\xfa\x0e\x00\x00\xff\xff\x9d\x60\x3d\x88\x79\x01\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -617,6 +680,12 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1636536507_add_index_bundles.up.sql": _1636536507_add_index_bundlesUpSql,
 
+	"1674300005_add_sender_keys.up.sql": _1674300005_add_sender_keysUpSql,
+
+	"1674300007_add_installations_revoked.up.sql": _1674300007_add_installations_revokedUpSql,
+
+	"1674300008_add_installations_capabilities.up.sql": _1674300008_add_installations_capabilitiesUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -661,26 +730,29 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"1536754952_initial_schema.down.sql":            &bintree{_1536754952_initial_schemaDownSql, map[string]*bintree{}},
-	"1536754952_initial_schema.up.sql":              &bintree{_1536754952_initial_schemaUpSql, map[string]*bintree{}},
-	"1539249977_update_ratchet_info.down.sql":       &bintree{_1539249977_update_ratchet_infoDownSql, map[string]*bintree{}},
-	"1539249977_update_ratchet_info.up.sql":         &bintree{_1539249977_update_ratchet_infoUpSql, map[string]*bintree{}},
-	"1540715431_add_version.down.sql":               &bintree{_1540715431_add_versionDownSql, map[string]*bintree{}},
-	"1540715431_add_version.up.sql":                 &bintree{_1540715431_add_versionUpSql, map[string]*bintree{}},
-	"1541164797_add_installations.down.sql":         &bintree{_1541164797_add_installationsDownSql, map[string]*bintree{}},
-	"1541164797_add_installations.up.sql":           &bintree{_1541164797_add_installationsUpSql, map[string]*bintree{}},
-	"1558084410_add_secret.down.sql":                &bintree{_1558084410_add_secretDownSql, map[string]*bintree{}},
-	"1558084410_add_secret.up.sql":                  &bintree{_1558084410_add_secretUpSql, map[string]*bintree{}},
-	"1558588866_add_version.down.sql":               &bintree{_1558588866_add_versionDownSql, map[string]*bintree{}},
-	"1558588866_add_version.up.sql":                 &bintree{_1558588866_add_versionUpSql, map[string]*bintree{}},
-	"1559627659_add_contact_code.down.sql":          &bintree{_1559627659_add_contact_codeDownSql, map[string]*bintree{}},
-	"1559627659_add_contact_code.up.sql":            &bintree{_1559627659_add_contact_codeUpSql, map[string]*bintree{}},
-	"1561368210_add_installation_metadata.down.sql": &bintree{_1561368210_add_installation_metadataDownSql, map[string]*bintree{}},
-	"1561368210_add_installation_metadata.up.sql":   &bintree{_1561368210_add_installation_metadataUpSql, map[string]*bintree{}},
-	"1632236298_add_communities.down.sql":           &bintree{_1632236298_add_communitiesDownSql, map[string]*bintree{}},
-	"1632236298_add_communities.up.sql":             &bintree{_1632236298_add_communitiesUpSql, map[string]*bintree{}},
-	"1636536507_add_index_bundles.up.sql":           &bintree{_1636536507_add_index_bundlesUpSql, map[string]*bintree{}},
-	"doc.go":                                        &bintree{docGo, map[string]*bintree{}},
+	"1536754952_initial_schema.down.sql":               &bintree{_1536754952_initial_schemaDownSql, map[string]*bintree{}},
+	"1536754952_initial_schema.up.sql":                 &bintree{_1536754952_initial_schemaUpSql, map[string]*bintree{}},
+	"1539249977_update_ratchet_info.down.sql":          &bintree{_1539249977_update_ratchet_infoDownSql, map[string]*bintree{}},
+	"1539249977_update_ratchet_info.up.sql":            &bintree{_1539249977_update_ratchet_infoUpSql, map[string]*bintree{}},
+	"1540715431_add_version.down.sql":                  &bintree{_1540715431_add_versionDownSql, map[string]*bintree{}},
+	"1540715431_add_version.up.sql":                    &bintree{_1540715431_add_versionUpSql, map[string]*bintree{}},
+	"1541164797_add_installations.down.sql":            &bintree{_1541164797_add_installationsDownSql, map[string]*bintree{}},
+	"1541164797_add_installations.up.sql":              &bintree{_1541164797_add_installationsUpSql, map[string]*bintree{}},
+	"1558084410_add_secret.down.sql":                   &bintree{_1558084410_add_secretDownSql, map[string]*bintree{}},
+	"1558084410_add_secret.up.sql":                     &bintree{_1558084410_add_secretUpSql, map[string]*bintree{}},
+	"1558588866_add_version.down.sql":                  &bintree{_1558588866_add_versionDownSql, map[string]*bintree{}},
+	"1558588866_add_version.up.sql":                    &bintree{_1558588866_add_versionUpSql, map[string]*bintree{}},
+	"1559627659_add_contact_code.down.sql":             &bintree{_1559627659_add_contact_codeDownSql, map[string]*bintree{}},
+	"1559627659_add_contact_code.up.sql":               &bintree{_1559627659_add_contact_codeUpSql, map[string]*bintree{}},
+	"1561368210_add_installation_metadata.down.sql":    &bintree{_1561368210_add_installation_metadataDownSql, map[string]*bintree{}},
+	"1561368210_add_installation_metadata.up.sql":      &bintree{_1561368210_add_installation_metadataUpSql, map[string]*bintree{}},
+	"1632236298_add_communities.down.sql":              &bintree{_1632236298_add_communitiesDownSql, map[string]*bintree{}},
+	"1632236298_add_communities.up.sql":                &bintree{_1632236298_add_communitiesUpSql, map[string]*bintree{}},
+	"1636536507_add_index_bundles.up.sql":              &bintree{_1636536507_add_index_bundlesUpSql, map[string]*bintree{}},
+	"1674300005_add_sender_keys.up.sql":                &bintree{_1674300005_add_sender_keysUpSql, map[string]*bintree{}},
+	"1674300007_add_installations_revoked.up.sql":      &bintree{_1674300007_add_installations_revokedUpSql, map[string]*bintree{}},
+	"1674300008_add_installations_capabilities.up.sql": &bintree{_1674300008_add_installations_capabilitiesUpSql, map[string]*bintree{}},
+	"doc.go": &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,4 @@
+CREATE TABLE hash_ratchet_sender_keys (
+  group_id BLOB NOT NULL PRIMARY KEY ON CONFLICT REPLACE,
+  distribution BLOB NOT NULL
+);
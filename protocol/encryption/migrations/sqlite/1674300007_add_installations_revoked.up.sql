@@ -0,0 +1 @@
+ALTER TABLE installations ADD COLUMN revoked BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+ALTER TABLE installations ADD COLUMN capabilities TEXT NOT NULL DEFAULT '';
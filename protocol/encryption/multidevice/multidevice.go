@@ -27,6 +27,10 @@ type Installation struct {
 	Enabled bool `json:"enabled"`
 	// Timestamp is the last time we saw this device
 	Timestamp int64 `json:"timestamp"`
+	// Revoked is whether the installation was revoked by its owner, it can't be enabled anymore
+	Revoked bool `json:"revoked"`
+	// Capabilities are the features the device advertised in its last bundle
+	Capabilities []string `json:"capabilities"`
 	// InstallationMetadata
 	InstallationMetadata *InstallationMetadata `json:"metadata"`
 }
@@ -35,6 +39,8 @@ type Config struct {
 	MaxInstallations int
 	ProtocolVersion  uint32
 	InstallationID   string
+	// Capabilities are the features our installation advertises to others
+	Capabilities []string
 }
 
 type Multidevice struct {
@@ -53,11 +59,22 @@ func (s *Multidevice) InstallationID() string {
 	return s.config.InstallationID
 }
 
+// SetCapabilities sets the capabilities our installation advertises in its bundle
+func (s *Multidevice) SetCapabilities(capabilities []string) {
+	s.config.Capabilities = capabilities
+}
+
 func (s *Multidevice) GetActiveInstallations(identity *ecdsa.PublicKey) ([]*Installation, error) {
 	identityC := crypto.CompressPubkey(identity)
 	return s.persistence.GetActiveInstallations(s.config.MaxInstallations, identityC)
 }
 
+// GetInstallations returns all the installations we know of, enabled or not, for a given identity
+func (s *Multidevice) GetInstallations(identity *ecdsa.PublicKey) ([]*Installation, error) {
+	identityC := crypto.CompressPubkey(identity)
+	return s.persistence.GetInstallations(identityC)
+}
+
 func (s *Multidevice) GetOurActiveInstallations(identity *ecdsa.PublicKey) ([]*Installation, error) {
 	identityC := crypto.CompressPubkey(identity)
 	installations, err := s.persistence.GetActiveInstallations(s.config.MaxInstallations-1, identityC)
@@ -66,8 +83,9 @@ func (s *Multidevice) GetOurActiveInstallations(identity *ecdsa.PublicKey) ([]*I
 	}
 
 	installations = append(installations, &Installation{
-		ID:      s.config.InstallationID,
-		Version: s.config.ProtocolVersion,
+		ID:           s.config.InstallationID,
+		Version:      s.config.ProtocolVersion,
+		Capabilities: s.config.Capabilities,
 	})
 
 	return installations, nil
@@ -86,14 +104,16 @@ func (s *Multidevice) GetOurInstallations(identity *ecdsa.PublicKey) ([]*Install
 			found = true
 			installation.Enabled = true
 			installation.Version = s.config.ProtocolVersion
+			installation.Capabilities = s.config.Capabilities
 		}
 
 	}
 	if !found {
 		installations = append(installations, &Installation{
-			ID:      s.config.InstallationID,
-			Enabled: true,
-			Version: s.config.ProtocolVersion,
+			ID:           s.config.InstallationID,
+			Enabled:      true,
+			Version:      s.config.ProtocolVersion,
+			Capabilities: s.config.Capabilities,
 		})
 	}
 
@@ -118,3 +138,9 @@ func (s *Multidevice) DisableInstallation(myIdentityKey *ecdsa.PublicKey, instal
 	myIdentityKeyC := crypto.CompressPubkey(myIdentityKey)
 	return s.persistence.DisableInstallation(myIdentityKeyC, installationID)
 }
+
+// RevokeInstallation disables an installation of identity for good, as its owner lost it
+func (s *Multidevice) RevokeInstallation(identity *ecdsa.PublicKey, installationID string) error {
+	identityC := crypto.CompressPubkey(identity)
+	return s.persistence.RevokeInstallation(identityC, installationID)
+}
@@ -1,6 +1,21 @@
 package multidevice
 
-import "database/sql"
+import (
+	"database/sql"
+	"strings"
+)
+
+// encodeCapabilities joins capabilities for storage, capabilities never contain commas
+func encodeCapabilities(capabilities []string) string {
+	return strings.Join(capabilities, ",")
+}
+
+func decodeCapabilities(capabilities string) []string {
+	if len(capabilities) == 0 {
+		return nil
+	}
+	return strings.Split(capabilities, ",")
+}
 
 type sqlitePersistence struct {
 	db *sql.DB
@@ -12,7 +27,7 @@ func newSQLitePersistence(db *sql.DB) *sqlitePersistence {
 
 // GetActiveInstallations returns the active installations for a given identity
 func (s *sqlitePersistence) GetActiveInstallations(maxInstallations int, identity []byte) ([]*Installation, error) {
-	stmt, err := s.db.Prepare(`SELECT installation_id, version
+	stmt, err := s.db.Prepare(`SELECT installation_id, version, capabilities
 				   FROM installations
 				   WHERE enabled = 1 AND identity = ?
 				   ORDER BY timestamp DESC
@@ -32,18 +47,21 @@ func (s *sqlitePersistence) GetActiveInstallations(maxInstallations int, identit
 		var (
 			installationID string
 			version        uint32
+			capabilities   string
 		)
 		err = rows.Scan(
 			&installationID,
 			&version,
+			&capabilities,
 		)
 		if err != nil {
 			return nil, err
 		}
 		installations = append(installations, &Installation{
-			ID:      installationID,
-			Version: version,
-			Enabled: true,
+			ID:           installationID,
+			Version:      version,
+			Enabled:      true,
+			Capabilities: decodeCapabilities(capabilities),
 		})
 
 	}
@@ -61,7 +79,7 @@ func (s *sqlitePersistence) GetInstallations(identity []byte) ([]*Installation,
 	var installations []*Installation
 
 	// We query both tables as sqlite does not support full outer joins
-	installationsStmt, err := s.db.Prepare(`SELECT installation_id, version, enabled, timestamp FROM installations WHERE identity = ?`)
+	installationsStmt, err := s.db.Prepare(`SELECT installation_id, version, enabled, timestamp, revoked, capabilities FROM installations WHERE identity = ?`)
 	if err != nil {
 		return nil, err
 	}
@@ -74,15 +92,19 @@ func (s *sqlitePersistence) GetInstallations(identity []byte) ([]*Installation,
 
 	for installationRows.Next() {
 		var installation Installation
+		var capabilities string
 		err = installationRows.Scan(
 			&installation.ID,
 			&installation.Version,
 			&installation.Enabled,
 			&installation.Timestamp,
+			&installation.Revoked,
+			&capabilities,
 		)
 		if err != nil {
 			return nil, err
 		}
+		installation.Capabilities = decodeCapabilities(capabilities)
 		// We initialized to empty in this case as we want to
 		// return metadata as well in this endpoint, but not in others
 		installation.InstallationMetadata = &InstallationMetadata{}
@@ -167,8 +189,8 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 		}
 
 		if err == sql.ErrNoRows {
-			stmt, err = tx.Prepare(`INSERT INTO installations(identity, installation_id, timestamp, enabled, version)
-						VALUES (?, ?, ?, ?, ?)`)
+			stmt, err = tx.Prepare(`INSERT INTO installations(identity, installation_id, timestamp, enabled, version, capabilities)
+						VALUES (?, ?, ?, ?, ?, ?)`)
 			if err != nil {
 				return nil, err
 			}
@@ -180,6 +202,7 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 				timestamp,
 				defaultEnabled,
 				latestVersion,
+				encodeCapabilities(installation.Capabilities),
 			)
 			if err != nil {
 				return nil, err
@@ -187,13 +210,14 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 			insertedInstallations = append(insertedInstallations, installation)
 		} else {
 			// We update timestamp if present without changing enabled, only if this is a new bundle
-			// and we set the version to the latest we ever saw
+			// and we set the version to the latest we ever saw.
+			// Capabilities are the ones of the latest bundle, as they can be dropped
 			if oldVersion > installation.Version {
 				latestVersion = oldVersion
 			}
 
 			stmt, err = tx.Prepare(`UPDATE installations
-					        SET timestamp = ?,  enabled = ?, version = ?
+					        SET timestamp = ?,  enabled = ?, version = ?, capabilities = ?
 						WHERE identity = ?
 						AND installation_id = ?
 						AND timestamp < ?`)
@@ -206,6 +230,7 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 				timestamp,
 				oldEnabled,
 				latestVersion,
+				encodeCapabilities(installation.Capabilities),
 				identity,
 				installation.ID,
 				timestamp,
@@ -230,7 +255,7 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 func (s *sqlitePersistence) EnableInstallation(identity []byte, installationID string) error {
 	stmt, err := s.db.Prepare(`UPDATE installations
 				   SET enabled = 1
-				   WHERE identity = ? AND installation_id = ?`)
+				   WHERE identity = ? AND installation_id = ? AND NOT revoked`)
 	if err != nil {
 		return err
 	}
@@ -253,6 +278,31 @@ func (s *sqlitePersistence) DisableInstallation(identity []byte, installationID
 	return err
 }
 
+// RevokeInstallation disables the installation for good, even if we never saw it before
+func (s *sqlitePersistence) RevokeInstallation(identity []byte, installationID string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`INSERT OR IGNORE INTO installations(identity, installation_id, timestamp, enabled, version, revoked)
+			  VALUES (?, ?, 0, 0, 0, 1)`, identity, installationID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`UPDATE installations
+			  SET enabled = 0, revoked = 1
+			  WHERE identity = ? AND installation_id = ?`, identity, installationID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
 // SetInstallationMetadata sets the metadata for a given installation
 func (s *sqlitePersistence) SetInstallationMetadata(identity []byte, installationID string, metadata *InstallationMetadata) error {
 	stmt, err := s.db.Prepare(`INSERT INTO installation_metadata(name, device_type, fcm_token, identity, installation_id) VALUES(?,?,?,?,?)`)
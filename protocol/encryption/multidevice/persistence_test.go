@@ -91,6 +91,54 @@ func (s *SQLLitePersistenceTestSuite) TestAddInstallationVersions() {
 	s.Require().Equal(installations, enabledInstallations)
 }
 
+func (s *SQLLitePersistenceTestSuite) TestAddInstallationCapabilities() {
+	identity := []byte("alice")
+	installations := []*Installation{
+		{ID: "alice-1", Version: 1, Enabled: true, Capabilities: []string{"a", "b"}},
+		{ID: "alice-2", Version: 1, Enabled: true},
+	}
+	_, err := s.service.AddInstallations(
+		identity,
+		1,
+		installations,
+		true,
+	)
+	s.Require().NoError(err)
+
+	enabledInstallations, err := s.service.GetActiveInstallations(5, identity)
+	s.Require().NoError(err)
+	s.Require().Equal(installations, enabledInstallations)
+
+	// Capabilities of older bundles are ignored
+	_, err = s.service.AddInstallations(
+		identity,
+		1,
+		[]*Installation{{ID: "alice-2", Version: 1, Capabilities: []string{"a"}}},
+		true,
+	)
+	s.Require().NoError(err)
+
+	// Capabilities are replaced by the ones of newer bundles
+	_, err = s.service.AddInstallations(
+		identity,
+		2,
+		[]*Installation{{ID: "alice-1", Version: 1, Capabilities: []string{"c"}}},
+		true,
+	)
+	s.Require().NoError(err)
+
+	allInstallations, err := s.service.GetInstallations(identity)
+	s.Require().NoError(err)
+	s.Require().Len(allInstallations, 2)
+	for _, installation := range allInstallations {
+		if installation.ID == "alice-1" {
+			s.Require().Equal([]string{"c"}, installation.Capabilities)
+		} else {
+			s.Require().Nil(installation.Capabilities)
+		}
+	}
+}
+
 func (s *SQLLitePersistenceTestSuite) TestAddInstallationsLimit() {
 	identity := []byte("alice")
 
@@ -241,6 +289,63 @@ func (s *SQLLitePersistenceTestSuite) TestEnableInstallation() {
 	s.Require().Equal(expected, actualInstallations)
 }
 
+func (s *SQLLitePersistenceTestSuite) TestRevokeInstallation() {
+	identity := []byte("alice")
+
+	installations := []*Installation{
+		{ID: "alice-1", Version: 1},
+		{ID: "alice-2", Version: 2},
+	}
+
+	_, err := s.service.AddInstallations(
+		identity,
+		1,
+		installations,
+		true,
+	)
+	s.Require().NoError(err)
+
+	err = s.service.RevokeInstallation(identity, "alice-1")
+	s.Require().NoError(err)
+
+	// Installations can be revoked before we see them
+	err = s.service.RevokeInstallation(identity, "alice-3")
+	s.Require().NoError(err)
+
+	// We add the installations again
+	installations = []*Installation{
+		{ID: "alice-1", Version: 1},
+		{ID: "alice-2", Version: 2},
+		{ID: "alice-3", Version: 2},
+	}
+
+	addedInstallations, err := s.service.AddInstallations(
+		identity,
+		2,
+		installations,
+		true,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(0, len(addedInstallations))
+
+	// Revoked installations can't be enabled
+	err = s.service.EnableInstallation(identity, "alice-1")
+	s.Require().NoError(err)
+
+	actualInstallations, err := s.service.GetActiveInstallations(3, identity)
+	s.Require().NoError(err)
+
+	expected := []*Installation{{ID: "alice-2", Version: 2, Enabled: true}}
+	s.Require().Equal(expected, actualInstallations)
+
+	actualInstallations, err = s.service.GetInstallations(identity)
+	s.Require().NoError(err)
+	s.Require().Len(actualInstallations, 3)
+	for _, installation := range actualInstallations {
+		s.Require().Equal(installation.ID != "alice-2", installation.Revoked)
+	}
+}
+
 func (s *SQLLitePersistenceTestSuite) TestGetInstallations() {
 	identity := []byte("alice")
 
@@ -162,6 +162,7 @@ func (s *sqlitePersistence) AddPublicBundle(b *Bundle) error {
 func (s *sqlitePersistence) GetAnyPrivateBundle(myIdentityKey []byte, installations []*multidevice.Installation) (*BundleContainer, error) {
 
 	versions := make(map[string]uint32)
+	capabilities := make(map[string][]string)
 	/* #nosec */
 	statement := `SELECT identity, private_key, signed_pre_key, installation_id, timestamp, version
 	              FROM bundles
@@ -182,6 +183,7 @@ func (s *sqlitePersistence) GetAnyPrivateBundle(myIdentityKey []byte, installati
 	for i, installation := range installations {
 		// Lookup up map for versions
 		versions[installation.ID] = installation.Version
+		capabilities[installation.ID] = installation.Capabilities
 
 		args[i+1] = installation.ID
 	}
@@ -227,6 +229,7 @@ func (s *sqlitePersistence) GetAnyPrivateBundle(myIdentityKey []byte, installati
 			SignedPreKey:    signedPreKey,
 			Version:         version,
 			ProtocolVersion: versions[installationID],
+			Capabilities:    capabilities[installationID],
 		}
 		bundle.Identity = identity
 	}
@@ -286,6 +289,7 @@ func (s *sqlitePersistence) GetPublicBundle(publicKey *ecdsa.PublicKey, installa
 	}
 
 	versions := make(map[string]uint32)
+	capabilities := make(map[string][]string)
 	identity := crypto.CompressPubkey(publicKey)
 
 	/* #nosec */
@@ -304,6 +308,7 @@ func (s *sqlitePersistence) GetPublicBundle(publicKey *ecdsa.PublicKey, installa
 	for i, installation := range installations {
 		// Lookup up map for versions
 		versions[installation.ID] = installation.Version
+		capabilities[installation.ID] = installation.Capabilities
 		args[i+1] = installation.ID
 	}
 
@@ -339,6 +344,7 @@ func (s *sqlitePersistence) GetPublicBundle(publicKey *ecdsa.PublicKey, installa
 			SignedPreKey:    signedPreKey,
 			Version:         version,
 			ProtocolVersion: versions[installationID],
+			Capabilities:    capabilities[installationID],
 		}
 
 	}
@@ -915,3 +921,75 @@ func (s *sqlitePersistence) SaveHashRatchetKey(
 
 	return err
 }
+
+// GetSenderKeyDistribution retrieves the fingerprint of the recipients our sender key
+// for the given group has been distributed to
+func (s *sqlitePersistence) GetSenderKeyDistribution(groupID []byte) ([]byte, error) {
+	var distribution []byte
+	err := s.DB.QueryRow(`SELECT distribution FROM hash_ratchet_sender_keys WHERE group_id = ?`, groupID).Scan(&distribution)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return distribution, err
+}
+
+// SaveSenderKeyDistribution saves the fingerprint of the recipients our sender key
+// for the given group has been distributed to
+func (s *sqlitePersistence) SaveSenderKeyDistribution(groupID []byte, distribution []byte) error {
+	_, err := s.DB.Exec(`INSERT INTO hash_ratchet_sender_keys(group_id, distribution) VALUES(?, ?)`, groupID, distribution)
+	return err
+}
+
+// DeleteRatchetInfo deletes the RatchetInfo, and the double ratchet sessions built on it,
+// for the specified interlocutor identity and installation.
+// If installationID is empty, the sessions with all their installations are deleted.
+func (s *sqlitePersistence) DeleteRatchetInfo(theirIdentity []byte, installationID string) (err error) {
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	where := `identity = ?`
+	args := []interface{}{theirIdentity}
+	if installationID != "" {
+		where += ` AND installation_id = ?`
+		args = append(args, installationID)
+	}
+
+	rows, err := tx.Query(`SELECT bundle_id, installation_id FROM ratchet_info_v2 WHERE `+where, args...)
+	if err != nil {
+		return err
+	}
+
+	var sessionIDs [][]byte
+	for rows.Next() {
+		var bundleID []byte
+		var id string
+		if err = rows.Scan(&bundleID, &id); err != nil {
+			rows.Close()
+			return err
+		}
+		sessionIDs = append(sessionIDs, append(bundleID, []byte(id)...))
+	}
+	rows.Close()
+
+	for _, sessionID := range sessionIDs {
+		if _, err = tx.Exec(`DELETE FROM keys WHERE session_id = ?`, sessionID); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(`DELETE FROM sessions WHERE id = ?`, sessionID); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`DELETE FROM ratchet_info_v2 WHERE `+where, args...)
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"database/sql"
 	"fmt"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -76,12 +77,17 @@ type Protocol struct {
 	publisher     *publisher.Publisher
 	subscriptions *Subscriptions
 
+	sessionFailures      map[string]*sessionFailures
+	sessionFailuresMutex sync.Mutex
+
 	logger *zap.Logger
 }
 
 var (
 	// ErrNoPayload means that there was no payload found in the received protocol message.
 	ErrNoPayload = errors.New("no payload")
+	// ErrNoInstallations means that none of the installations of the recipient were targeted.
+	ErrNoInstallations = errors.New("no installations")
 )
 
 // New creates a new ProtocolService instance
@@ -114,8 +120,9 @@ func NewWithEncryptorConfig(
 			ProtocolVersion:  protocolVersion,
 			InstallationID:   installationID,
 		}),
-		publisher: publisher.New(logger),
-		logger:    logger.With(zap.Namespace("Protocol")),
+		publisher:       publisher.New(logger),
+		sessionFailures: make(map[string]*sessionFailures),
+		logger:          logger.With(zap.Namespace("Protocol")),
 	}
 }
 
@@ -182,6 +189,13 @@ func (p *Protocol) BuildPublicMessage(myIdentityKey *ecdsa.PrivateKey, payload [
 
 // BuildEncryptedMessage returns a 1:1 chat message and optionally a negotiated topic given the user identity private key, the recipient's public key, and a payload
 func (p *Protocol) BuildEncryptedMessage(myIdentityKey *ecdsa.PrivateKey, publicKey *ecdsa.PublicKey, payload []byte) (*ProtocolMessageSpec, error) {
+	return p.BuildEncryptedMessageForInstallations(myIdentityKey, publicKey, payload, nil)
+}
+
+// BuildEncryptedMessageForInstallations is like BuildEncryptedMessage, but only encrypts the payload
+// for the installations of the recipient accepted by filter, or all of them if filter is nil.
+// ErrNoInstallations is returned if filter accepts none of the known installations.
+func (p *Protocol) BuildEncryptedMessageForInstallations(myIdentityKey *ecdsa.PrivateKey, publicKey *ecdsa.PublicKey, payload []byte, filter func(*multidevice.Installation) bool) (*ProtocolMessageSpec, error) {
 
 	// Get recipients installations.
 	activeInstallations, err := p.multidevice.GetActiveInstallations(publicKey)
@@ -189,6 +203,21 @@ func (p *Protocol) BuildEncryptedMessage(myIdentityKey *ecdsa.PrivateKey, public
 		return nil, err
 	}
 
+	// Installations we don't know of can't be filtered, the payload is then encrypted with DH
+	if filter != nil && len(activeInstallations) != 0 {
+		var filtered []*multidevice.Installation
+		for _, installation := range activeInstallations {
+			if filter(installation) {
+				filtered = append(filtered, installation)
+			}
+		}
+		// Without installations the payload would be encrypted for all devices
+		if len(filtered) == 0 {
+			return nil, ErrNoInstallations
+		}
+		activeInstallations = filtered
+	}
+
 	// Encrypt payload
 	encryptedMessagesByInstalls, installations, err := p.encryptor.EncryptPayload(publicKey, myIdentityKey, activeInstallations, payload)
 	if err != nil {
@@ -422,9 +451,10 @@ func (p *Protocol) recoverInstallationsFromBundle(myIdentityKey *ecdsa.PrivateKe
 	for installationID, signedPreKey := range signedPreKeys {
 		if installationID != p.multidevice.InstallationID() {
 			installations = append(installations, &multidevice.Installation{
-				Identity: theirIdentityStr,
-				ID:       installationID,
-				Version:  signedPreKey.GetProtocolVersion(),
+				Identity:     theirIdentityStr,
+				ID:           installationID,
+				Version:      signedPreKey.GetProtocolVersion(),
+				Capabilities: signedPreKey.GetCapabilities(),
 			})
 		}
 	}
@@ -442,6 +472,12 @@ func (p *Protocol) GetBundle(myIdentityKey *ecdsa.PrivateKey) (*Bundle, error) {
 	return p.encryptor.CreateBundle(myIdentityKey, installations)
 }
 
+// SetCapabilities sets the capabilities advertised in our bundle, so that others
+// know which features our installation understands
+func (p *Protocol) SetCapabilities(capabilities []string) {
+	p.multidevice.SetCapabilities(capabilities)
+}
+
 // EnableInstallation enables an installation for multi-device sync.
 func (p *Protocol) EnableInstallation(myIdentityKey *ecdsa.PublicKey, installationID string) error {
 	return p.multidevice.EnableInstallation(myIdentityKey, installationID)
@@ -452,11 +488,21 @@ func (p *Protocol) DisableInstallation(myIdentityKey *ecdsa.PublicKey, installat
 	return p.multidevice.DisableInstallation(myIdentityKey, installationID)
 }
 
+// RevokeInstallation disables an installation for good, we won't encrypt to it anymore.
+func (p *Protocol) RevokeInstallation(identity *ecdsa.PublicKey, installationID string) error {
+	return p.multidevice.RevokeInstallation(identity, installationID)
+}
+
 // GetOurInstallations returns all the installations available given an identity
 func (p *Protocol) GetOurInstallations(myIdentityKey *ecdsa.PublicKey) ([]*multidevice.Installation, error) {
 	return p.multidevice.GetOurInstallations(myIdentityKey)
 }
 
+// GetInstallations returns all the installations we know of for someone else's identity
+func (p *Protocol) GetInstallations(theirIdentityKey *ecdsa.PublicKey) ([]*multidevice.Installation, error) {
+	return p.multidevice.GetInstallations(theirIdentityKey)
+}
+
 // GetOurActiveInstallations returns all the active installations available given an identity
 func (p *Protocol) GetOurActiveInstallations(myIdentityKey *ecdsa.PublicKey) ([]*multidevice.Installation, error) {
 	return p.multidevice.GetOurActiveInstallations(myIdentityKey)
@@ -492,6 +538,8 @@ type DecryptMessageResponse struct {
 	Installations    []*multidevice.Installation
 	SharedSecrets    []*sharedsecret.Secret
 	HashRatchetInfo  []*HashRatchetInfo
+	// SenderKey is set when the message was encrypted with the sender key of a group chat member
+	SenderKey bool
 }
 
 func (p *Protocol) HandleHashRatchetKeys(groupID, encodedKeys []byte) ([]*HashRatchetInfo, error) {
@@ -553,6 +601,13 @@ func (p *Protocol) HandleMessage(
 
 	// Decrypt message
 	if encryptedMessage := protocolMessage.GetEncryptedMessage(); encryptedMessage != nil {
+		// Sender keys can only be distributed and used by their owner
+		if msg := p.encryptor.GetMessage(encryptedMessage); msg != nil && msg.GetHRHeader() != nil {
+			if !isSenderKeyOwner(msg.GetHRHeader().GroupId, theirPublicKey) {
+				return nil, ErrSenderKeyNotOwner
+			}
+		}
+
 		message, err := p.encryptor.DecryptPayload(
 			myIdentityKey,
 			theirPublicKey,
@@ -572,6 +627,15 @@ func (p *Protocol) HandleMessage(
 			return response, err
 		}
 
+		if isSessionMessage(p.encryptor.GetMessage(encryptedMessage)) {
+			if err == nil {
+				p.clearSessionFailures(theirPublicKey)
+			} else if p.recordSessionFailure(theirPublicKey) {
+				logger.Warn("session out of sync", zap.Error(err))
+				return nil, ErrSessionOutOfSync
+			}
+		}
+
 		if err != nil {
 			return nil, err
 		}
@@ -602,6 +666,7 @@ func (p *Protocol) HandleMessage(
 			response.SharedSecrets = []*sharedsecret.Secret{sharedSecret}
 		}
 		response.DecryptedMessage = message
+		response.SenderKey = isSenderKeyMessage(p.encryptor.GetMessage(encryptedMessage))
 		return response, nil
 	}
 
@@ -21,9 +21,11 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type SignedPreKey struct {
-	SignedPreKey         []byte   `protobuf:"bytes,1,opt,name=signed_pre_key,json=signedPreKey,proto3" json:"signed_pre_key,omitempty"`
-	Version              uint32   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	ProtocolVersion      uint32   `protobuf:"varint,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	SignedPreKey    []byte `protobuf:"bytes,1,opt,name=signed_pre_key,json=signedPreKey,proto3" json:"signed_pre_key,omitempty"`
+	Version         uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ProtocolVersion uint32 `protobuf:"varint,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// Features the installation understands, such as content types
+	Capabilities         []string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -75,6 +77,13 @@ func (m *SignedPreKey) GetProtocolVersion() uint32 {
 	return 0
 }
 
+func (m *SignedPreKey) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 // X3DH prekey bundle
 type Bundle struct {
 	// Identity key
@@ -84,10 +93,13 @@ type Bundle struct {
 	// Prekey signature
 	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	// When the bundle was created locally
-	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Signature covering the capabilities of the installations as well,
+	// kept apart from signature so that older clients can still verify the bundle
+	CapabilitiesSignature []byte   `protobuf:"bytes,6,opt,name=capabilities_signature,json=capabilitiesSignature,proto3" json:"capabilities_signature,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *Bundle) Reset()         { *m = Bundle{} }
@@ -143,6 +155,13 @@ func (m *Bundle) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Bundle) GetCapabilitiesSignature() []byte {
+	if m != nil {
+		return m.CapabilitiesSignature
+	}
+	return nil
+}
+
 type BundleContainer struct {
 	// X3DH prekey bundle
 	Bundle *Bundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
@@ -576,7 +595,9 @@ type ProtocolMessage struct {
 	// TODO map here is redundant in case of community messages
 	EncryptedMessage map[string]*EncryptedMessageProtocol `protobuf:"bytes,101,rep,name=encrypted_message,json=encryptedMessage,proto3" json:"encrypted_message,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Public chats, not encrypted
-	PublicMessage        []byte   `protobuf:"bytes,102,opt,name=public_message,json=publicMessage,proto3" json:"public_message,omitempty"`
+	PublicMessage []byte `protobuf:"bytes,102,opt,name=public_message,json=publicMessage,proto3" json:"public_message,omitempty"`
+	// Padding up to the size bucket of the message, ignored by the receiver
+	Padding              []byte   `protobuf:"bytes,103,opt,name=padding,proto3" json:"padding,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -635,6 +656,13 @@ func (m *ProtocolMessage) GetPublicMessage() []byte {
 	return nil
 }
 
+func (m *ProtocolMessage) GetPadding() []byte {
+	if m != nil {
+		return m.Padding
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SignedPreKey)(nil), "encryption.SignedPreKey")
 	proto.RegisterType((*Bundle)(nil), "encryption.Bundle")
@@ -656,47 +684,50 @@ func init() {
 }
 
 var fileDescriptor_4e37b52004a72e16 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x4e, 0x9c, 0x40,
-	0x14, 0x0e, 0xb0, 0x7f, 0x9e, 0xfd, 0x61, 0x9d, 0x56, 0x43, 0x8d, 0x17, 0x1b, 0xa2, 0xe9, 0xb6,
-	0x69, 0xb0, 0x6a, 0x93, 0x36, 0xf6, 0xce, 0x6a, 0x82, 0x36, 0x36, 0x66, 0x4c, 0x9a, 0xc6, 0x8b,
-	0x12, 0x94, 0xa3, 0x9d, 0x88, 0x80, 0x0c, 0x6b, 0xca, 0x0b, 0xf4, 0xa6, 0x6f, 0xd8, 0xa7, 0x69,
-	0x18, 0x18, 0x18, 0xd7, 0xdd, 0x3b, 0xce, 0xc7, 0xf9, 0xfb, 0xbe, 0x73, 0xe6, 0xc0, 0x7a, 0x92,
-	0xc6, 0x59, 0x7c, 0x1d, 0x87, 0xde, 0x3d, 0x72, 0xee, 0xdf, 0xa2, 0x23, 0x00, 0x02, 0x18, 0x5d,
-	0xa7, 0x79, 0x92, 0xb1, 0x38, 0xb2, 0x73, 0x18, 0x5c, 0xb0, 0xdb, 0x08, 0x83, 0xf3, 0x14, 0xbf,
-	0x62, 0x4e, 0xb6, 0x60, 0xc4, 0x85, 0xed, 0x25, 0x29, 0x7a, 0x77, 0x98, 0x5b, 0xda, 0x44, 0x9b,
-	0x0e, 0xe8, 0x80, 0xab, 0x5e, 0x16, 0x74, 0x1f, 0x31, 0xe5, 0x2c, 0x8e, 0x2c, 0x7d, 0xa2, 0x4d,
-	0x87, 0x54, 0x9a, 0xe4, 0x0d, 0x8c, 0xeb, 0xaa, 0xd2, 0xc5, 0x10, 0x2e, 0xa6, 0xc4, 0xbf, 0x97,
-	0xb0, 0xfd, 0x57, 0x87, 0xce, 0xe1, 0x2c, 0x0a, 0x42, 0x24, 0x1b, 0xd0, 0x63, 0x01, 0x46, 0x19,
-	0xcb, 0x64, 0xbd, 0xda, 0x26, 0x67, 0x60, 0x3e, 0xed, 0x88, 0x5b, 0xfa, 0xc4, 0x98, 0xf6, 0xf7,
-	0xb6, 0x9d, 0x86, 0x87, 0x53, 0x26, 0x72, 0x54, 0x2e, 0xfc, 0x38, 0xca, 0xd2, 0x9c, 0x0e, 0xd5,
-	0xce, 0x39, 0xd9, 0x84, 0x95, 0x02, 0xf0, 0xb3, 0x59, 0x8a, 0x56, 0x4b, 0xd4, 0x6a, 0x80, 0xe2,
-	0x6f, 0xc6, 0xee, 0x91, 0x67, 0xfe, 0x7d, 0x62, 0xb5, 0x27, 0xda, 0xd4, 0xa0, 0x0d, 0xb0, 0x71,
-	0x09, 0xe4, 0x79, 0x01, 0x32, 0x06, 0x43, 0xea, 0xb4, 0x42, 0x8b, 0x4f, 0xe2, 0x40, 0xfb, 0xd1,
-	0x0f, 0x67, 0x28, 0xc4, 0xe9, 0xef, 0x59, 0x6a, 0xa3, 0x6a, 0x02, 0x5a, 0xba, 0x1d, 0xe8, 0x9f,
-	0x34, 0xfb, 0x37, 0x98, 0x25, 0x87, 0x2f, 0x71, 0x94, 0xf9, 0x2c, 0xc2, 0x94, 0xbc, 0x85, 0xce,
-	0x95, 0x80, 0x44, 0xee, 0xfe, 0x1e, 0x79, 0x4e, 0x98, 0x56, 0x1e, 0x64, 0xbf, 0x98, 0x36, 0x7b,
-	0xf4, 0x33, 0xf4, 0xe6, 0xe6, 0xa7, 0x0b, 0x8e, 0x2f, 0xaa, 0xbf, 0x6a, 0xf9, 0xd3, 0x56, 0xcf,
-	0x18, 0xb7, 0xec, 0x53, 0xe8, 0x1d, 0x51, 0x17, 0xfd, 0x00, 0x53, 0x95, 0xcb, 0xa0, 0xe4, 0x32,
-	0x00, 0x4d, 0x0e, 0x59, 0x8b, 0xc8, 0x08, 0xf4, 0x44, 0x0e, 0x54, 0x4f, 0x84, 0xcd, 0x82, 0x4a,
-	0x46, 0x9d, 0x05, 0xf6, 0x26, 0xf4, 0x8e, 0xdc, 0x65, 0xb9, 0xec, 0x0f, 0x00, 0x3f, 0xf6, 0x97,
-	0xff, 0x9f, 0xcf, 0x56, 0xf5, 0x77, 0x01, 0x3d, 0x57, 0xf6, 0xb7, 0x06, 0x9d, 0x3b, 0xcc, 0x3d,
-	0x16, 0x88, 0xb0, 0x21, 0x6d, 0xdf, 0x61, 0x7e, 0x12, 0x14, 0x30, 0xc7, 0x07, 0x2f, 0x8a, 0xab,
-	0x4e, 0xdb, 0x1c, 0x1f, 0xbe, 0xc5, 0xe4, 0x15, 0xf4, 0x6e, 0xd3, 0x78, 0x96, 0x14, 0xfe, 0x86,
-	0xc8, 0xda, 0x15, 0xf6, 0x49, 0x60, 0xef, 0x40, 0xc7, 0xa5, 0x62, 0x21, 0xb6, 0xa1, 0x25, 0x96,
-	0x4a, 0x13, 0x4b, 0xb5, 0xaa, 0x6a, 0x2c, 0x3c, 0xa8, 0xf8, 0x6d, 0xbf, 0x87, 0xb6, 0x30, 0x97,
-	0xb5, 0x50, 0xb1, 0xd1, 0x1b, 0xb6, 0x7f, 0x74, 0xb0, 0x8e, 0xcb, 0x64, 0x18, 0x9c, 0x95, 0x2f,
-	0xf0, 0xbc, 0x7a, 0x03, 0xe4, 0x23, 0xf4, 0x0b, 0x29, 0xbc, 0x5f, 0x82, 0x57, 0x35, 0xe0, 0x75,
-	0xb5, 0x78, 0xa3, 0x14, 0x55, 0x55, 0xdb, 0x85, 0x95, 0x23, 0x2a, 0xc3, 0xca, 0xfd, 0x7a, 0xa9,
-	0x86, 0xc9, 0x51, 0xd2, 0x66, 0xa8, 0x45, 0x48, 0x5d, 0x09, 0x17, 0x84, 0xb8, 0x75, 0x88, 0x52,
-	0xc5, 0xad, 0xab, 0xdc, 0x3c, 0x0f, 0x71, 0xeb, 0x2a, 0xf5, 0x68, 0x2c, 0xe8, 0x26, 0x7e, 0x1e,
-	0xc6, 0x7e, 0xad, 0x75, 0x65, 0xda, 0xff, 0x74, 0x30, 0x25, 0xf1, 0x4a, 0x07, 0xf2, 0x1a, 0x4c,
-	0x16, 0xf1, 0xcc, 0x0f, 0x43, 0xbf, 0x48, 0x58, 0xc8, 0xa9, 0x8b, 0x07, 0x34, 0x52, 0xe1, 0x93,
-	0x80, 0xbc, 0x83, 0x6e, 0xb9, 0xe2, 0xdc, 0x32, 0xc4, 0x84, 0x16, 0xbd, 0x02, 0xe9, 0x42, 0x7e,
-	0xc2, 0x2a, 0x4a, 0xc9, 0xe5, 0xd5, 0xb3, 0x50, 0xc4, 0xed, 0xaa, 0x71, 0x73, 0xed, 0x38, 0xf3,
-	0x73, 0x2a, 0x4f, 0xc7, 0x18, 0xe7, 0x60, 0xb2, 0x0d, 0xa3, 0x64, 0x76, 0x15, 0xb2, 0xeb, 0x3a,
-	0xf9, 0x8d, 0xe0, 0x3a, 0x2c, 0xd1, 0xca, 0x6d, 0x83, 0xc1, 0xda, 0xc2, 0x8c, 0x0b, 0x6e, 0xc5,
-	0xc1, 0xd3, 0x5b, 0xb1, 0xa5, 0x76, 0xb9, 0x6c, 0x7b, 0x94, 0xbb, 0x71, 0x68, 0x5e, 0x0e, 0x9d,
-	0x9d, 0xcf, 0x4d, 0xd0, 0x55, 0x47, 0xdc, 0xd9, 0xfd, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1c,
-	0x6a, 0xd5, 0xad, 0xfe, 0x05, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x4e, 0xdb, 0x4a,
+	0x10, 0x96, 0xed, 0xfc, 0x4e, 0x7e, 0xd9, 0x73, 0x40, 0x3e, 0x88, 0x8b, 0xc8, 0x02, 0x9d, 0xb4,
+	0xaa, 0x42, 0x81, 0x56, 0xad, 0xe8, 0x1d, 0x05, 0xc9, 0x50, 0x51, 0xa1, 0x45, 0xaa, 0x2a, 0x2e,
+	0x6a, 0x39, 0xf1, 0x90, 0xae, 0x30, 0xb6, 0xf1, 0x3a, 0xa8, 0x7e, 0x81, 0xbe, 0x45, 0x5f, 0xa6,
+	0xcf, 0xd4, 0x07, 0xa8, 0xbc, 0xf6, 0xda, 0x4b, 0x48, 0xee, 0x3c, 0xb3, 0x33, 0xdf, 0xcc, 0xf7,
+	0xcd, 0x78, 0x60, 0x2b, 0x8a, 0xc3, 0x24, 0x9c, 0x85, 0xbe, 0x73, 0x8f, 0x9c, 0xbb, 0x73, 0x9c,
+	0x08, 0x07, 0x01, 0x0c, 0x66, 0x71, 0x1a, 0x25, 0x2c, 0x0c, 0xac, 0x5f, 0x1a, 0x74, 0xaf, 0xd9,
+	0x3c, 0x40, 0xef, 0x2a, 0xc6, 0x4f, 0x98, 0x92, 0x5d, 0xe8, 0x73, 0x61, 0x3b, 0x51, 0x8c, 0xce,
+	0x1d, 0xa6, 0xa6, 0x36, 0xd2, 0xc6, 0x5d, 0xda, 0xe5, 0x6a, 0x94, 0x09, 0xcd, 0x47, 0x8c, 0x39,
+	0x0b, 0x03, 0x53, 0x1f, 0x69, 0xe3, 0x1e, 0x95, 0x26, 0x79, 0x01, 0xc3, 0xb2, 0xac, 0x0c, 0x31,
+	0x44, 0xc8, 0x40, 0xfa, 0xbf, 0x14, 0xa1, 0x16, 0x74, 0x67, 0x6e, 0xe4, 0x4e, 0x99, 0xcf, 0x12,
+	0x86, 0xdc, 0xac, 0x8d, 0x8c, 0x71, 0x9b, 0x3e, 0xf1, 0x59, 0xbf, 0x75, 0x68, 0x9c, 0x2c, 0x02,
+	0xcf, 0x47, 0xb2, 0x0d, 0x2d, 0xe6, 0x61, 0x90, 0xb0, 0x44, 0xf6, 0x54, 0xda, 0xe4, 0x12, 0x06,
+	0x4f, 0xbb, 0xe6, 0xa6, 0x3e, 0x32, 0xc6, 0x9d, 0xc3, 0xbd, 0x49, 0x45, 0x76, 0x92, 0x03, 0x4d,
+	0x54, 0xbe, 0xfc, 0x2c, 0x48, 0xe2, 0x94, 0xf6, 0x54, 0x76, 0x9c, 0xec, 0x40, 0x3b, 0x73, 0xb8,
+	0xc9, 0x22, 0x46, 0xb3, 0x26, 0x6a, 0x55, 0x8e, 0xec, 0x35, 0x61, 0xf7, 0xc8, 0x13, 0xf7, 0x3e,
+	0x32, 0xeb, 0x23, 0x6d, 0x6c, 0xd0, 0xca, 0x41, 0xde, 0xc2, 0x96, 0xca, 0xc0, 0xa9, 0x80, 0x1a,
+	0x02, 0x68, 0x53, 0x7d, 0xbd, 0x96, 0x8f, 0xdb, 0x37, 0x40, 0x9e, 0xf7, 0x45, 0x86, 0x60, 0xc8,
+	0x11, 0xb4, 0x69, 0xf6, 0x49, 0x26, 0x50, 0x7f, 0x74, 0xfd, 0x05, 0x0a, 0xdd, 0x3b, 0x87, 0xa6,
+	0xca, 0x4f, 0x05, 0xa0, 0x79, 0xd8, 0xb1, 0xfe, 0x5e, 0xb3, 0x7e, 0xc0, 0x20, 0xa7, 0xfe, 0x31,
+	0x0c, 0x12, 0x97, 0x05, 0x18, 0x93, 0x97, 0xd0, 0x98, 0x0a, 0x97, 0xc0, 0xee, 0x1c, 0x92, 0xe7,
+	0x3a, 0xd1, 0x22, 0x82, 0x1c, 0x65, 0x9b, 0xc4, 0x1e, 0xdd, 0x04, 0x9d, 0xa5, 0xd5, 0xd0, 0x05,
+	0xa3, 0x7f, 0x8a, 0x57, 0xb5, 0xfc, 0x45, 0xad, 0x65, 0x0c, 0x6b, 0xd6, 0x05, 0xb4, 0x4e, 0xa9,
+	0x8d, 0xae, 0x87, 0xb1, 0xca, 0xa5, 0x9b, 0x73, 0xe9, 0x82, 0x26, 0xf7, 0x47, 0x0b, 0x48, 0x1f,
+	0xf4, 0x48, 0xee, 0x8a, 0x1e, 0x09, 0x9b, 0x79, 0x85, 0xfa, 0x3a, 0xf3, 0xac, 0x1d, 0x68, 0x9d,
+	0xda, 0xeb, 0xb0, 0xac, 0x37, 0x00, 0x5f, 0x8f, 0xd6, 0xbf, 0x2f, 0xa3, 0x15, 0xfd, 0x5d, 0x43,
+	0xcb, 0x96, 0xfd, 0x6d, 0x42, 0xe3, 0x0e, 0x53, 0x87, 0x79, 0x22, 0xad, 0x47, 0xeb, 0x77, 0x98,
+	0x9e, 0x7b, 0x99, 0x9b, 0xe3, 0x83, 0x13, 0x84, 0x45, 0xa7, 0x75, 0x8e, 0x0f, 0x9f, 0x43, 0xf2,
+	0x1f, 0xb4, 0xe6, 0x71, 0xb8, 0x88, 0xb2, 0x78, 0x43, 0xa0, 0x36, 0x85, 0x7d, 0xee, 0x59, 0xfb,
+	0xd0, 0xb0, 0xa9, 0xd8, 0xa3, 0x3d, 0xa8, 0x89, 0x5d, 0xd4, 0xc4, 0x2e, 0x6e, 0xa8, 0x1a, 0x8b,
+	0x08, 0x2a, 0x9e, 0xad, 0xd7, 0x50, 0x17, 0xe6, 0xba, 0x16, 0x0a, 0x36, 0x7a, 0xc5, 0xf6, 0xa7,
+	0x0e, 0xe6, 0x59, 0x0e, 0x86, 0xde, 0x65, 0xfe, 0x77, 0x5f, 0x15, 0xbf, 0x17, 0x79, 0x07, 0x9d,
+	0x4c, 0x0a, 0xe7, 0xbb, 0xe0, 0x55, 0x0c, 0x78, 0x4b, 0x2d, 0x5e, 0x29, 0x45, 0x55, 0xd5, 0x0e,
+	0xa0, 0x7d, 0x4a, 0x65, 0x5a, 0xbe, 0x5f, 0xff, 0xaa, 0x69, 0x72, 0x94, 0xb4, 0x1a, 0x6a, 0x96,
+	0x52, 0x56, 0xc2, 0x15, 0x29, 0x76, 0x99, 0xa2, 0x54, 0xb1, 0xcb, 0x2a, 0xb7, 0xcf, 0x53, 0xec,
+	0xb2, 0x4a, 0x39, 0x1a, 0x13, 0x9a, 0x91, 0x9b, 0xfa, 0xa1, 0x5b, 0x6a, 0x5d, 0x98, 0xd6, 0x1f,
+	0x1d, 0x06, 0x92, 0x78, 0xa1, 0x03, 0xf9, 0x1f, 0x06, 0x2c, 0xe0, 0x89, 0xeb, 0xfb, 0x6e, 0x06,
+	0x98, 0xc9, 0xa9, 0x8b, 0x1f, 0xa8, 0xaf, 0xba, 0xcf, 0x3d, 0xf2, 0x0a, 0x9a, 0xf9, 0x8a, 0x73,
+	0xd3, 0x10, 0x13, 0x5a, 0xf5, 0x17, 0xc8, 0x10, 0xf2, 0x0d, 0x36, 0x50, 0x4a, 0x2e, 0x2f, 0xaa,
+	0x89, 0x22, 0xef, 0x40, 0xcd, 0x5b, 0x6a, 0x67, 0xb2, 0x3c, 0xa7, 0xfc, 0xe2, 0x0c, 0x71, 0xc9,
+	0x4d, 0xf6, 0xa0, 0x1f, 0x2d, 0xa6, 0x3e, 0x9b, 0x95, 0xe0, 0xb7, 0x82, 0x6b, 0x2f, 0xf7, 0xca,
+	0x30, 0xa1, 0x85, 0xe7, 0xb1, 0x60, 0x6e, 0xce, 0xa5, 0x16, 0xc2, 0xdc, 0x66, 0xb0, 0xb9, 0xb2,
+	0xd6, 0x8a, 0x2b, 0x72, 0xfc, 0xf4, 0x8a, 0xec, 0xaa, 0xfd, 0xaf, 0xdb, 0x2b, 0xe5, 0xa2, 0x9c,
+	0x0c, 0x6e, 0x7a, 0x93, 0xfd, 0x0f, 0x55, 0xd2, 0xb4, 0x21, 0x8e, 0xfb, 0xd1, 0xdf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x46, 0x53, 0x24, 0xf9, 0x74, 0x06, 0x00, 0x00,
 }
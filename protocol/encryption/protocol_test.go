@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
 )
 
 func TestProtocolServiceTestSuite(t *testing.T) {
@@ -104,6 +105,36 @@ func (s *ProtocolServiceTestSuite) TestBuildEncryptedMessage() {
 	s.NotEqualf(payload, encryptedPayload, "It encrypts the payload")
 }
 
+func (s *ProtocolServiceTestSuite) TestBuildEncryptedMessageForInstallations() {
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	payload := []byte("test")
+	skipAll := func(*multidevice.Installation) bool { return false }
+	onlyBob := func(installation *multidevice.Installation) bool { return installation.ID == "2" }
+
+	// Installations we don't know of are not filtered
+	msgSpec, err := s.alice.BuildEncryptedMessageForInstallations(aliceKey, &bobKey.PublicKey, payload, skipAll)
+	s.Require().NoError(err)
+	s.Require().Contains(msgSpec.Message.GetEncryptedMessage(), "none")
+
+	// Alice receives Bob's bundle
+	msgSpec, err = s.bob.BuildEncryptedMessage(bobKey, &aliceKey.PublicKey, payload)
+	s.Require().NoError(err)
+	_, err = s.alice.HandleMessage(aliceKey, &bobKey.PublicKey, msgSpec.Message, []byte("message-id"))
+	s.Require().NoError(err)
+
+	_, err = s.alice.BuildEncryptedMessageForInstallations(aliceKey, &bobKey.PublicKey, payload, skipAll)
+	s.Require().Equal(ErrNoInstallations, err)
+
+	msgSpec, err = s.alice.BuildEncryptedMessageForInstallations(aliceKey, &bobKey.PublicKey, payload, onlyBob)
+	s.Require().NoError(err)
+	s.Require().Len(msgSpec.Message.GetEncryptedMessage(), 1)
+	s.Require().Contains(msgSpec.Message.GetEncryptedMessage(), "2")
+}
+
 func (s *ProtocolServiceTestSuite) TestBuildAndReadEncryptedMessage() {
 	bobKey, err := crypto.GenerateKey()
 	s.Require().NoError(err)
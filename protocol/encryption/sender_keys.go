@@ -0,0 +1,129 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+// Sender keys are hash ratchet keys owned by a single member of a group chat.
+// Each member distributes its own key to the other members over the pairwise
+// double ratchet sessions, and encrypts the messages it sends to the group with
+// it, so that a message is encrypted once regardless of the size of the group.
+// A new key is generated whenever the members, or their installations, change.
+
+// senderKeyGroupIDLength is the length of a compressed public key followed by a keccak256 hash
+const senderKeyGroupIDLength = 33 + 32
+
+// ErrSenderKeyNotOwner means that a sender key was distributed or used by someone else than its owner
+var ErrSenderKeyNotOwner = errors.New("sender key not owned by the sender")
+
+// SenderKeyGroupID returns the hash ratchet group id of the sender key of owner in a group chat.
+// Sender key group ids are prefixed with the compressed public key of their owner, so that
+// keys distributed or used by any other member can be rejected.
+func SenderKeyGroupID(chatID string, owner *ecdsa.PublicKey) []byte {
+	return append(crypto.CompressPubkey(owner), crypto.Keccak256([]byte(chatID))...)
+}
+
+// isSenderKeyOwner returns false if groupID is the id of a sender key not owned by publicKey
+func isSenderKeyOwner(groupID []byte, publicKey *ecdsa.PublicKey) bool {
+	if len(groupID) != senderKeyGroupIDLength {
+		return true
+	}
+	if publicKey == nil {
+		return false
+	}
+	return bytes.Equal(groupID[:33], crypto.CompressPubkey(publicKey))
+}
+
+// isSenderKeyMessage returns whether msg is a message encrypted with a sender key,
+// rather than the distribution of a sender key
+func isSenderKeyMessage(msg *EncryptedMessageProtocol) bool {
+	header := msg.GetHRHeader()
+	return header != nil && header.SeqNo != 0 && len(header.GroupId) == senderKeyGroupIDLength
+}
+
+// senderKeyDistribution fingerprints the recipients of a sender key and their installations
+func (p *Protocol) senderKeyDistribution(recipients []*ecdsa.PublicKey) ([]byte, error) {
+	var entries []string
+	for _, recipient := range recipients {
+		identity := string(crypto.CompressPubkey(recipient))
+		entries = append(entries, identity)
+
+		installations, err := p.multidevice.GetActiveInstallations(recipient)
+		if err != nil {
+			return nil, err
+		}
+		for _, installation := range installations {
+			entries = append(entries, identity+installation.ID)
+		}
+	}
+	sort.Strings(entries)
+
+	var distribution []byte
+	for _, entry := range entries {
+		distribution = append(distribution, entry...)
+	}
+	return crypto.Keccak256(distribution), nil
+}
+
+// BuildSenderKeyMessage encrypts payload with our sender key for the given group chat.
+// If there is no sender key yet, or the recipients changed since it was distributed,
+// a new one is generated and a key exchange message for each recipient, to be sent
+// before the message itself, is returned as well.
+func (p *Protocol) BuildSenderKeyMessage(myIdentityKey *ecdsa.PrivateKey, chatID string, recipients []*ecdsa.PublicKey, payload []byte) (*ProtocolMessageSpec, []*ProtocolMessageSpec, error) {
+	groupID := SenderKeyGroupID(chatID, &myIdentityKey.PublicKey)
+
+	distribution, err := p.senderKeyDistribution(recipients)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	distributed, err := p.encryptor.persistence.GetSenderKeyDistribution(groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyID, err := p.encryptor.persistence.GetCurrentKeyForGroup(groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keyExchangeSpecs []*ProtocolMessageSpec
+	if keyID == 0 || !bytes.Equal(distribution, distributed) {
+		keyID, err = p.GenerateHashRatchetKey(groupID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, recipient := range recipients {
+			spec, err := p.BuildHashRatchetKeyExchangeMessage(myIdentityKey, recipient, groupID, []uint32{keyID})
+			if err != nil {
+				return nil, nil, err
+			}
+			keyExchangeSpecs = append(keyExchangeSpecs, spec)
+		}
+	}
+
+	spec, err := p.BuildHashRatchetMessage(groupID, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return spec, keyExchangeSpecs, nil
+}
+
+// ConfirmSenderKeyDistribution records that our current sender key for the given
+// group chat has been sent to the recipients, so that it's not distributed again
+func (p *Protocol) ConfirmSenderKeyDistribution(myIdentityKey *ecdsa.PrivateKey, chatID string, recipients []*ecdsa.PublicKey) error {
+	distribution, err := p.senderKeyDistribution(recipients)
+	if err != nil {
+		return err
+	}
+
+	return p.encryptor.persistence.SaveSenderKeyDistribution(SenderKeyGroupID(chatID, &myIdentityKey.PublicKey), distribution)
+}
@@ -0,0 +1,102 @@
+package encryption
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+func (s *ProtocolServiceTestSuite) TestSenderKeyMessage() {
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	chatID := "group-chat-id"
+	recipients := []*ecdsa.PublicKey{&bobKey.PublicKey}
+
+	// The sender key is distributed with the first message
+	payload1 := []byte("group msg 1")
+	msg1, keyExchange, err := s.alice.BuildSenderKeyMessage(aliceKey, chatID, recipients, payload1)
+	s.Require().NoError(err)
+	s.Require().Len(keyExchange, 1)
+	s.Require().NoError(s.alice.ConfirmSenderKeyDistribution(aliceKey, chatID, recipients))
+
+	// The message can't be decrypted before the key is received
+	_, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, msg1.Message, []byte("message-id-1"))
+	s.Require().Equal(ErrHashRatchetGroupIDNotFound, err)
+
+	response, err := s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, keyExchange[0].Message, []byte("key-exchange-id"))
+	s.Require().NoError(err)
+	s.Require().Len(response.HashRatchetInfo, 1)
+	s.Require().Equal(SenderKeyGroupID(chatID, &aliceKey.PublicKey), response.HashRatchetInfo[0].GroupID)
+
+	response, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, msg1.Message, []byte("message-id-1"))
+	s.Require().NoError(err)
+	s.Require().Equal(payload1, response.DecryptedMessage)
+
+	// The same key is used as long as the recipients don't change
+	payload2 := []byte("group msg 2")
+	msg2, keyExchange, err := s.alice.BuildSenderKeyMessage(aliceKey, chatID, recipients, payload2)
+	s.Require().NoError(err)
+	s.Require().Empty(keyExchange)
+
+	response, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, msg2.Message, []byte("message-id-2"))
+	s.Require().NoError(err)
+	s.Require().Equal(payload2, response.DecryptedMessage)
+}
+
+func (s *ProtocolServiceTestSuite) TestSenderKeyRotatedOnMembershipChange() {
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	charlieKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	chatID := "group-chat-id"
+	groupID := SenderKeyGroupID(chatID, &aliceKey.PublicKey)
+	recipients := []*ecdsa.PublicKey{&bobKey.PublicKey, &charlieKey.PublicKey}
+
+	_, keyExchange, err := s.alice.BuildSenderKeyMessage(aliceKey, chatID, recipients, []byte("group msg 1"))
+	s.Require().NoError(err)
+	s.Require().Len(keyExchange, 2)
+	s.Require().NoError(s.alice.ConfirmSenderKeyDistribution(aliceKey, chatID, recipients))
+
+	keyID1, err := s.alice.GetCurrentKeyForGroup(groupID)
+	s.Require().NoError(err)
+
+	// Charlie leaves the group, a new key is distributed to bob only
+	recipients = recipients[:1]
+	_, keyExchange, err = s.alice.BuildSenderKeyMessage(aliceKey, chatID, recipients, []byte("group msg 2"))
+	s.Require().NoError(err)
+	s.Require().Len(keyExchange, 1)
+
+	keyID2, err := s.alice.GetCurrentKeyForGroup(groupID)
+	s.Require().NoError(err)
+	s.Require().NotEqual(keyID1, keyID2)
+}
+
+func (s *ProtocolServiceTestSuite) TestSenderKeyNotOwner() {
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	charlieKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	// Alice distributes a key pretending to be charlie's sender key
+	groupID := SenderKeyGroupID("group-chat-id", &charlieKey.PublicKey)
+	keyID, err := s.alice.GenerateHashRatchetKey(groupID)
+	s.Require().NoError(err)
+
+	keyExchange, err := s.alice.BuildHashRatchetKeyExchangeMessage(aliceKey, &bobKey.PublicKey, groupID, []uint32{keyID})
+	s.Require().NoError(err)
+
+	_, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, keyExchange.Message, []byte("key-exchange-id"))
+	s.Require().Equal(ErrSenderKeyNotOwner, err)
+
+	currentKeyID, err := s.bob.GetCurrentKeyForGroup(groupID)
+	s.Require().NoError(err)
+	s.Require().Zero(currentKeyID)
+}
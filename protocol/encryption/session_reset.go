@@ -0,0 +1,94 @@
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+// A double ratchet session gets out of sync when one side loses its state, for
+// example after restoring a backup or losing its pre-keys. Messages from the
+// other side can't be decrypted anymore, so after a few consecutive failures
+// the session is reset: both sides drop their sessions and negotiate new ones
+// through X3DH, using the bundle sent along with the reset.
+
+const (
+	// sessionResetThreshold is the number of consecutive decryption failures
+	// after which a session is considered out of sync
+	sessionResetThreshold = 3
+	// sessionResetInterval is the minimum time between two resets of the sessions with a peer
+	sessionResetInterval = 10 * time.Minute
+)
+
+// ErrSessionOutOfSync means that repeated messages from a peer could not be decrypted,
+// and the sessions with them should be reset
+var ErrSessionOutOfSync = errors.New("session out of sync")
+
+type sessionFailures struct {
+	count     int
+	lastReset time.Time
+}
+
+// isSessionMessage returns whether msg is encrypted with a double ratchet session
+func isSessionMessage(msg *EncryptedMessageProtocol) bool {
+	return msg != nil && (msg.GetDRHeader() != nil || msg.GetX3DHHeader() != nil)
+}
+
+func sessionFailuresID(theirIdentityKey *ecdsa.PublicKey) string {
+	return string(crypto.CompressPubkey(theirIdentityKey))
+}
+
+// recordSessionFailure records a failure to decrypt a message from theirIdentityKey,
+// and returns true if the sessions with them should be reset
+func (p *Protocol) recordSessionFailure(theirIdentityKey *ecdsa.PublicKey) bool {
+	p.sessionFailuresMutex.Lock()
+	defer p.sessionFailuresMutex.Unlock()
+
+	id := sessionFailuresID(theirIdentityKey)
+	failures, ok := p.sessionFailures[id]
+	if !ok {
+		failures = &sessionFailures{}
+		p.sessionFailures[id] = failures
+	}
+
+	failures.count++
+	if failures.count < sessionResetThreshold || time.Since(failures.lastReset) < sessionResetInterval {
+		return false
+	}
+
+	failures.count = 0
+	failures.lastReset = time.Now()
+	return true
+}
+
+// clearSessionFailures is called when a message from theirIdentityKey is decrypted successfully
+func (p *Protocol) clearSessionFailures(theirIdentityKey *ecdsa.PublicKey) {
+	p.sessionFailuresMutex.Lock()
+	defer p.sessionFailuresMutex.Unlock()
+
+	if failures, ok := p.sessionFailures[sessionFailuresID(theirIdentityKey)]; ok {
+		failures.count = 0
+	}
+}
+
+// ResetSession drops the double ratchet sessions with the given installation of theirIdentityKey,
+// or with all their installations if installationID is empty, so that new ones are negotiated
+// through X3DH the next time a message is sent to them
+func (p *Protocol) ResetSession(theirIdentityKey *ecdsa.PublicKey, installationID string) error {
+	p.clearSessionFailures(theirIdentityKey)
+	return p.encryptor.ResetSession(theirIdentityKey, installationID)
+}
+
+// BuildSessionResetMessage resets our sessions with theirIdentityKey and builds a message
+// asking them to do the same. The message is encrypted with DH, as the sessions can't be
+// trusted anymore, and carries our signed bundle so that they can negotiate new sessions.
+func (p *Protocol) BuildSessionResetMessage(myIdentityKey *ecdsa.PrivateKey, theirIdentityKey *ecdsa.PublicKey, payload []byte) (*ProtocolMessageSpec, error) {
+	if err := p.ResetSession(theirIdentityKey, ""); err != nil {
+		return nil, err
+	}
+
+	return p.BuildDHMessage(myIdentityKey, theirIdentityKey, payload)
+}
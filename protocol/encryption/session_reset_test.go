@@ -0,0 +1,79 @@
+package encryption
+
+import (
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+func (s *EncryptionServiceTestSuite) TestSessionReset() {
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobBundle, err := s.bob.GetBundle(bobKey)
+	s.Require().NoError(err)
+
+	aliceBundle, err := s.alice.GetBundle(aliceKey)
+	s.Require().NoError(err)
+
+	_, err = s.alice.ProcessPublicBundle(aliceKey, bobBundle)
+	s.Require().NoError(err)
+
+	_, err = s.bob.ProcessPublicBundle(bobKey, aliceBundle)
+	s.Require().NoError(err)
+
+	// Alice and bob establish a session
+	response, err := s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, []byte("hello"))
+	s.Require().NoError(err)
+	_, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, response.Message, defaultMessageID)
+	s.Require().NoError(err)
+
+	response, err = s.bob.BuildEncryptedMessage(bobKey, &aliceKey.PublicKey, []byte("hello back"))
+	s.Require().NoError(err)
+	_, err = s.alice.HandleMessage(aliceKey, &bobKey.PublicKey, response.Message, defaultMessageID)
+	s.Require().NoError(err)
+
+	// Bob loses his session state
+	s.Require().NoError(s.bob.encryptor.persistence.DeleteRatchetInfo(crypto.CompressPubkey(&aliceKey.PublicKey), ""))
+
+	for i := 1; i <= sessionResetThreshold; i++ {
+		response, err = s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, []byte("lost"))
+		s.Require().NoError(err)
+		_, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, response.Message, defaultMessageID)
+		s.Require().Error(err)
+		if i < sessionResetThreshold {
+			s.Require().NotEqual(ErrSessionOutOfSync, err)
+		} else {
+			s.Require().Equal(ErrSessionOutOfSync, err)
+		}
+	}
+
+	// Bob asks alice to reset the session
+	resetPayload := []byte("reset")
+	response, err = s.bob.BuildSessionResetMessage(bobKey, &aliceKey.PublicKey, resetPayload)
+	s.Require().NoError(err)
+	s.Require().Len(response.Message.GetBundles(), 1)
+
+	decrypted, err := s.alice.HandleMessage(aliceKey, &bobKey.PublicKey, response.Message, defaultMessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(resetPayload, decrypted.DecryptedMessage)
+
+	s.Require().NoError(s.alice.ResetSession(&bobKey.PublicKey, bobInstallationID))
+
+	// A new session is negotiated
+	cleartext := []byte("resent")
+	response, err = s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, cleartext)
+	s.Require().NoError(err)
+	s.Require().NotNil(response.Message.GetEncryptedMessage()[bobInstallationID].GetX3DHHeader())
+
+	decrypted, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, response.Message, defaultMessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(cleartext, decrypted.DecryptedMessage)
+
+	response, err = s.bob.BuildEncryptedMessage(bobKey, &aliceKey.PublicKey, cleartext)
+	s.Require().NoError(err)
+	decrypted, err = s.alice.HandleMessage(aliceKey, &bobKey.PublicKey, response.Message, defaultMessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(cleartext, decrypted.DecryptedMessage)
+}
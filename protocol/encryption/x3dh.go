@@ -5,6 +5,7 @@ import (
 	"errors"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/status-im/status-go/eth-node/crypto"
@@ -44,6 +45,41 @@ func buildSignatureMaterial(bundle *Bundle) []byte {
 
 }
 
+// hasCapabilities returns whether any installation of the bundle advertises capabilities
+func hasCapabilities(bundle *Bundle) bool {
+	for _, signedPreKey := range bundle.GetSignedPreKeys() {
+		if len(signedPreKey.GetCapabilities()) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// buildCapabilitiesSignatureMaterial extends the signature material of the bundle
+// with the capabilities of each installation
+func buildCapabilitiesSignatureMaterial(bundle *Bundle) []byte {
+	signedPreKeys := bundle.GetSignedPreKeys()
+	signatureMaterial := buildSignatureMaterial(bundle)
+	var keys []string
+
+	for k := range signedPreKeys {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, installationID := range keys {
+		capabilities := make([]string, len(signedPreKeys[installationID].GetCapabilities()))
+		copy(capabilities, signedPreKeys[installationID].GetCapabilities())
+		sort.Strings(capabilities)
+
+		signatureMaterial = append(signatureMaterial, []byte(installationID)...)
+		signatureMaterial = append(signatureMaterial, []byte(strings.Join(capabilities, ","))...)
+	}
+
+	return signatureMaterial
+}
+
 // SignBundle signs the bundle and refreshes the timestamps
 func SignBundle(identity *ecdsa.PrivateKey, bundleContainer *BundleContainer) error {
 	bundleContainer.Bundle.Timestamp = time.Now().UnixNano()
@@ -54,6 +90,17 @@ func SignBundle(identity *ecdsa.PrivateKey, bundleContainer *BundleContainer) er
 		return err
 	}
 	bundleContainer.Bundle.Signature = signature
+
+	bundleContainer.Bundle.CapabilitiesSignature = nil
+	if hasCapabilities(bundleContainer.GetBundle()) {
+		capabilitiesSignatureMaterial := buildCapabilitiesSignatureMaterial(bundleContainer.GetBundle())
+		capabilitiesSignature, err := crypto.Sign(crypto.Keccak256(capabilitiesSignatureMaterial), identity)
+		if err != nil {
+			return err
+		}
+		bundleContainer.Bundle.CapabilitiesSignature = capabilitiesSignature
+	}
+
 	return nil
 }
 
@@ -113,6 +160,20 @@ func ExtractIdentity(bundle *Bundle) (*ecdsa.PublicKey, error) {
 		return nil, errors.New("identity key and signature mismatch")
 	}
 
+	if hasCapabilities(bundle) {
+		recoveredKey, err := crypto.SigToPub(
+			crypto.Keccak256(buildCapabilitiesSignatureMaterial(bundle)),
+			bundle.GetCapabilitiesSignature(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if crypto.PubkeyToAddress(*recoveredKey) != crypto.PubkeyToAddress(*bundleIdentityKey) {
+			return nil, errors.New("identity key and capabilities signature mismatch")
+		}
+	}
+
 	return recoveredKey, nil
 }
 
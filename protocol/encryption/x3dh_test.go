@@ -146,6 +146,46 @@ func TestExtractIdentity(t *testing.T) {
 	)
 }
 
+func TestExtractIdentityWithCapabilities(t *testing.T) {
+	privateKey, err := crypto.ToECDSA([]byte(alicePrivateKey))
+	require.NoError(t, err, "Private key should be generated without errors")
+
+	bundleContainer, err := NewBundleContainer(privateKey, "1")
+	require.NoError(t, err, "Bundle container should be created successfully")
+
+	bundle := bundleContainer.Bundle
+	bundle.GetSignedPreKeys()["1"].Capabilities = []string{"b", "a"}
+
+	err = SignBundle(privateKey, bundleContainer)
+	require.NoError(t, err, "Bundle container should be signed successfully")
+	require.NotNil(t, bundle.CapabilitiesSignature)
+
+	recoveredPublicKey, err := ExtractIdentity(bundle)
+	require.NoError(t, err, "Public key should be recovered from the bundle successfully")
+	require.Equal(t, privateKey.PublicKey, *recoveredPublicKey)
+
+	// Older clients only check the signature without capabilities
+	signatureMaterial := append([]byte("1"), bundle.GetSignedPreKeys()["1"].GetSignedPreKey()...)
+	signatureMaterial = append(signatureMaterial, []byte("0")...)
+	signatureMaterial = append(signatureMaterial, []byte(fmt.Sprint(bundle.GetTimestamp()))...)
+	recoveredPublicKey, err = crypto.SigToPub(crypto.Keccak256(signatureMaterial), bundle.Signature)
+	require.NoError(t, err)
+	require.Equal(t, privateKey.PublicKey, *recoveredPublicKey)
+
+	// Capabilities can't be tampered with
+	bundle.GetSignedPreKeys()["1"].Capabilities = []string{"a", "b", "c"}
+	_, err = ExtractIdentity(bundle)
+	require.Error(t, err)
+
+	bundle.GetSignedPreKeys()["1"].Capabilities = []string{"a", "b"}
+	_, err = ExtractIdentity(bundle)
+	require.NoError(t, err)
+
+	bundle.CapabilitiesSignature = nil
+	_, err = ExtractIdentity(bundle)
+	require.Error(t, err)
+}
+
 // Alice wants to send a message to Bob
 func TestX3dhActive(t *testing.T) {
 	bobIdentityKey, err := crypto.ToECDSA([]byte(bobPrivateKey))
@@ -5,8 +5,10 @@ import (
 )
 
 var (
-	ErrChatIDEmpty     = errors.New("chat ID is empty")
-	ErrChatNotFound    = errors.New("can't find chat")
-	ErrNotImplemented  = errors.New("not implemented")
-	ErrContactNotFound = errors.New("contact not found")
+	ErrChatIDEmpty       = errors.New("chat ID is empty")
+	ErrChatNotFound      = errors.New("can't find chat")
+	ErrNotImplemented    = errors.New("not implemented")
+	ErrContactNotFound   = errors.New("contact not found")
+	ErrChatDraftOutdated = errors.New("a newer draft exists for this chat")
+	ErrInvalidEmoji      = errors.New("invalid emoji")
 )
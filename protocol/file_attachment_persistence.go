@@ -0,0 +1,85 @@
+package protocol
+
+import (
+	"database/sql"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (db sqlitePersistence) SaveFileAttachment(hash []byte, payload []byte) error {
+	_, err := db.db.Exec(`INSERT INTO file_attachments(hash, payload) VALUES (?, ?)`, hash, payload)
+	return err
+}
+
+func (db sqlitePersistence) FileAttachment(hash []byte) ([]byte, error) {
+	var payload []byte
+	err := db.db.QueryRow(`SELECT payload FROM file_attachments WHERE hash = ?`, hash).Scan(&payload)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return payload, err
+}
+
+func (db sqlitePersistence) HasFileAttachment(hash []byte) (bool, error) {
+	var exists bool
+	err := db.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM file_attachments WHERE hash = ?)`, hash).Scan(&exists)
+	return exists, err
+}
+
+// SaveFileAttachmentChunk stores a chunk received from sender for the file described
+// by the message, chunks already received for the same index are kept
+func (db sqlitePersistence) SaveFileAttachmentChunk(sender string, chunk *protobuf.FileChunk) error {
+	_, err := db.db.Exec(`INSERT INTO file_attachment_chunks(message_id, sender, file_hash, chunk_index, payload) VALUES (?, ?, ?, ?, ?)`,
+		chunk.MessageId,
+		sender,
+		chunk.FileHash,
+		chunk.Index,
+		chunk.Payload,
+	)
+	return err
+}
+
+// FileAttachmentChunksCount returns how many chunks of the file described by the message have been received so far
+func (db sqlitePersistence) FileAttachmentChunksCount(messageID string, sender string) (uint32, error) {
+	var count uint32
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM file_attachment_chunks WHERE message_id = ? AND sender = ?`, messageID, sender).Scan(&count)
+	return count, err
+}
+
+// PendingFileAttachmentChunksCount returns how many chunks sent by sender are waiting to be assembled
+func (db sqlitePersistence) PendingFileAttachmentChunksCount(sender string) (int, error) {
+	var count int
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM file_attachment_chunks WHERE sender = ?`, sender).Scan(&count)
+	return count, err
+}
+
+// FileAttachmentChunks returns the payloads of the received chunks of the file described by the message, by index
+func (db sqlitePersistence) FileAttachmentChunks(messageID string, sender string, hash []byte) (map[uint32][]byte, error) {
+	rows, err := db.db.Query(`SELECT chunk_index, payload FROM file_attachment_chunks WHERE message_id = ? AND sender = ? AND file_hash = ?`, messageID, sender, hash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chunks := make(map[uint32][]byte)
+	for rows.Next() {
+		var index uint32
+		var payload []byte
+		if err := rows.Scan(&index, &payload); err != nil {
+			return nil, err
+		}
+		chunks[index] = payload
+	}
+
+	return chunks, rows.Err()
+}
+
+func (db sqlitePersistence) DeleteFileAttachmentChunk(messageID string, sender string, index uint32) error {
+	_, err := db.db.Exec(`DELETE FROM file_attachment_chunks WHERE message_id = ? AND sender = ? AND chunk_index = ?`, messageID, sender, index)
+	return err
+}
+
+func (db sqlitePersistence) DeleteFileAttachmentChunks(messageID string, sender string) error {
+	_, err := db.db.Exec(`DELETE FROM file_attachment_chunks WHERE message_id = ? AND sender = ?`, messageID, sender)
+	return err
+}
@@ -15,10 +15,6 @@ const (
 var colorHashAlphabet [][]int
 
 func GenerateFor(pubkey string) (hash multiaccounts.ColourHash, err error) {
-	if len(colorHashAlphabet) == 0 {
-		colorHashAlphabet = makeColorHashAlphabet(colorHashSegmentMaxLen, colorHashColorsCount)
-	}
-
 	compressedKey, err := identity.ToCompressedKey(pubkey)
 	if err != nil {
 		return nil, err
@@ -29,7 +25,16 @@ func GenerateFor(pubkey string) (hash multiaccounts.ColourHash, err error) {
 		return nil, err
 	}
 
-	return toColorHash(new(big.Int).SetBytes(slices[2]), &colorHashAlphabet, colorHashColorsCount), nil
+	return GenerateForBytes(slices[2]), nil
+}
+
+// GenerateForBytes returns the color hash of up to 10 bytes of data
+func GenerateForBytes(data []byte) multiaccounts.ColourHash {
+	if len(colorHashAlphabet) == 0 {
+		colorHashAlphabet = makeColorHashAlphabet(colorHashSegmentMaxLen, colorHashColorsCount)
+	}
+
+	return toColorHash(new(big.Int).SetBytes(data), &colorHashAlphabet, colorHashColorsCount)
 }
 
 // [[1 0] [1 1] [1 2] ... [units, colors-1]]
@@ -19,14 +19,6 @@ const (
 var emojisAlphabet []string
 
 func GenerateFor(pubkey string) ([]string, error) {
-	if len(emojisAlphabet) == 0 {
-		alphabet, err := loadAlphabet()
-		if err != nil {
-			return nil, err
-		}
-		emojisAlphabet = *alphabet
-	}
-
 	compressedKey, err := identity.ToCompressedKey(pubkey)
 	if err != nil {
 		return nil, err
@@ -37,7 +29,20 @@ func GenerateFor(pubkey string) ([]string, error) {
 		return nil, err
 	}
 
-	return toEmojiHash(new(big.Int).SetBytes(slices[1]), emojiHashLen, &emojisAlphabet)
+	return GenerateForBytes(slices[1])
+}
+
+// GenerateForBytes returns the emoji hash of up to 20 bytes of data
+func GenerateForBytes(data []byte) ([]string, error) {
+	if len(emojisAlphabet) == 0 {
+		alphabet, err := loadAlphabet()
+		if err != nil {
+			return nil, err
+		}
+		emojisAlphabet = *alphabet
+	}
+
+	return toEmojiHash(new(big.Int).SetBytes(data), emojiHashLen, &emojisAlphabet)
 }
 
 func loadAlphabet() (*[]string, error) {
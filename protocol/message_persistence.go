@@ -1,7 +1,9 @@
 package protocol
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -96,7 +98,20 @@ func (db sqlitePersistence) tableUserMessagesAllFields() string {
 		contact_verification_status,
 		mentioned,
 		replied,
-    discord_message_id`
+    discord_message_id,
+		file_name,
+		file_mime_type,
+		file_size,
+		file_hash,
+		file_chunks_count,
+		file_chunk_hashes,
+		video_thumbnail,
+		video_thumbnail_type,
+		video_duration_ms,
+		video_width,
+		video_height,
+		delivered_count,
+		recipients_count`
 }
 
 func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
@@ -146,6 +161,19 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
 		m1.contact_verification_status,
 		m1.mentioned,
 		m1.replied,
+		COALESCE(m1.file_name, ""),
+		COALESCE(m1.file_mime_type, ""),
+		COALESCE(m1.file_size, 0),
+		m1.file_hash,
+		COALESCE(m1.file_chunks_count, 0),
+		m1.file_chunk_hashes,
+		m1.video_thumbnail,
+		COALESCE(m1.video_thumbnail_type, 0),
+		COALESCE(m1.video_duration_ms, 0),
+		COALESCE(m1.video_width, 0),
+		COALESCE(m1.video_height, 0),
+		m1.delivered_count,
+		m1.recipients_count,
     COALESCE(m1.discord_message_id, ""),
     COALESCE(dm.author_id, ""),
     COALESCE(dm.type, ""),
@@ -212,6 +240,9 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	command := &common.CommandParameters{}
 	audio := &protobuf.AudioMessage{}
 	image := &protobuf.ImageMessage{}
+	file := &protobuf.FileMessage{}
+	var fileChunkHashes []byte
+	video := &protobuf.VideoMessage{}
 	discordMessage := &protobuf.DiscordMessage{
 		Author:      &protobuf.DiscordMessageAuthor{},
 		Reference:   &protobuf.DiscordMessageReference{},
@@ -267,6 +298,19 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&contactVerificationState,
 		&message.Mentioned,
 		&message.Replied,
+		&file.Name,
+		&file.MimeType,
+		&file.Size,
+		&file.Hash,
+		&file.ChunksCount,
+		&fileChunkHashes,
+		&video.Thumbnail,
+		&video.ThumbnailType,
+		&video.DurationMs,
+		&video.Width,
+		&video.Height,
+		&message.DeliveredCount,
+		&message.RecipientsCount,
 		&discordMessage.Id,
 		&discordMessage.Author.Id,
 		&discordMessage.Type,
@@ -381,6 +425,11 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		discordMessage.Attachments = append(discordMessage.Attachments, attachment)
 	}
 
+	for len(fileChunkHashes) >= sha256.Size {
+		file.ChunkHashes = append(file.ChunkHashes, fileChunkHashes[:sha256.Size])
+		fileChunkHashes = fileChunkHashes[sha256.Size:]
+	}
+
 	switch message.ContentType {
 	case protobuf.ChatMessage_STICKER:
 		message.Payload = &protobuf.ChatMessage_Sticker{Sticker: sticker}
@@ -398,6 +447,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		}
 		message.Payload = &protobuf.ChatMessage_Image{Image: &img}
 
+	case protobuf.ChatMessage_FILE:
+		message.Payload = &protobuf.ChatMessage_File{File: file}
+
+	case protobuf.ChatMessage_VIDEO:
+		video.File = file
+		message.Payload = &protobuf.ChatMessage_Video{Video: video}
+
 	case protobuf.ChatMessage_DISCORD_MESSAGE:
 		message.Payload = &protobuf.ChatMessage_DiscordMessage{
 			DiscordMessage: discordMessage,
@@ -425,6 +481,19 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		audio = &protobuf.AudioMessage{}
 	}
 
+	video := message.GetVideo()
+	if video == nil {
+		video = &protobuf.VideoMessage{}
+	}
+
+	file := message.GetFile()
+	if file == nil {
+		file = video.File
+	}
+	if file == nil {
+		file = &protobuf.FileMessage{}
+	}
+
 	command := message.CommandParameters
 	if command == nil {
 		command = &common.CommandParameters{}
@@ -513,6 +582,19 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		message.Mentioned,
 		message.Replied,
 		discordMessage.Id,
+		file.Name,
+		file.MimeType,
+		file.Size,
+		file.Hash,
+		file.ChunksCount,
+		bytes.Join(file.ChunkHashes, nil),
+		video.Thumbnail,
+		video.ThumbnailType,
+		video.DurationMs,
+		video.Width,
+		video.Height,
+		message.DeliveredCount,
+		message.RecipientsCount,
 	}, nil
 }
 
@@ -1155,7 +1237,9 @@ func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor str
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.emoji,
+			    e.custom_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -1186,7 +1270,9 @@ func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor str
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.Emoji,
+			&emojiReaction.CustomEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1208,7 +1294,9 @@ func (db sqlitePersistence) EmojiReactionsByChatIDMessageID(chatID string, messa
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.emoji,
+			    e.custom_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -1236,7 +1324,9 @@ func (db sqlitePersistence) EmojiReactionsByChatIDMessageID(chatID string, messa
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.Emoji,
+			&emojiReaction.CustomEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1285,7 +1375,9 @@ func (db sqlitePersistence) EmojiReactionsByChatIDs(chatIDs []string, currCursor
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.emoji,
+			    e.custom_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -1316,7 +1408,9 @@ func (db sqlitePersistence) EmojiReactionsByChatIDs(chatIDs []string, currCursor
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.Emoji,
+			&emojiReaction.CustomEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1717,6 +1811,13 @@ func (db sqlitePersistence) MarkMessagesSeen(chatID string, ids []string) (uint6
 	return countWithMentions + countNoMentions, countWithMentions, err
 }
 
+// UpdateMessageDeliveryCount sets the number of recipients who acknowledged a message,
+// out of the number of recipients it was sent to
+func (db sqlitePersistence) UpdateMessageDeliveryCount(id string, delivered uint32, recipients uint32) error {
+	_, err := db.db.Exec(`UPDATE user_messages SET delivered_count = ?, recipients_count = ? WHERE id = ?`, delivered, recipients, id)
+	return err
+}
+
 func (db sqlitePersistence) UpdateMessageOutgoingStatus(id string, newOutgoingStatus string) error {
 	_, err := db.db.Exec(`
 		UPDATE user_messages
@@ -2023,7 +2124,7 @@ func (db sqlitePersistence) SaveDiscordMessageAttachments(attachments []*protobu
 }
 
 func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err error) {
-	query := "INSERT INTO emoji_reactions(id,clock_value,source,emoji_id,message_id,chat_id,local_chat_id,retracted) VALUES (?,?,?,?,?,?,?,?)"
+	query := "INSERT INTO emoji_reactions(id,clock_value,source,emoji_id,message_id,chat_id,local_chat_id,retracted,emoji,custom_emoji_id) VALUES (?,?,?,?,?,?,?,?,?,?)"
 	stmt, err := db.db.Prepare(query)
 	if err != nil {
 		return
@@ -2038,6 +2139,8 @@ func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err
 		emojiReaction.ChatId,
 		emojiReaction.LocalChatID,
 		emojiReaction.Retracted,
+		emojiReaction.Emoji,
+		emojiReaction.CustomEmojiId,
 	)
 
 	return
@@ -2052,7 +2155,9 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 			    message_id,
 			    chat_id,
 			    local_chat_id,
-			    retracted
+			    retracted,
+			    emoji,
+			    custom_emoji_id
 			FROM
 				emoji_reactions
 			WHERE
@@ -2067,6 +2172,8 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 		&emojiReaction.ChatId,
 		&emojiReaction.LocalChatID,
 		&emojiReaction.Retracted,
+		&emojiReaction.Emoji,
+		&emojiReaction.CustomEmojiId,
 	)
 
 	switch err {
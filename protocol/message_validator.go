@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strconv"
@@ -118,6 +119,30 @@ func ValidateReceivedPairInstallation(message *protobuf.PairInstallation, whispe
 	return nil
 }
 
+func ValidateReceivedInstallationRevocation(message *protobuf.InstallationRevocation, whisperTimestamp uint64) error {
+	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(message.InstallationId)) == 0 {
+		return errors.New("installationId can't be empty")
+	}
+
+	return nil
+}
+
+func ValidateReceivedSessionReset(message *protobuf.SessionReset, whisperTimestamp uint64) error {
+	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(message.InstallationId)) == 0 {
+		return errors.New("installationId can't be empty")
+	}
+
+	return nil
+}
+
 func ValidateReceivedSendTransaction(message *protobuf.SendTransaction, whisperTimestamp uint64) error {
 	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
 		return err
@@ -280,6 +305,26 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		if image.Type == protobuf.ImageType_UNKNOWN_IMAGE_TYPE {
 			return errors.New("image type unknown")
 		}
+
+	case protobuf.ChatMessage_FILE:
+		if err := validateFileMessage(message.GetFile()); err != nil {
+			return err
+		}
+
+	case protobuf.ChatMessage_VIDEO:
+		video := message.GetVideo()
+		if video == nil {
+			return errors.New("no video content")
+		}
+		if err := validateFileMessage(video.File); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(video.File.MimeType, "video/") {
+			return errors.New("video mime type is not a video")
+		}
+		if len(video.Thumbnail) != 0 && video.ThumbnailType == protobuf.ImageType_UNKNOWN_IMAGE_TYPE {
+			return errors.New("video thumbnail type unknown")
+		}
 	}
 
 	if message.ContentType == protobuf.ChatMessage_AUDIO {
@@ -306,6 +351,107 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 	return nil
 }
 
+func validateFileMessage(file *protobuf.FileMessage) error {
+	if file == nil {
+		return errors.New("no file content")
+	}
+	if len(file.Name) == 0 {
+		return errors.New("file name empty")
+	}
+	if file.Size == 0 {
+		return errors.New("file size empty")
+	}
+	if len(file.Hash) != sha256.Size {
+		return errors.New("invalid file hash")
+	}
+	if file.ChunksCount == 0 && uint64(len(file.Payload)) != file.Size {
+		return errors.New("file payload does not match size")
+	}
+	if file.ChunksCount != 0 && len(file.Payload) != 0 {
+		return errors.New("file payload must be sent either inline or in chunks")
+	}
+	if file.ChunksCount != 0 && uint64(file.ChunksCount) != (file.Size+fileChunkSize-1)/fileChunkSize {
+		return errors.New("file chunks count does not match size")
+	}
+	if len(file.ChunkHashes) != int(file.ChunksCount) {
+		return errors.New("file chunk hashes do not match chunks count")
+	}
+	for _, hash := range file.ChunkHashes {
+		if len(hash) != sha256.Size {
+			return errors.New("invalid file chunk hash")
+		}
+	}
+	return nil
+}
+
+func ValidateReceivedFileChunk(chunk *protobuf.FileChunk, whisperTimestamp uint64) error {
+	if err := validateClockValue(chunk.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(chunk.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(chunk.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if len(chunk.FileHash) != sha256.Size {
+		return errors.New("invalid file hash")
+	}
+
+	if chunk.ChunksCount == 0 || chunk.Index >= chunk.ChunksCount {
+		return errors.New("invalid chunk index")
+	}
+
+	if len(chunk.Payload) == 0 {
+		return errors.New("chunk payload empty")
+	}
+
+	return nil
+}
+
+func ValidateReceivedReadReceipts(receipts *protobuf.ReadReceipts, whisperTimestamp uint64) error {
+	if err := validateClockValue(receipts.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(receipts.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(receipts.MessageIds) == 0 {
+		return errors.New("message-ids can't be empty")
+	}
+
+	if len(receipts.MessageIds) > maxReadReceiptsBatchSize {
+		return errors.New("too many message-ids")
+	}
+
+	if receipts.MessageType != protobuf.MessageType_ONE_TO_ONE && receipts.MessageType != protobuf.MessageType_PRIVATE_GROUP {
+		return errors.New("invalid message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedTypingIndicator(indicator *protobuf.TypingIndicator, whisperTimestamp uint64) error {
+	if err := validateClockValue(indicator.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(indicator.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if indicator.MessageType != protobuf.MessageType_ONE_TO_ONE && indicator.MessageType != protobuf.MessageType_PRIVATE_GROUP {
+		return errors.New("invalid message type")
+	}
+
+	return nil
+}
+
 func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimestamp uint64) error {
 	if err := validateClockValue(emoji.Clock, whisperTimestamp); err != nil {
 		return err
@@ -319,7 +465,18 @@ func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimesta
 		return errors.New("chat-id can't be empty")
 	}
 
-	if emoji.Type == protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+	if len(emoji.Emoji) != 0 && !isEmojiSequence(emoji.Emoji) {
+		return errors.New("invalid emoji")
+	}
+
+	if len(emoji.CustomEmojiId) != 0 {
+		if len(emoji.Emoji) != 0 || emoji.Type != protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+			return errors.New("custom emoji reactions can't have an emoji")
+		}
+		if emoji.MessageType != protobuf.MessageType_COMMUNITY_CHAT {
+			return errors.New("custom emoji reactions are only allowed in communities")
+		}
+	} else if emoji.Type == protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE && len(emoji.Emoji) == 0 {
 		return errors.New("unknown emoji reaction type")
 	}
 
@@ -480,6 +480,230 @@ func (s *MessageValidatorSuite) TestValidatePlainTextMessage() {
 				ContentType: protobuf.ChatMessage_AUDIO,
 			},
 		},
+		{
+			Name:             "Valid inlined file message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Name:    "file.txt",
+						Size:    12,
+						Hash:    make([]byte, 32),
+						Payload: []byte("some-payload"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
+		{
+			Name:             "Valid chunked file message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Name:        "file.txt",
+						Size:        1000000,
+						Hash:        make([]byte, 32),
+						ChunksCount: 4,
+						ChunkHashes: [][]byte{make([]byte, 32), make([]byte, 32), make([]byte, 32), make([]byte, 32)},
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
+		{
+			Name:             "Invalid chunked file message, missing chunk hashes",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Name:        "file.txt",
+						Size:        1000000,
+						Hash:        make([]byte, 32),
+						ChunksCount: 4,
+						ChunkHashes: [][]byte{make([]byte, 32)},
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
+		{
+			Name:             "Invalid chunked file message, chunks count not matching size",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Name:        "file.txt",
+						Size:        1000000,
+						Hash:        make([]byte, 32),
+						ChunksCount: 1,
+						ChunkHashes: [][]byte{make([]byte, 32)},
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
+		{
+			Name:             "Invalid file message, invalid hash",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Name:    "file.txt",
+						Size:    12,
+						Hash:    []byte("hash"),
+						Payload: []byte("some-payload"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
+		{
+			Name:             "Valid video message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						File: &protobuf.FileMessage{
+							Name:        "video.mp4",
+							MimeType:    "video/mp4",
+							Size:        1000000,
+							Hash:        make([]byte, 32),
+							ChunksCount: 4,
+							ChunkHashes: [][]byte{make([]byte, 32), make([]byte, 32), make([]byte, 32), make([]byte, 32)},
+						},
+						Thumbnail:     []byte("some-thumbnail"),
+						ThumbnailType: protobuf.ImageType_JPEG,
+						DurationMs:    1000,
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, not a video",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						File: &protobuf.FileMessage{
+							Name:     "video.mp4",
+							MimeType: "text/html",
+							Size:     12,
+							Hash:     make([]byte, 32),
+							Payload:  []byte("some-payload"),
+						},
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, no file",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						DurationMs: 1000,
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, thumbnail type unknown",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						File: &protobuf.FileMessage{
+							Name:     "video.mp4",
+							MimeType: "video/mp4",
+							Size:     12,
+							Hash:     make([]byte, 32),
+							Payload:  []byte("some-payload"),
+						},
+						Thumbnail: []byte("some-thumbnail"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid file message, payload does not match size",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Name:    "file.txt",
+						Size:    100,
+						Hash:    make([]byte, 32),
+						Payload: []byte("some-payload"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -494,6 +718,201 @@ func (s *MessageValidatorSuite) TestValidatePlainTextMessage() {
 	}
 }
 
+func (s *MessageValidatorSuite) TestValidateReadReceipts() {
+	tooManyIDs := make([]string, maxReadReceiptsBatchSize+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = "message-id"
+	}
+
+	testCases := []struct {
+		Name             string
+		Valid            bool
+		WhisperTimestamp uint64
+		Message          protobuf.ReadReceipts
+	}{
+		{
+			Name:             "valid read receipts",
+			Valid:            true,
+			WhisperTimestamp: 30,
+			Message: protobuf.ReadReceipts{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				MessageIds:  []string{"message-id"},
+			},
+		},
+		{
+			Name:             "missing chatID",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.ReadReceipts{
+				Clock:       30,
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				MessageIds:  []string{"message-id"},
+			},
+		},
+		{
+			Name:             "missing messageIDs",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.ReadReceipts{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "too many messageIDs",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.ReadReceipts{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				MessageIds:  tooManyIDs,
+			},
+		},
+		{
+			Name:             "public chat",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.ReadReceipts{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_PUBLIC_GROUP,
+				MessageIds:  []string{"message-id"},
+			},
+		},
+		{
+			Name:             "clock value too high",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.ReadReceipts{
+				Clock:       900000,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				MessageIds:  []string{"message-id"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedReadReceipts(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateTypingIndicator() {
+	testCases := []struct {
+		Name             string
+		Valid            bool
+		WhisperTimestamp uint64
+		Message          protobuf.TypingIndicator
+	}{
+		{
+			Name:             "valid typing indicator",
+			Valid:            true,
+			WhisperTimestamp: 30,
+			Message: protobuf.TypingIndicator{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_PRIVATE_GROUP,
+				Typing:      true,
+			},
+		},
+		{
+			Name:             "missing chatID",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.TypingIndicator{
+				Clock:       30,
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+			},
+		},
+		{
+			Name:             "public chat",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.TypingIndicator{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_PUBLIC_GROUP,
+			},
+		},
+		{
+			Name:             "clock value too high",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.TypingIndicator{
+				Clock:       900000,
+				ChatId:      "chat-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedTypingIndicator(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
+func (s *MessageValidatorSuite) TestValidateInstallationRevocation() {
+	testCases := []struct {
+		Name             string
+		Valid            bool
+		WhisperTimestamp uint64
+		Message          protobuf.InstallationRevocation
+	}{
+		{
+			Name:             "valid installation revocation",
+			Valid:            true,
+			WhisperTimestamp: 30,
+			Message: protobuf.InstallationRevocation{
+				Clock:          30,
+				InstallationId: "installation-id",
+			},
+		},
+		{
+			Name:             "missing installation id",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.InstallationRevocation{
+				Clock: 30,
+			},
+		},
+		{
+			Name:             "clock value too high",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.InstallationRevocation{
+				Clock:          900000,
+				InstallationId: "installation-id",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			err := ValidateReceivedInstallationRevocation(&tc.Message, tc.WhisperTimestamp)
+			if tc.Valid {
+				s.Nil(err)
+			} else {
+				s.NotNil(err)
+			}
+		})
+	}
+}
+
 func (s *MessageValidatorSuite) TestValidateEmojiReaction() {
 	testCases := []struct {
 		Name             string
@@ -559,6 +978,67 @@ func (s *MessageValidatorSuite) TestValidateEmojiReaction() {
 				MessageType: protobuf.MessageType_ONE_TO_ONE,
 			},
 		},
+		{
+			Name:             "valid unicode emoji reaction",
+			Valid:            true,
+			WhisperTimestamp: 30,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				Emoji:       "👩🏽‍🚀",
+			},
+		},
+		{
+			Name:             "invalid unicode emoji reaction",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.EmojiReaction{
+				Clock:       30,
+				ChatId:      "chat-id",
+				MessageId:   "message-id",
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				Emoji:       "not an emoji",
+			},
+		},
+		{
+			Name:             "valid custom emoji reaction",
+			Valid:            true,
+			WhisperTimestamp: 30,
+			Message: protobuf.EmojiReaction{
+				Clock:         30,
+				ChatId:        "chat-id",
+				MessageId:     "message-id",
+				MessageType:   protobuf.MessageType_COMMUNITY_CHAT,
+				CustomEmojiId: "emoji-id",
+			},
+		},
+		{
+			Name:             "custom emoji reaction outside of a community",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.EmojiReaction{
+				Clock:         30,
+				ChatId:        "chat-id",
+				MessageId:     "message-id",
+				MessageType:   protobuf.MessageType_ONE_TO_ONE,
+				CustomEmojiId: "emoji-id",
+			},
+		},
+		{
+			Name:             "custom emoji reaction with an emoji",
+			Valid:            false,
+			WhisperTimestamp: 30,
+			Message: protobuf.EmojiReaction{
+				Clock:         30,
+				ChatId:        "chat-id",
+				MessageId:     "message-id",
+				MessageType:   protobuf.MessageType_COMMUNITY_CHAT,
+				CustomEmojiId: "emoji-id",
+				Emoji:         "🚀",
+			},
+		},
 		{
 			Name:             "missing message type",
 			Valid:            false,
@@ -84,7 +84,7 @@ const (
 )
 
 const messageResendMinDelay = 30
-const messageResendMaxCount = 3
+const messageResendMaxBackoffExponent = 5
 
 var communityAdvertiseIntervalSecond int64 = 60 * 60
 
@@ -138,13 +138,20 @@ type Messenger struct {
 	mailserversDatabase        *mailserversDB.Database
 	browserDatabase            *browsers.Database
 	httpServer                 *server.MediaServer
+	dataPolicy                 *connection.DataPolicy
 	quit                       chan struct{}
+	outboxTrigger              chan struct{}
 
 	importingCommunities map[string]bool
+	// discordImportCancels are closed when the import of their community is cancelled
+	discordImportCancels     map[string]chan struct{}
+	discordImportCancelsLock sync.Mutex
 
 	requestedCommunitiesLock sync.RWMutex
 	requestedCommunities     map[string]*transport.Filter
 
+	typingIndicators *typingIndicators
+
 	connectionState                      connection.State
 	telemetryClient                      *telemetry.Client
 	contractMaker                        *contracts.ContractMaker
@@ -157,6 +164,7 @@ type Messenger struct {
 	mailPeersMutex            sync.Mutex
 	handleMessagesMutex       sync.Mutex
 	handleImportMessagesMutex sync.Mutex
+	channelKeysMutex          sync.Mutex
 
 	// flag to disable checking #hasPairedDevices
 	localPairing bool
@@ -221,7 +229,13 @@ func (interceptor EnvelopeEventsInterceptor) EnvelopeSent(identifiers [][]byte)
 
 // EnvelopeExpired triggered when envelope is expired but wasn't delivered to any peer.
 func (interceptor EnvelopeEventsInterceptor) EnvelopeExpired(identifiers [][]byte, err error) {
-	//we don't track expired events in Messenger, so just redirect to handler
+	if interceptor.Messenger != nil {
+		// Messages in the outbox are resent, they're only reported once given up on
+		identifiers = interceptor.Messenger.processExpiredMessages(identifiers)
+		if len(identifiers) == 0 {
+			return
+		}
+	}
 	interceptor.EnvelopeEventsHandler.EnvelopeExpired(identifiers, err)
 }
 
@@ -337,6 +351,7 @@ func NewMessenger(
 		installationID,
 		logger,
 	)
+	encryptionProtocol.SetCapabilities(common.Capabilities)
 
 	sender, err := common.NewMessageSender(
 		identity,
@@ -349,6 +364,7 @@ func NewMessenger(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create messageSender")
 	}
+	sender.SetPaddingBuckets(c.messagePaddingBuckets)
 
 	// Initialise anon metrics client
 	var anonMetricsClient *anonmetrics.Client
@@ -459,11 +475,15 @@ func NewMessenger(
 		mailserversDatabase:      c.mailserversDatabase,
 		account:                  c.account,
 		quit:                     make(chan struct{}),
+		outboxTrigger:            make(chan struct{}, 1),
 		requestedCommunitiesLock: sync.RWMutex{},
 		requestedCommunities:     make(map[string]*transport.Filter),
 		importingCommunities:     make(map[string]bool),
+		discordImportCancels:     make(map[string]chan struct{}),
+		typingIndicators:         newTypingIndicators(),
 		browserDatabase:          c.browserDatabase,
 		httpServer:               c.httpServer,
+		dataPolicy:               c.dataPolicy,
 		contractMaker: &contracts.ContractMaker{
 			RPCClient: c.rpcClient,
 		},
@@ -484,6 +504,10 @@ func NewMessenger(
 		savedAddressesManager: savedAddressesManager,
 	}
 
+	if messenger.dataPolicy == nil {
+		messenger.dataPolicy = connection.NewDataPolicy()
+	}
+
 	if c.outputMessagesCSV {
 		messenger.outputCSV = c.outputMessagesCSV
 		csvFile, err := os.Create("messages-" + fmt.Sprint(time.Now().Unix()) + ".csv")
@@ -559,12 +583,13 @@ func shouldResendMessage(message *common.RawMessage, t common.TimeSource) (bool,
 		return false, errors.New("Should resend only non-sent messages")
 	}
 
-	if message.SendCount > messageResendMaxCount {
-		return false, nil
+	//exponential backoff depends on how many attempts to send message already made,
+	//it's capped as messages are resent until the outbox deadline
+	exponent := message.SendCount - 1
+	if exponent > messageResendMaxBackoffExponent {
+		exponent = messageResendMaxBackoffExponent
 	}
-
-	//exponential backoff depends on how many attempts to send message already made
-	backoff := uint64(math.Pow(2, float64(message.SendCount-1))) * messageResendMinDelay * uint64(time.Second.Milliseconds())
+	backoff := uint64(math.Pow(2, float64(exponent))) * messageResendMinDelay * uint64(time.Second.Milliseconds())
 	backoffElapsed := t.GetCurrentTime() > (message.LastSent + backoff)
 	return backoffElapsed, nil
 }
@@ -574,27 +599,42 @@ func (m *Messenger) resendExpiredMessages() error {
 		return errors.New("offline")
 	}
 
-	ids, err := m.persistence.ExpiredMessagesIDs(messageResendMaxCount)
+	ids, err := m.persistence.ExpiredMessagesIDs()
 	if err != nil {
 		return errors.Wrapf(err, "Can't get expired reactions from db")
 	}
 
+	// Number of messages of each chat in the outbox, the most recent ones come first
+	queued := make(map[string]int)
+
 	for _, id := range ids {
 		rawMessage, err := m.persistence.RawMessageByID(id)
 		if err != nil {
 			return errors.Wrapf(err, "Can't get raw message with id %v", id)
 		}
 
-		chat, ok := m.allChats.Load(rawMessage.LocalChatID)
-		if !ok {
-			return ErrChatNotFound
+		if !m.inOutbox(rawMessage) {
+			continue
 		}
 
-		if !(chat.Public() || chat.CommunityChat()) {
-			return errors.New("Only public chats and community chats messages are resent")
+		queued[rawMessage.LocalChatID]++
+		if queued[rawMessage.LocalChatID] > outboxMaxMessagesPerChat {
+			err = m.failOutboxMessage(rawMessage, ErrOutboxFull)
+			if err != nil {
+				return err
+			}
+			continue
 		}
 
-		ok, err = shouldResendMessage(rawMessage, m.getTimesource())
+		if outboxDeadlineExceeded(rawMessage, m.outboxDeadline(), m.getTimesource()) {
+			err = m.failOutboxMessage(rawMessage, ErrOutboxDeadlineExceeded)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		ok, err := shouldResendMessage(rawMessage, m.getTimesource())
 		if err != nil {
 			return err
 		}
@@ -683,15 +723,25 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.handleENSVerificationSubscription(ensSubscription)
 	m.watchConnectionChange()
 	m.watchExpiredMessages()
+	m.watchGroupMessageDelivery()
 	m.watchIdentityImageChanges()
 	m.broadcastLatestUserStatus()
 	m.timeoutAutomaticStatusUpdates()
+	m.timeoutTypingIndicators()
 	m.startBackupLoop()
 	err = m.startAutoMessageLoop()
 	if err != nil {
 		return nil, err
 	}
 	m.startSyncSettingsLoop()
+	m.startBandwidthLoop()
+
+	err = m.loadDataPolicy()
+	if err != nil {
+		return nil, err
+	}
+	// The bandwidth is recorded before the database is closed
+	m.shutdownTasks = append([]func() error{m.transport.RecordBandwidth}, m.shutdownTasks...)
 
 	if err := m.cleanTopics(); err != nil {
 		return nil, err
@@ -1305,22 +1355,23 @@ func (m *Messenger) watchConnectionChange() {
 	}()
 }
 
-// watchExpiredMessages regularly checks for expired emojis and invoke their resending
+// watchExpiredMessages regularly checks for messages in the outbox and invoke their resending
 func (m *Messenger) watchExpiredMessages() {
 	m.logger.Debug("watching expired messages")
 	go func() {
 		for {
 			select {
 			case <-time.After(time.Second):
-				if m.online() {
-					err := m.resendExpiredMessages()
-					if err != nil {
-						m.logger.Debug("Error when resending expired emoji reactions", zap.Error(err))
-					}
-				}
+			case <-m.outboxTrigger:
 			case <-m.quit:
 				return
 			}
+			if m.online() {
+				err := m.resendExpiredMessages()
+				if err != nil {
+					m.logger.Debug("Error when resending expired messages", zap.Error(err))
+				}
+			}
 		}
 	}()
 }
@@ -1398,6 +1449,9 @@ func (m *Messenger) Init() error {
 	var (
 		publicChatIDs []string
 		publicKeys    []*ecdsa.PublicKey
+		// community chats are relayed on the pubsub topic of their community
+		communityPubsubTopics = make(map[string]string)
+		shardedChatIDs        = make(map[string][]string)
 	)
 
 	joinedCommunities, err := m.communitiesManager.Joined()
@@ -1407,6 +1461,7 @@ func (m *Messenger) Init() error {
 	for _, org := range joinedCommunities {
 		// the org advertise on the public topic derived by the pk
 		publicChatIDs = append(publicChatIDs, org.DefaultFilters()...)
+		communityPubsubTopics[org.IDString()] = m.communityPubsubTopic(org)
 
 		// This is for status-go versions that didn't have `CommunitySettings`
 		// We need to ensure communities that existed before community settings
@@ -1492,7 +1547,11 @@ func (m *Messenger) Init() error {
 			publicChatIDs = append(publicChatIDs, chat.ID)
 		case ChatTypeCommunityChat:
 			// TODO not public chat now
-			publicChatIDs = append(publicChatIDs, chat.ID)
+			if pubsubTopic := communityPubsubTopics[chat.CommunityID]; pubsubTopic != "" {
+				shardedChatIDs[pubsubTopic] = append(shardedChatIDs[pubsubTopic], chat.ID)
+			} else {
+				publicChatIDs = append(publicChatIDs, chat.ID)
+			}
 		case ChatTypeOneToOne:
 			pk, err := chat.PublicKey()
 			if err != nil {
@@ -1507,6 +1566,10 @@ func (m *Messenger) Init() error {
 				}
 				publicKeys = append(publicKeys, publicKey)
 			}
+			// Messages encrypted with sender keys are sent on the group topic
+			if m.featureFlags.SenderKeys {
+				publicChatIDs = append(publicChatIDs, chat.ID)
+			}
 		default:
 			return errors.New("invalid chat type")
 		}
@@ -1551,7 +1614,18 @@ func (m *Messenger) Init() error {
 	}
 
 	_, err = m.transport.InitFilters(publicChatIDs, publicKeys)
-	return err
+	if err != nil {
+		return err
+	}
+
+	for pubsubTopic, chatIDs := range shardedChatIDs {
+		_, err = m.transport.InitPublicFiltersOnPubsubTopic(chatIDs, pubsubTopic)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Shutdown takes care of ensuring a clean shutdown of Messenger
@@ -1581,6 +1655,10 @@ func (m *Messenger) EnableInstallation(id string) error {
 		return errors.New("no installation found")
 	}
 
+	if installation.Revoked {
+		return errors.New("installation revoked")
+	}
+
 	err := m.encryptor.EnableInstallation(&m.identity.PublicKey, id)
 	if err != nil {
 		return err
@@ -1607,6 +1685,58 @@ func (m *Messenger) DisableInstallation(id string) error {
 	return nil
 }
 
+// RevokeInstallation disables one of our installations for good, as when it was lost or
+// stolen. The revocation is broadcast on our contact code topic, so that our other
+// installations stop syncing to it and our contacts stop encrypting to it.
+func (m *Messenger) RevokeInstallation(ctx context.Context, id string) (*MessengerResponse, error) {
+	if id == m.installationID {
+		return nil, errors.New("can't revoke the current installation")
+	}
+
+	installation, ok := m.allInstallations.Load(id)
+	if !ok {
+		return nil, errors.New("no installation found")
+	}
+
+	err := m.encryptor.RevokeInstallation(&m.identity.PublicKey, id)
+	if err != nil {
+		return nil, err
+	}
+	installation.Enabled = false
+	installation.Revoked = true
+	// TODO(samyoul) remove storing of an updated reference pointer?
+	m.allInstallations.Store(id, installation)
+
+	revocation := &protobuf.InstallationRevocation{
+		Clock:          m.getTimesource().GetCurrentTime(),
+		InstallationId: id,
+	}
+	encodedMessage, err := proto.Marshal(revocation)
+	if err != nil {
+		return nil, err
+	}
+
+	contactCodeTopic := transport.ContactCodeTopic(&m.identity.PublicKey)
+	_, err = m.sender.SendPublic(ctx, contactCodeTopic, common.RawMessage{
+		LocalChatID: contactCodeTopic,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_INSTALLATION_REVOCATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish our bundle without the revoked installation
+	err = m.publishContactCode()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.Installations = []*multidevice.Installation{installation}
+	return response, nil
+}
+
 func (m *Messenger) Installations() []*multidevice.Installation {
 	installations := make([]*multidevice.Installation, m.allInstallations.Len())
 
@@ -1705,6 +1835,12 @@ func (m *Messenger) reSendRawMessage(ctx context.Context, messageID string) erro
 		return errors.New("chat not found")
 	}
 
+	// Messages given up on are given a new deadline
+	var firstSent uint64
+	if !message.Failed {
+		firstSent = message.FirstSent
+	}
+
 	_, err = m.dispatchMessage(ctx, common.RawMessage{
 		LocalChatID:         chat.ID,
 		Payload:             message.Payload,
@@ -1712,6 +1848,7 @@ func (m *Messenger) reSendRawMessage(ctx context.Context, messageID string) erro
 		Recipients:          message.Recipients,
 		ResendAutomatically: message.ResendAutomatically,
 		SendCount:           message.SendCount,
+		FirstSent:           firstSent,
 	})
 	return err
 }
@@ -1750,7 +1887,7 @@ func (m *Messenger) sendToPairedDevices(ctx context.Context, spec common.RawMess
 	// We send a message to any paired device
 	if hasPairedDevices {
 		_, err := m.sender.SendPrivate(ctx, &m.identity.PublicKey, &spec)
-		if err != nil {
+		if err != nil && err != encryption.ErrNoInstallations {
 			return err
 		}
 	}
@@ -1798,15 +1935,23 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 		if !common.IsPubKeyEqual(publicKey, &m.identity.PublicKey) || rawMessage.SkipEncryption {
 			id, err = m.sender.SendPrivate(ctx, publicKey, &rawMessage)
 
+			// There is nothing to send when the message targets none of their installations
+			if err == encryption.ErrNoInstallations {
+				rawMessage.Sent = true
+				err = nil
+			}
 			if err != nil {
 				return rawMessage, err
 			}
 		}
 
-		err = m.sendToPairedDevices(ctx, specCopyForPairedDevices)
+		// Ephemeral messages are of no interest to our other devices
+		if !rawMessage.Ephemeral {
+			err = m.sendToPairedDevices(ctx, specCopyForPairedDevices)
 
-		if err != nil {
-			return rawMessage, err
+			if err != nil {
+				return rawMessage, err
+			}
 		}
 
 	case ChatTypePublic, ChatTypeProfile:
@@ -1834,9 +1979,15 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 		if err != nil {
 			return rawMessage, err
 		}
-		if !isEncrypted {
+		// Private chats are encrypted with a key of their own, regardless of the community
+		chatGroupID, err := m.communitiesManager.ChatHashRatchetGroupID(chat.CommunityID, chat.CommunityChatID())
+		if err != nil {
+			return rawMessage, err
+		}
+		if !isEncrypted && chatGroupID == nil {
 			id, err = m.sender.SendPublic(ctx, chat.ID, rawMessage)
 		} else {
+			rawMessage.HashRatchetGroupID = chatGroupID
 			rawMessage.CommunityID, err = types.DecodeHex(chat.CommunityID)
 
 			if err == nil {
@@ -1848,6 +1999,11 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 		}
 	case ChatTypePrivateGroupChat:
 		logger.Debug("sending group message", zap.String("chatName", chat.Name))
+		// Messages with explicit recipients, such as membership changes, are always
+		// sent pairwise, as the recipients might not be listening on the group topic.
+		// So are messages for some installations only, a sender key reaches them all.
+		useSenderKey := m.featureFlags.SenderKeys && rawMessage.Recipients == nil &&
+			len(rawMessage.Installations) == 0 && len(rawMessage.SkipInstallations) == 0
 		if rawMessage.Recipients == nil {
 			rawMessage.Recipients, err = chat.MembersAsPublicKeys()
 			if err != nil {
@@ -1857,7 +2013,7 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 
 		hasPairedDevices := m.hasPairedDevices()
 
-		if !hasPairedDevices {
+		if !hasPairedDevices || rawMessage.Ephemeral {
 
 			// Filter out my key from the recipients
 			n := 0
@@ -1880,7 +2036,11 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 			rawMessage.MessageType = protobuf.ApplicationMetadataMessage_MEMBERSHIP_UPDATE_MESSAGE
 		}
 
-		id, err = m.sender.SendGroup(ctx, rawMessage.Recipients, rawMessage)
+		if useSenderKey && len(rawMessage.Recipients) != 0 {
+			id, err = m.sender.SendGroupWithSenderKey(ctx, rawMessage.Recipients, rawMessage)
+		} else {
+			id, err = m.sender.SendGroup(ctx, rawMessage.Recipients, rawMessage)
+		}
 		if err != nil {
 			return rawMessage, err
 		}
@@ -1891,6 +2051,15 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 	rawMessage.ID = types.EncodeHex(id)
 	rawMessage.SendCount++
 	rawMessage.LastSent = m.getTimesource().GetCurrentTime()
+	if rawMessage.FirstSent == 0 {
+		rawMessage.FirstSent = rawMessage.LastSent
+	}
+
+	// Ephemeral messages are never resent, there is no point in keeping them
+	if rawMessage.Ephemeral {
+		return rawMessage, nil
+	}
+
 	err = m.persistence.SaveRawMessage(&rawMessage)
 	if err != nil {
 		return rawMessage, err
@@ -1977,6 +2146,9 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 	}
 
 	message.DisplayName = displayName
+
+	// filePayload holds the content of a file attachment sent in chunks
+	var filePayload []byte
 	if len(message.ImagePath) != 0 {
 
 		payload, err := m.OpenAndAdjustImage(userimage.CroppedImage{ImagePath: message.ImagePath}, false)
@@ -2031,6 +2203,28 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		if err != nil {
 			return nil, err
 		}
+	} else if len(message.FilePath) != 0 {
+		file, payload, err := m.readFileAttachment(message.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		filePayload = payload
+		message.Payload = &protobuf.ChatMessage_File{File: file}
+		message.ContentType = protobuf.ChatMessage_FILE
+		if len(message.Text) == 0 {
+			message.Text = file.Name
+		}
+	} else if len(message.VideoPath) != 0 {
+		video, payload, err := m.readVideoAttachment(message)
+		if err != nil {
+			return nil, err
+		}
+		filePayload = payload
+		message.Payload = &protobuf.ChatMessage_Video{Video: video}
+		message.ContentType = protobuf.ChatMessage_VIDEO
+		if len(message.Text) == 0 {
+			message.Text = video.File.Name
+		}
 	}
 
 	var response MessengerResponse
@@ -2056,12 +2250,18 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	fallback, unsupportedInstallations, err := m.capabilityFallback(chat, message)
+	if err != nil {
+		return nil, err
+	}
+
 	rawMessage := common.RawMessage{
 		LocalChatID:          chat.ID,
 		SendPushNotification: m.featureFlags.PushNotifications,
 		Payload:              encodedMessage,
 		MessageType:          protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
 		ResendAutomatically:  true,
+		SkipInstallations:    unsupportedInstallations,
 	}
 
 	rawMessage, err = m.dispatchMessage(ctx, rawMessage)
@@ -2069,10 +2269,44 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	// Installations that can't render the message receive it as text instead
+	if fallback != nil {
+		encodedFallback, err := m.encodeChatEntity(chat, fallback)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:          chat.ID,
+			SendPushNotification: m.featureFlags.PushNotifications,
+			Payload:              encodedFallback,
+			MessageType:          protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			ResendAutomatically:  true,
+			Installations:        unsupportedInstallations,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if rawMessage.Sent {
 		message.OutgoingStatus = common.OutgoingStatusSent
 	}
 	message.ID = rawMessage.ID
+
+	err = m.updateMessageDeliveryCount(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if file := messageFileAttachment(message); file != nil {
+		// Installations that received the fallback have no use for the chunks
+		err = m.storeAndSendFileAttachment(ctx, chat, message, file, filePayload, unsupportedInstallations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = message.PrepareContent(common.PubkeyToHex(&m.identity.PublicKey))
 	if err != nil {
 		return nil, err
@@ -2088,6 +2322,11 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	err = m.clearChatDraft(chat.ID, message.Clock, &response)
+	if err != nil {
+		return nil, err
+	}
+
 	msg, err := m.pullMessagesAndResponsesFromDB([]*common.Message{message})
 	if err != nil {
 		return nil, err
@@ -2393,6 +2632,18 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string,
 			return err
 		}
 	}
+
+	drafts, err := m.persistence.AllChatDrafts()
+	if err != nil {
+		return err
+	}
+
+	for _, d := range drafts {
+		err = m.syncChatDraft(ctx, d, rawMessageHandler)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -2974,13 +3225,19 @@ func (m *Messenger) markDeliveredMessages(acks [][]byte) {
 			m.logger.Debug("Can't set message status as delivered", zap.Error(err))
 		}
 
+		message, err := m.persistence.MessageByID(messageID)
+		if err != nil {
+			m.logger.Debug("Can't get message from database", zap.Error(err))
+			continue
+		}
+
+		err = m.updateMessageDeliveryCount(message)
+		if err != nil {
+			m.logger.Debug("Can't update message delivery count", zap.Error(err))
+		}
+
 		//send signal to client that message status updated
 		if m.config.messengerSignalsHandler != nil {
-			message, err := m.persistence.MessageByID(messageID)
-			if err != nil {
-				m.logger.Debug("Can't get message from database", zap.Error(err))
-				continue
-			}
 			m.config.messengerSignalsHandler.MessageDelivered(message.LocalChatID, messageID)
 		}
 	}
@@ -3162,6 +3419,14 @@ func (m *Messenger) handleImportedMessages(messagesToHandle map[transport.Filter
 							logger.Warn("failed to handle ChatMessage", zap.Error(err))
 							continue
 						}
+					case protobuf.FileChunk:
+						logger.Debug("Handling FileChunk")
+						message := msg.ParsedMessage.Interface().(protobuf.FileChunk)
+						err = m.HandleFileChunk(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle FileChunk", zap.Error(err))
+							continue
+						}
 					}
 				}
 			}
@@ -3325,6 +3590,12 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 					logger.Warn("failed to handle shared secrets")
 				}
 
+				err = m.handleContactInstallations(messageState, publicKey, msg.Installations)
+				if err != nil {
+					// log and continue, non-critical error
+					logger.Warn("failed to handle contact installations", zap.Error(err))
+				}
+
 				senderID := contactIDFromPublicKey(publicKey)
 
 				// Check for messages from blocked users
@@ -3332,6 +3603,14 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 					continue
 				}
 
+				if msg.SessionOutOfSync {
+					err := m.resetSession(messageState, publicKey)
+					if err != nil {
+						logger.Warn("failed to reset session", zap.Error(err))
+					}
+					continue
+				}
+
 				// Don't process duplicates
 				messageID := types.EncodeHex(msg.ID)
 				exists, err := m.messageExists(messageID, messageState.ExistingMessagesMap)
@@ -3475,6 +3754,28 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							continue
 						}
 
+					case protobuf.InstallationRevocation:
+						p := msg.ParsedMessage.Interface().(protobuf.InstallationRevocation)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						logger.Debug("Handling InstallationRevocation", zap.Any("message", p))
+						err = m.HandleInstallationRevocation(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle InstallationRevocation", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SessionReset:
+						p := msg.ParsedMessage.Interface().(protobuf.SessionReset)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						logger.Debug("Handling SessionReset", zap.Any("message", p))
+						err = m.HandleSessionReset(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SessionReset", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
 					case protobuf.StatusUpdate:
 						p := msg.ParsedMessage.Interface().(protobuf.StatusUpdate)
 						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
@@ -3968,6 +4269,36 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							allMessagesProcessed = false
 							continue
 						}
+					case protobuf.FileChunk:
+						logger.Debug("Handling FileChunk")
+						message := msg.ParsedMessage.Interface().(protobuf.FileChunk)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleFileChunk(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle FileChunk", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.ReadReceipts:
+						logger.Debug("Handling ReadReceipts")
+						message := msg.ParsedMessage.Interface().(protobuf.ReadReceipts)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleReadReceipts(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle ReadReceipts", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.TypingIndicator:
+						logger.Debug("Handling TypingIndicator")
+						message := msg.ParsedMessage.Interface().(protobuf.TypingIndicator)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleTypingIndicator(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle TypingIndicator", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
 					case protobuf.GroupChatInvitation:
 						logger.Debug("Handling GroupChatInvitation")
 						message := msg.ParsedMessage.Interface().(protobuf.GroupChatInvitation)
@@ -4151,6 +4482,20 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							allMessagesProcessed = false
 							continue
 						}
+					case protobuf.SyncChatDraft:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncChatDraft)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						err = m.handleSyncChatDraft(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncChatDraft", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
 					default:
 						// Check if is an encrypted PushNotificationRegistration
 						if msg.Type == protobuf.ApplicationMetadataMessage_PUSH_NOTIFICATION_REGISTRATION {
@@ -4521,6 +4866,15 @@ func (m *Messenger) prepareMessage(msg *common.Message, s *server.MediaServer) {
 	if msg.ContentType == protobuf.ChatMessage_STICKER {
 		msg.StickerLocalURL = s.MakeStickerURL(msg.GetSticker().Hash)
 	}
+	if msg.ContentType == protobuf.ChatMessage_FILE {
+		msg.FileLocalURL = s.MakeFileURL(msg.ID)
+	}
+	if msg.ContentType == protobuf.ChatMessage_VIDEO {
+		msg.VideoLocalURL = s.MakeVideoURL(msg.ID)
+		if len(msg.GetVideo().Thumbnail) != 0 {
+			msg.VideoThumbnailLocalURL = s.MakeVideoThumbnailURL(msg.ID)
+		}
+	}
 }
 
 func (m *Messenger) AllMessageByChatIDWhichMatchTerm(chatID string, searchTerm string, caseSensitive bool) ([]*common.Message, error) {
@@ -4552,6 +4906,10 @@ func (m *Messenger) DeleteMessagesByChatID(id string) error {
 // It returns the number of affected messages or error. If there is an error,
 // the number of affected messages is always zero.
 func (m *Messenger) MarkMessagesSeen(chatID string, ids []string) (uint64, uint64, error) {
+	unseenIDs, err := m.persistence.UnseenIncomingMessageIDsIn(ids, common.PubkeyToHex(&m.identity.PublicKey))
+	if err != nil {
+		return 0, 0, err
+	}
 	count, countWithMentions, err := m.persistence.MarkMessagesSeen(chatID, ids)
 	if err != nil {
 		return 0, 0, err
@@ -4561,6 +4919,12 @@ func (m *Messenger) MarkMessagesSeen(chatID string, ids []string) (uint64, uint6
 		return 0, 0, err
 	}
 	m.allChats.Store(chatID, chat)
+
+	err = m.sendReadReceipts(context.Background(), chat, unseenIDs)
+	if err != nil {
+		m.logger.Warn("failed to send read receipts", zap.Error(err))
+	}
+
 	return count, countWithMentions, nil
 }
 
@@ -4646,7 +5010,24 @@ func (m *Messenger) MarkAllRead(chatID string) error {
 		clock, _ = chat.NextClockAndTimestamp(m.getTimesource())
 	}
 
-	return m.markAllRead(chatID, clock, true)
+	unseenIDs, err := m.persistence.UnseenIncomingMessageIDs(chatID, common.PubkeyToHex(&m.identity.PublicKey), clock)
+	if err != nil {
+		return err
+	}
+
+	err = m.markAllRead(chatID, clock, true)
+	if err != nil {
+		return err
+	}
+
+	if chat, ok := m.allChats.Load(chatID); ok {
+		err = m.sendReadReceipts(context.Background(), chat, unseenIDs)
+		if err != nil {
+			m.logger.Warn("failed to send read receipts", zap.Error(err))
+		}
+	}
+
+	return nil
 }
 
 func (m *Messenger) MarkAllReadInCommunity(communityID string) ([]string, error) {
@@ -5690,6 +6071,55 @@ func generateAliasAndIdenticon(pk string) (string, string, error) {
 }
 
 func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*MessengerResponse, error) {
+	return m.sendEmojiReaction(ctx, chatID, &protobuf.EmojiReaction{
+		MessageId: messageID,
+		Type:      emojiID,
+	})
+}
+
+// SendUnicodeEmojiReaction reacts to a message with any unicode emoji sequence
+func (m *Messenger) SendUnicodeEmojiReaction(ctx context.Context, chatID, messageID string, emoji string) (*MessengerResponse, error) {
+	if !isEmojiSequence(emoji) {
+		return nil, ErrInvalidEmoji
+	}
+
+	return m.sendEmojiReaction(ctx, chatID, &protobuf.EmojiReaction{
+		MessageId: messageID,
+		Emoji:     emoji,
+	})
+}
+
+// SendCustomEmojiReaction reacts to a message of a community chat with one of the community custom emojis
+func (m *Messenger) SendCustomEmojiReaction(ctx context.Context, chatID, messageID string, customEmojiID string) (*MessengerResponse, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	if !m.communityHasCustomEmoji(chat, customEmojiID) {
+		return nil, communities.ErrCustomEmojiNotFound
+	}
+
+	return m.sendEmojiReaction(ctx, chatID, &protobuf.EmojiReaction{
+		MessageId:     messageID,
+		CustomEmojiId: customEmojiID,
+	})
+}
+
+func (m *Messenger) communityHasCustomEmoji(chat *Chat, customEmojiID string) bool {
+	if !chat.CommunityChat() {
+		return false
+	}
+
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil || community == nil {
+		return false
+	}
+
+	return community.HasCustomEmoji(customEmojiID)
+}
+
+func (m *Messenger) sendEmojiReaction(ctx context.Context, chatID string, pbEmojiR *protobuf.EmojiReaction) (*MessengerResponse, error) {
 	var response MessengerResponse
 
 	chat, ok := m.allChats.Load(chatID)
@@ -5698,15 +6128,14 @@ func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID str
 	}
 	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
 
+	pbEmojiR.Clock = clock
+	pbEmojiR.ChatId = chatID
+	normalizeEmojiReaction(pbEmojiR)
+
 	emojiR := &EmojiReaction{
-		EmojiReaction: protobuf.EmojiReaction{
-			Clock:     clock,
-			MessageId: messageID,
-			ChatId:    chatID,
-			Type:      emojiID,
-		},
-		LocalChatID: chatID,
-		From:        types.EncodeHex(crypto.FromECDSAPub(&m.identity.PublicKey)),
+		EmojiReaction: *pbEmojiR,
+		LocalChatID:   chatID,
+		From:          types.EncodeHex(crypto.FromECDSAPub(&m.identity.PublicKey)),
 	}
 	encodedMessage, err := m.encodeChatEntity(chat, emojiR)
 	if err != nil {
@@ -0,0 +1,166 @@
+package protocol
+
+import (
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/transport"
+)
+
+const (
+	bandwidthRecordInterval = time.Minute
+	// bandwidthRetention is how long the daily traffic counters are kept
+	bandwidthRetention = 90 * 24 * time.Hour
+)
+
+// Traffic is the size in bytes of the messages sent and received
+type Traffic struct {
+	Upload   uint64 `json:"upload"`
+	Download uint64 `json:"download"`
+}
+
+func (t *Traffic) add(b types.TopicBandwidth) {
+	t.Upload += b.Upload
+	t.Download += b.Download
+}
+
+// Bandwidth is the traffic of a chat or a community, in total and by Waku protocol
+type Bandwidth struct {
+	Traffic
+	ByProtocol map[string]*Traffic `json:"byProtocol"`
+}
+
+func (b *Bandwidth) add(topicBandwidth types.TopicBandwidth) {
+	b.Traffic.add(topicBandwidth)
+	traffic, ok := b.ByProtocol[topicBandwidth.Protocol]
+	if !ok {
+		traffic = &Traffic{}
+		b.ByProtocol[topicBandwidth.Protocol] = traffic
+	}
+	traffic.add(topicBandwidth)
+}
+
+// TopicBandwidthStat is the traffic of a topic during a day, along with the
+// chats and communities it's used by
+type TopicBandwidthStat struct {
+	transport.DailyTopicBandwidth
+	ChatIDs      []string `json:"chatIds"`
+	CommunityIDs []string `json:"communityIds"`
+}
+
+// BandwidthStats is the traffic over a period, by topic, chat and community.
+// Topics can be shared by several chats, e.g. one-to-one chats, in which case
+// their traffic is accounted to each of them.
+type BandwidthStats struct {
+	Topics      []*TopicBandwidthStat `json:"topics"`
+	Chats       map[string]*Bandwidth `json:"chats"`
+	Communities map[string]*Bandwidth `json:"communities"`
+}
+
+func (m *Messenger) startBandwidthLoop() {
+	ticker := time.NewTicker(bandwidthRecordInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				err := m.transport.RecordBandwidth()
+				if err != nil {
+					m.logger.Error("failed to record bandwidth", zap.Error(err))
+					continue
+				}
+				err = m.transport.DeleteBandwidthBefore(time.Now().Add(-bandwidthRetention))
+				if err != nil {
+					m.logger.Error("failed to delete old bandwidth stats", zap.Error(err))
+				}
+			case <-m.quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// chatsAndCommunitiesByTopic returns the IDs of the chats and communities
+// using each topic
+func (m *Messenger) chatsAndCommunitiesByTopic() (map[types.TopicType][]string, map[types.TopicType][]string, error) {
+	communities, err := m.communitiesManager.All()
+	if err != nil {
+		return nil, nil, err
+	}
+	communityIDs := make(map[string]bool)
+	for _, community := range communities {
+		communityIDs[community.IDString()] = true
+	}
+
+	chatIDs := make(map[types.TopicType][]string)
+	topicCommunityIDs := make(map[types.TopicType][]string)
+	for _, filter := range m.transport.Filters() {
+		chatIDs[filter.Topic] = append(chatIDs[filter.Topic], filter.ChatID)
+
+		// Community chats, the community description and the admin filters
+		communityID := strings.TrimSuffix(filter.ChatID, "-admin")
+		if chat, ok := m.allChats.Load(filter.ChatID); ok && chat.CommunityID != "" {
+			communityID = chat.CommunityID
+		}
+		if communityIDs[communityID] && !stringSliceContains(topicCommunityIDs[filter.Topic], communityID) {
+			topicCommunityIDs[filter.Topic] = append(topicCommunityIDs[filter.Topic], communityID)
+		}
+	}
+
+	return chatIDs, topicCommunityIDs, nil
+}
+
+// BandwidthStats returns the traffic between from and to, by day for each
+// topic, and in total for each chat and community.
+func (m *Messenger) BandwidthStats(from, to time.Time) (*BandwidthStats, error) {
+	// Include the traffic not recorded yet
+	err := m.transport.RecordBandwidth()
+	if err != nil {
+		return nil, err
+	}
+
+	bandwidth, err := m.transport.Bandwidth(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	chatIDs, communityIDs, err := m.chatsAndCommunitiesByTopic()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &BandwidthStats{
+		Topics:      make([]*TopicBandwidthStat, 0, len(bandwidth)),
+		Chats:       make(map[string]*Bandwidth),
+		Communities: make(map[string]*Bandwidth),
+	}
+	addTo := func(totals map[string]*Bandwidth, id string, b types.TopicBandwidth) {
+		total, ok := totals[id]
+		if !ok {
+			total = &Bandwidth{ByProtocol: make(map[string]*Traffic)}
+			totals[id] = total
+		}
+		total.add(b)
+	}
+
+	for _, b := range bandwidth {
+		stat := &TopicBandwidthStat{
+			DailyTopicBandwidth: b,
+			ChatIDs:             chatIDs[b.Topic],
+			CommunityIDs:        communityIDs[b.Topic],
+		}
+		stats.Topics = append(stats.Topics, stat)
+
+		for _, chatID := range stat.ChatIDs {
+			addTo(stats.Chats, chatID, b.TopicBandwidth)
+		}
+		for _, communityID := range stat.CommunityIDs {
+			addTo(stats.Communities, communityID, b.TopicBandwidth)
+		}
+	}
+
+	return stats, nil
+}
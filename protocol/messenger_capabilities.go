@@ -0,0 +1,119 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// recipientInstallations returns the installations a message sent to chat is
+// encrypted for, including our other devices. Only one-to-one and private group
+// chats are considered, as members of public and community chats are not known.
+func (m *Messenger) recipientInstallations(chat *Chat) ([]*multidevice.Installation, error) {
+	var recipients []*ecdsa.PublicKey
+
+	switch chat.ChatType {
+	case ChatTypeOneToOne:
+		publicKey, err := chat.PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, publicKey)
+	case ChatTypePrivateGroupChat:
+		members, err := chat.MembersAsPublicKeys()
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, members...)
+	default:
+		return nil, nil
+	}
+
+	var installations []*multidevice.Installation
+	for _, recipient := range recipients {
+		if common.IsPubKeyEqual(recipient, &m.identity.PublicKey) {
+			continue
+		}
+
+		theirInstallations, err := m.encryptor.GetInstallations(recipient)
+		if err != nil {
+			return nil, err
+		}
+		for _, installation := range theirInstallations {
+			if installation.Enabled && !installation.Revoked {
+				installations = append(installations, installation)
+			}
+		}
+	}
+
+	ourInstallations, err := m.encryptor.GetOurActiveInstallations(&m.identity.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, installation := range ourInstallations {
+		if installation.ID != m.installationID {
+			installations = append(installations, installation)
+		}
+	}
+
+	return installations, nil
+}
+
+// capabilityFallback returns a version of message that can be rendered by the
+// installations it is sent to that lack the capability to render it as it is,
+// along with the ids of those installations, or nil if all of them can.
+// Installations we never received a bundle from, or that never advertised any
+// capability, are unknown and assumed to be up to date.
+func (m *Messenger) capabilityFallback(chat *Chat, message *common.Message) (*common.Message, []string, error) {
+	capability := common.ContentTypeCapability(message.ContentType)
+	if len(capability) == 0 {
+		return nil, nil, nil
+	}
+
+	installations, err := m.recipientInstallations(chat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var unsupported []string
+	for _, installation := range installations {
+		if len(installation.Capabilities) != 0 && !common.HasCapability(installation.Capabilities, capability) {
+			unsupported = append(unsupported, installation.ID)
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil, nil, nil
+	}
+
+	fallback := *message
+	fallback.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	fallback.Payload = nil
+	fallback.Text = capabilityFallbackText(message)
+	return &fallback, unsupported, nil
+}
+
+// capabilityFallbackText renders message as text, for installations that
+// don't understand its content type
+func capabilityFallbackText(message *common.Message) string {
+	var text string
+	var name string
+	switch message.ContentType {
+	case protobuf.ChatMessage_FILE:
+		name = message.GetFile().GetName()
+		text = fmt.Sprintf("Sent a file: %s", name)
+	case protobuf.ChatMessage_VIDEO:
+		name = message.GetVideo().GetFile().GetName()
+		text = fmt.Sprintf("Sent a video: %s", name)
+	default:
+		return message.Text
+	}
+
+	// The text defaults to the name of the file when there is no caption
+	if len(message.Text) != 0 && message.Text != name {
+		text += "\n" + message.Text
+	}
+	return text
+}
@@ -0,0 +1,187 @@
+package protocol
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/proto"
+
+	userimage "github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/audio"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// SaveChatDraft stores the unsent input of a chat and syncs it to paired devices
+func (m *Messenger) SaveChatDraft(ctx context.Context, request *requests.SaveChatDraft) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	var attachments []*protobuf.ChatDraftAttachment
+	for _, imagePath := range request.ImagePaths {
+		payload, err := m.OpenAndAdjustImage(userimage.CroppedImage{ImagePath: imagePath}, false)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, &protobuf.ChatDraftAttachment{
+			Payload: &protobuf.ChatDraftAttachment_Image{
+				Image: &protobuf.ImageMessage{
+					Payload: payload,
+					Type:    images.ImageType(payload),
+				},
+			},
+		})
+	}
+
+	if len(request.AudioPath) != 0 {
+		payload, err := ioutil.ReadFile(request.AudioPath)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, &protobuf.ChatDraftAttachment{
+			Payload: &protobuf.ChatDraftAttachment_Audio{
+				Audio: &protobuf.AudioMessage{
+					Payload: payload,
+					Type:    audio.Type(payload),
+				},
+			},
+		})
+	}
+
+	clock, err := m.nextChatDraftClock(chat.ID)
+	if err != nil {
+		return nil, err
+	}
+	draft := &ChatDraft{
+		ChatID:      chat.ID,
+		Text:        request.Text,
+		ResponseTo:  request.ResponseTo,
+		Attachments: attachments,
+		Clock:       clock,
+	}
+
+	return m.saveAndSyncChatDraft(ctx, draft)
+}
+
+// DeleteChatDraft removes the draft of a chat and syncs the removal to paired devices
+func (m *Messenger) DeleteChatDraft(ctx context.Context, chatID string) (*MessengerResponse, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	clock, err := m.nextChatDraftClock(chat.ID)
+	if err != nil {
+		return nil, err
+	}
+	draft := &ChatDraft{
+		ChatID:  chat.ID,
+		Clock:   clock,
+		Removed: true,
+	}
+
+	return m.saveAndSyncChatDraft(ctx, draft)
+}
+
+func (m *Messenger) ChatDraft(chatID string) (*ChatDraft, error) {
+	return m.persistence.ChatDraft(chatID)
+}
+
+func (m *Messenger) ChatDrafts() ([]*ChatDraft, error) {
+	return m.persistence.ChatDrafts()
+}
+
+// nextChatDraftClock returns a clock higher than the one of the stored draft,
+// so that edits made in quick succession are never rejected as outdated
+func (m *Messenger) nextChatDraftClock(chatID string) (uint64, error) {
+	storedClock, err := m.persistence.ChatDraftClock(chatID)
+	if err != nil {
+		return 0, err
+	}
+
+	clock := m.getTimesource().GetCurrentTime()
+	if clock <= storedClock {
+		clock = storedClock + 1
+	}
+	return clock, nil
+}
+
+func (m *Messenger) saveAndSyncChatDraft(ctx context.Context, draft *ChatDraft) (*MessengerResponse, error) {
+	saved, err := m.persistence.SaveChatDraftIfNewer(draft)
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		return nil, ErrChatDraftOutdated
+	}
+
+	err = m.syncChatDraft(ctx, draft, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddChatDraft(draft)
+	return response, nil
+}
+
+// clearChatDraft removes the draft of a chat once a message with a newer clock
+// has been sent to it, either from this device or from a paired one
+func (m *Messenger) clearChatDraft(chatID string, clock uint64, response *MessengerResponse) error {
+	removed, err := m.persistence.RemoveChatDraftIfOlder(chatID, clock)
+	if err != nil {
+		return err
+	}
+
+	if removed {
+		response.AddChatDraft(&ChatDraft{ChatID: chatID, Clock: clock, Removed: true})
+	}
+	return nil
+}
+
+func (m *Messenger) syncChatDraft(ctx context.Context, draft *ChatDraft, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	_, chat := m.getLastClockWithRelatedChat()
+
+	encodedMessage, err := proto.Marshal(draft.ToSyncProtobuf())
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_CHAT_DRAFT,
+		ResendAutomatically: true,
+	}
+
+	_, err = rawMessageHandler(ctx, rawMessage)
+	return err
+}
+
+func (m *Messenger) handleSyncChatDraft(state *ReceivedMessageState, message protobuf.SyncChatDraft) error {
+	draft := chatDraftFromSyncProtobuf(&message)
+
+	saved, err := m.persistence.SaveChatDraftIfNewer(draft)
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		state.Response.AddChatDraft(draft)
+	}
+	return nil
+}
@@ -477,7 +477,21 @@ func (m *Messenger) Join(chat *Chat) ([]*transport.Filter, error) {
 		if err != nil {
 			return nil, err
 		}
-		return m.transport.JoinGroup(members)
+		filters, err := m.transport.JoinGroup(members)
+		if err != nil {
+			return nil, err
+		}
+
+		if !m.featureFlags.SenderKeys {
+			return filters, nil
+		}
+
+		// Messages encrypted with sender keys are sent on the group topic
+		f, err := m.transport.JoinPublic(chat.ID)
+		if err != nil {
+			return nil, err
+		}
+		return append(filters, f), nil
 	case ChatTypePublic, ChatTypeProfile, ChatTypeTimeline:
 		f, err := m.transport.JoinPublic(chat.ID)
 		if err != nil {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/meirf/gopart"
 
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/images"
@@ -176,6 +177,11 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					if err != nil {
 						m.logger.Warn("failed to publish org", zap.Error(err))
 					}
+
+					err = m.distributeChannelKeys(sub.Community)
+					if err != nil {
+						m.logger.Warn("failed to distribute channel keys", zap.Error(err))
+					}
 				}
 
 				for _, invitation := range sub.Invitations {
@@ -288,18 +294,17 @@ func (m *Messenger) CuratedCommunities() (*communities.KnownCommunitiesResponse,
 func (m *Messenger) initCommunityChats(community *communities.Community) ([]*Chat, error) {
 	logger := m.logger.Named("initCommunityChats")
 
-	chatIDs := community.DefaultFilters()
-
 	chats := CreateCommunityChats(community, m.getTimesource())
 
+	var chatIDs []string
 	for _, chat := range chats {
 		chatIDs = append(chatIDs, chat.ID)
 	}
 
 	// Load transport filters
-	filters, err := m.transport.InitPublicFilters(chatIDs)
+	filters, err := m.initCommunityFilters(community, chatIDs)
 	if err != nil {
-		logger.Debug("m.transport.InitPublicFilters error", zap.Error(err))
+		logger.Debug("m.initCommunityFilters error", zap.Error(err))
 		return nil, err
 	}
 
@@ -312,7 +317,7 @@ func (m *Messenger) initCommunityChats(community *communities.Community) ([]*Cha
 		filters = append(filters, communityFilters...)
 	}
 
-	willSync, err := m.scheduleSyncFilters(filters)
+	willSync, err := m.scheduleSyncCommunityFilters(filters)
 	if err != nil {
 		logger.Debug("m.scheduleSyncFilters error", zap.Error(err))
 		return nil, err
@@ -626,6 +631,58 @@ func (m *Messenger) ReorderCommunityChat(request *requests.ReorderCommunityChat)
 	return &response, nil
 }
 
+func (m *Messenger) AddCommunityCustomEmoji(request *requests.AddCommunityCustomEmoji) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var response MessengerResponse
+	community, changes, err := m.communitiesManager.AddCustomEmoji(request)
+	if err != nil {
+		return nil, err
+	}
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return &response, nil
+}
+
+func (m *Messenger) RemoveCommunityCustomEmoji(request *requests.RemoveCommunityCustomEmoji) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var response MessengerResponse
+	community, changes, err := m.communitiesManager.RemoveCustomEmoji(request)
+	if err != nil {
+		return nil, err
+	}
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return &response, nil
+}
+
+// CommunityCustomEmojis returns the custom emojis of the community along with the URL they are served at
+func (m *Messenger) CommunityCustomEmojis(communityID types.HexBytes) ([]*communities.CustomEmoji, error) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, communities.ErrOrgNotFound
+	}
+
+	emojis := community.CustomEmojis()
+	if m.httpServer != nil {
+		for _, emoji := range emojis {
+			emoji.URL = m.httpServer.MakeCommunityCustomEmojiURL(community.IDString(), emoji.ID)
+		}
+	}
+
+	return emojis, nil
+}
+
 func (m *Messenger) DeleteCommunityCategory(request *requests.DeleteCommunityCategory) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -912,11 +969,11 @@ func (m *Messenger) CreateCommunityChat(communityID types.HexBytes, c *protobuf.
 	}
 
 	// Load filters
-	filters, err := m.transport.InitPublicFilters(chatIDs)
+	filters, err := m.initCommunityChatFilters(community, chatIDs)
 	if err != nil {
 		return nil, err
 	}
-	_, err = m.scheduleSyncFilters(filters)
+	_, err = m.scheduleSyncCommunityFilters(filters)
 	if err != nil {
 		return nil, err
 	}
@@ -926,6 +983,12 @@ func (m *Messenger) CreateCommunityChat(communityID types.HexBytes, c *protobuf.
 		return nil, err
 	}
 
+	// Private chats need their key before anything is sent to them
+	err = m.distributeChannelKeys(community)
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.reregisterForPushNotifications()
 	if err != nil {
 		return nil, err
@@ -953,16 +1016,21 @@ func (m *Messenger) EditCommunityChat(communityID types.HexBytes, chatID string,
 	}
 
 	// Load filters
-	filters, err := m.transport.InitPublicFilters(chatIDs)
+	filters, err := m.initCommunityChatFilters(community, chatIDs)
 	if err != nil {
 		return nil, err
 	}
-	_, err = m.scheduleSyncFilters(filters)
+	_, err = m.scheduleSyncCommunityFilters(filters)
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, m.saveChats(chats)
+	err = m.saveChats(chats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, m.distributeChannelKeys(community)
 }
 
 func (m *Messenger) DeleteCommunityChat(communityID types.HexBytes, chatID string) (*MessengerResponse, error) {
@@ -1315,6 +1383,83 @@ func (m *Messenger) SendKeyExchangeMessage(communityID []byte, pubkeys []*ecdsa.
 	return nil
 }
 
+// distributeChannelKeys makes sure that the members that can access the private
+// chats of a community we administer, and only those, have the key of each chat.
+// The key is rotated whenever someone lost access since it was last distributed,
+// otherwise members that gained access are sent the existing keys.
+func (m *Messenger) distributeChannelKeys(community *communities.Community) error {
+	if !community.IsAdmin() {
+		return nil
+	}
+
+	m.channelKeysMutex.Lock()
+	defer m.channelKeysMutex.Unlock()
+
+	for chatID := range community.Chats() {
+		if !community.ChatEncrypted(chatID) {
+			continue
+		}
+
+		fullChatID := community.IDString() + chatID
+		distributed, err := m.communitiesManager.GetChannelKeyRecipients(fullChatID)
+		if err != nil {
+			return err
+		}
+
+		previousRecipients := make(map[string]bool)
+		for _, recipient := range distributed {
+			previousRecipients[recipient] = true
+		}
+
+		members := community.ChatMemberPubkeys(chatID)
+		var recipients []string
+		var added []*ecdsa.PublicKey
+		for _, member := range members {
+			key := common.PubkeyToHex(member)
+			recipients = append(recipients, key)
+			if previousRecipients[key] {
+				delete(previousRecipients, key)
+			} else {
+				added = append(added, member)
+			}
+		}
+
+		// Anyone left has lost access
+		rekey := len(previousRecipients) != 0
+
+		if rekey {
+			err = m.sendChannelKeyExchangeMessage(community.ID(), community.ChatHashRatchetGroupID(chatID), members, common.KeyExMsgRekey)
+		} else if len(added) != 0 {
+			err = m.sendChannelKeyExchangeMessage(community.ID(), community.ChatHashRatchetGroupID(chatID), added, common.KeyExMsgReuse)
+		} else {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = m.communitiesManager.SaveChannelKeyRecipients(fullChatID, recipients)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) sendChannelKeyExchangeMessage(communityID []byte, groupID []byte, pubkeys []*ecdsa.PublicKey, msgType common.CommKeyExMsgType) error {
+	rawMessage := common.RawMessage{
+		SkipEncryption:        false,
+		CommunityID:           communityID,
+		CommunityKeyExMsgType: msgType,
+		HashRatchetGroupID:    groupID,
+		Recipients:            pubkeys,
+		MessageType:           protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+	}
+	_, err := m.sender.SendCommunityMessage(context.Background(), rawMessage)
+	return err
+}
+
 func (m *Messenger) UnbanUserFromCommunity(request *requests.UnbanUserFromCommunity) (*MessengerResponse, error) {
 	community, err := m.communitiesManager.UnbanUserFromCommunity(request)
 	if err != nil {
@@ -1703,12 +1848,15 @@ func (m *Messenger) handleCommunityDescription(state *ReceivedMessageState, sign
 		}
 	}
 
+	// The chats are moved along with the community when it changes shard
+	chatIDs = append(chatIDs, m.communityChatsOffPubsubTopic(community)...)
+
 	// Load transport filters
-	filters, err := m.transport.InitPublicFilters(chatIDs)
+	filters, err := m.initCommunityChatFilters(community, chatIDs)
 	if err != nil {
 		return err
 	}
-	_, err = m.scheduleSyncFilters(filters)
+	_, err = m.scheduleSyncCommunityFilters(filters)
 	if err != nil {
 		return err
 	}
@@ -2306,6 +2454,9 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 
 		// marking import as not cancelled
 		m.importingCommunities[communityID] = false
+		m.discordImportCancelsLock.Lock()
+		m.discordImportCancels[communityID] = make(chan struct{})
+		m.discordImportCancelsLock.Unlock()
 		importProgress.CommunityID = communityID
 		importProgress.CommunityImages = make(map[string]images.IdentityImage)
 
@@ -2694,6 +2845,18 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 		importProgress.UpdateTaskProgress(discord.ImportMessagesTask, 1)
 		progressUpdates <- importProgress
 
+		if !m.dataPolicy.Allowed(connection.DataMedia) {
+			m.logger.Info("deferring the download of discord assets until on an unmetered connection")
+			if !m.waitForDataPolicy(connection.DataMedia, m.discordImportCancel(communityID)) {
+				if m.DiscordImportMarkedAsCancelled(communityID) {
+					importProgress.StopTask(discord.DownloadAssetsTask)
+					progressUpdates <- importProgress
+					cancel <- communityID
+				}
+				return
+			}
+		}
+
 		totalAssetsCount := len(messageAttachmentsToDownload) + len(authorProfilesToSave)
 		var assetCounter discord.AssetCounter
 
@@ -2889,12 +3052,12 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 			return
 		}
 
-		filterChatIds := discordCommunity.DefaultFilters()
+		var chatIDs []string
 		for _, chatID := range processedChannelIds {
-			filterChatIds = append(filterChatIds, chatID)
+			chatIDs = append(chatIDs, chatID)
 		}
 
-		filters, err := m.transport.InitPublicFilters(filterChatIds)
+		filters, err := m.initCommunityFilters(discordCommunity, chatIDs)
 		if err != nil {
 			m.cleanUpImport(communityID)
 			importProgress.AddTaskError(discord.InitCommunityTask, discord.Error(err.Error()))
@@ -2913,7 +3076,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 			return
 		}
 
-		_, err = m.scheduleSyncFilters(filters)
+		_, err = m.scheduleSyncCommunityFilters(filters)
 		if err != nil {
 			m.cleanUpImport(communityID)
 			importProgress.AddTaskError(discord.InitCommunityTask, discord.Error(err.Error()))
@@ -3006,6 +3169,21 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 
 func (m *Messenger) MarkDiscordCommunityImportAsCancelled(communityID string) {
 	m.importingCommunities[communityID] = true
+
+	m.discordImportCancelsLock.Lock()
+	defer m.discordImportCancelsLock.Unlock()
+	if c, ok := m.discordImportCancels[communityID]; ok {
+		close(c)
+		delete(m.discordImportCancels, communityID)
+	}
+}
+
+// discordImportCancel returns a channel closed when the import of communityID is
+// cancelled, nil if it isn't in progress
+func (m *Messenger) discordImportCancel(communityID string) <-chan struct{} {
+	m.discordImportCancelsLock.Lock()
+	defer m.discordImportCancelsLock.Unlock()
+	return m.discordImportCancels[communityID]
 }
 
 func (m *Messenger) DiscordImportMarkedAsCancelled(communityID string) bool {
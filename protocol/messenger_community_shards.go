@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/transport"
+)
+
+// Community chats are relayed on the Waku v2 shard of their community, so that nodes
+// only relay the traffic of the communities they are in. The control topics of a
+// community, see DefaultFilters, stay on the default pubsub topic as they must be
+// reachable by anyone who only knows the community id.
+
+// communityPubsubTopic returns the pubsub topic the chats of community are relayed on,
+// the default one when community sharding is disabled
+func (m *Messenger) communityPubsubTopic(community *communities.Community) string {
+	if !m.featureFlags.CommunitySharding {
+		return ""
+	}
+	return community.PubsubTopic()
+}
+
+// initCommunityChatFilters loads the filters of the given chats of community on its
+// pubsub topic, replacing the ones on another pubsub topic
+func (m *Messenger) initCommunityChatFilters(community *communities.Community, chatIDs []string) ([]*transport.Filter, error) {
+	return m.transport.InitPublicFiltersOnPubsubTopic(chatIDs, m.communityPubsubTopic(community))
+}
+
+// initCommunityFilters loads the filters of the control topics of community and of the given chats
+func (m *Messenger) initCommunityFilters(community *communities.Community, chatIDs []string) ([]*transport.Filter, error) {
+	filters, err := m.transport.InitPublicFilters(community.DefaultFilters())
+	if err != nil {
+		return nil, err
+	}
+
+	chatFilters, err := m.initCommunityChatFilters(community, chatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(filters, chatFilters...), nil
+}
+
+// communityChatsOffPubsubTopic returns the chats of community whose filters are on
+// another pubsub topic than the community, e.g. after it moved to another shard
+func (m *Messenger) communityChatsOffPubsubTopic(community *communities.Community) []string {
+	pubsubTopic := m.communityPubsubTopic(community)
+
+	var chatIDs []string
+	for chatID := range community.Chats() {
+		chatID = community.IDString() + chatID
+		filter := m.transport.FilterByChatID(chatID)
+		if filter != nil && filter.PubsubTopic != pubsubTopic {
+			chatIDs = append(chatIDs, chatID)
+		}
+	}
+	return chatIDs
+}
+
+// SetCommunityShard moves the chats of a community we own to the given shard,
+// or back to the one derived from its id
+func (m *Messenger) SetCommunityShard(request *requests.SetCommunityShard) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, changes, err := m.communitiesManager.SetShard(request)
+	if err != nil {
+		return nil, err
+	}
+
+	filters, err := m.initCommunityChatFilters(community, m.communityChatsOffPubsubTopic(community))
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.scheduleSyncCommunityFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var response MessengerResponse
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return &response, nil
+}
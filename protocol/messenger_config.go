@@ -3,6 +3,8 @@ package protocol
 import (
 	"database/sql"
 	"encoding/json"
+	"sort"
+	"time"
 
 	"github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/server"
@@ -11,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/appdatabase/migrations"
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/multiaccounts/settings"
@@ -56,6 +59,9 @@ type MessengerSignalsHandler interface {
 	SendWakuFetchingBackupProgress(response *wakusync.WakuBackedUpDataResponse)
 	SendWakuBackedUpProfile(response *wakusync.WakuBackedUpDataResponse)
 	SendWakuBackedUpSettings(response *wakusync.WakuBackedUpDataResponse)
+	FileTransferProgress(progress *FileTransferProgress)
+	TypingIndicator(indicator *TypingIndicator)
+	DataPolicyResumed(categories []connection.DataCategory)
 }
 
 type config struct {
@@ -83,6 +89,7 @@ type config struct {
 	browserDatabase     *browsers.Database
 	torrentConfig       *params.TorrentConfig
 	httpServer          *server.MediaServer
+	dataPolicy          *connection.DataPolicy
 	rpcClient           *rpc.Client
 
 	verifyTransactionClient  EthClient
@@ -102,6 +109,15 @@ type config struct {
 	messengerSignalsHandler MessengerSignalsHandler
 
 	telemetryServerURL string
+
+	// maxFileAttachmentSize is the maximum size in bytes of sent and received file attachments
+	maxFileAttachmentSize uint64
+
+	// messagePaddingBuckets are the sizes in bytes encrypted payloads are padded to
+	messagePaddingBuckets []int
+
+	// outboxDeadline is how long messages that can't be sent are retried for
+	outboxDeadline time.Duration
 }
 
 type Option func(*config) error
@@ -250,6 +266,33 @@ func WithDatasync() func(c *config) error {
 	}
 }
 
+// WithMessagePadding pads encrypted payloads to the smallest of the given sizes, in bytes,
+// they fit in, so that relays and store nodes can't tell messages apart by their size.
+// Fewer, larger buckets leak less information at the cost of more bandwidth.
+func WithMessagePadding(buckets []int) Option {
+	return func(c *config) error {
+		sorted := make([]int, len(buckets))
+		copy(sorted, buckets)
+		sort.Ints(sorted)
+		c.messagePaddingBuckets = sorted
+		return nil
+	}
+}
+
+func WithSenderKeys() func(c *config) error {
+	return func(c *config) error {
+		c.featureFlags.SenderKeys = true
+		return nil
+	}
+}
+
+func WithCommunitySharding() func(c *config) error {
+	return func(c *config) error {
+		c.featureFlags.CommunitySharding = true
+		return nil
+	}
+}
+
 func WithPushNotifications() func(c *config) error {
 	return func(c *config) error {
 		c.featureFlags.PushNotifications = true
@@ -301,6 +344,15 @@ func WithHTTPServer(s *server.MediaServer) Option {
 	}
 }
 
+// WithDataPolicy shares the data policy of the node, deferring non-essential
+// traffic while on a metered connection
+func WithDataPolicy(p *connection.DataPolicy) Option {
+	return func(c *config) error {
+		c.dataPolicy = p
+		return nil
+	}
+}
+
 func WithRPCClient(r *rpc.Client) Option {
 	return func(c *config) error {
 		c.rpcClient = r
@@ -308,6 +360,22 @@ func WithRPCClient(r *rpc.Client) Option {
 	}
 }
 
+func WithMaxFileAttachmentSize(size uint64) Option {
+	return func(c *config) error {
+		c.maxFileAttachmentSize = size
+		return nil
+	}
+}
+
+// WithOutboxDeadline sets how long messages that can't be sent are resent for,
+// before they're reported as expired
+func WithOutboxDeadline(deadline time.Duration) Option {
+	return func(c *config) error {
+		c.outboxDeadline = deadline
+		return nil
+	}
+}
+
 func WithMessageCSV(enabled bool) Option {
 	return func(c *config) error {
 		c.outputMessagesCSV = enabled
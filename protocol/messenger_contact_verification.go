@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -15,6 +16,7 @@ import (
 	"github.com/status-im/status-go/eth-node/types"
 
 	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/verification"
@@ -1029,6 +1031,75 @@ func (m *Messenger) GetLatestVerificationRequestFrom(contactID string) (*verific
 	return m.verificationDatabase.GetLatestVerificationRequestFrom(contactID)
 }
 
+// GetSafetyNumber returns the safety number shared with a contact, to be compared out of band
+func (m *Messenger) GetSafetyNumber(contactID string) (*verification.SafetyNumber, error) {
+	publicKey, err := common.HexToPubkey(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	return verification.NewSafetyNumber(&m.identity.PublicKey, publicKey)
+}
+
+// handleContactInstallations alerts about verified contacts using an installation
+// we didn't know of, as their keys might have been compromised, and marks them
+// for re-verification
+func (m *Messenger) handleContactInstallations(state *ReceivedMessageState, publicKey *ecdsa.PublicKey, installations []*multidevice.Installation) error {
+	contactID := contactIDFromPublicKey(publicKey)
+	if len(installations) == 0 || contactID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil
+	}
+
+	contact, ok := state.AllContacts.Load(contactID)
+	if !ok || !contact.IsVerified() {
+		return nil
+	}
+
+	var installationIDs []string
+	for _, installation := range installations {
+		if installation.Identity == contactID {
+			installationIDs = append(installationIDs, installation.ID)
+		}
+	}
+	if len(installationIDs) == 0 {
+		return nil
+	}
+
+	// Nothing changed if these are the first installations we see
+	knownInstallations, err := m.encryptor.GetInstallations(publicKey)
+	if err != nil {
+		return err
+	}
+	if len(knownInstallations) <= len(installationIDs) {
+		return nil
+	}
+
+	m.logger.Info("verified contact is using an unknown installation",
+		zap.String("contact-id", contactID),
+		zap.Strings("installation-ids", installationIDs))
+
+	clock := m.getTimesource().GetCurrentTime()
+	contact.VerificationStatus = VerificationStatusREVERIFY
+	contact.LastUpdatedLocally = clock
+	err = m.persistence.SaveContact(contact, nil)
+	if err != nil {
+		return err
+	}
+	state.AllContacts.Store(contact.ID, contact)
+	state.ModifiedContacts.Store(contact.ID, true)
+
+	notification := &ActivityCenterNotification{
+		ID:        types.HexBytes(crypto.Keccak256([]byte(contactID + strings.Join(installationIDs, "")))),
+		Name:      contact.CanonicalName(),
+		Type:      ActivityCenterNotificationTypeContactKeyChanged,
+		Author:    contactID,
+		Timestamp: clock,
+		ChatID:    contactID,
+	}
+
+	return m.addActivityCenterNotification(state.Response, notification)
+}
+
 func (m *Messenger) createOrUpdateOutgoingContactVerificationNotification(contact *Contact, response *MessengerResponse, vr *verification.Request, chatMessage *common.Message, replyMessage *common.Message) error {
 	notification := &ActivityCenterNotification{
 		ID:                        types.FromHex(vr.ID),
@@ -678,3 +678,76 @@ func (s *MessengerVerificationRequests) newMessenger(shh types.Waku) *Messenger
 	s.Require().NoError(err)
 	return messenger
 }
+
+func (s *MessengerVerificationRequests) TestSafetyNumber() {
+	theirMessenger := s.newMessenger(s.shh)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	ourPk := types.EncodeHex(crypto.FromECDSAPub(&s.m.identity.PublicKey))
+	theirPk := types.EncodeHex(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))
+
+	ours, err := s.m.GetSafetyNumber(theirPk)
+	s.Require().NoError(err)
+	theirs, err := theirMessenger.GetSafetyNumber(ourPk)
+	s.Require().NoError(err)
+	s.Require().Equal(ours, theirs)
+
+	_, err = s.m.GetSafetyNumber("0x01")
+	s.Require().Error(err)
+}
+
+func (s *MessengerVerificationRequests) TestVerifiedContactUnknownInstallation() {
+	theirMessenger := s.newMessenger(s.shh)
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	s.mutualContact(theirMessenger)
+
+	theirPk := types.EncodeHex(crypto.FromECDSAPub(&theirMessenger.identity.PublicKey))
+	contact, ok := s.m.allContacts.Load(theirPk)
+	s.Require().True(ok)
+	contact.VerificationStatus = VerificationStatusVERIFIED
+	s.Require().NoError(s.m.persistence.SaveContact(contact, nil))
+
+	// A new installation of the contact, not paired with the others
+	theirNewDevice, err := newMessengerWithKey(s.shh, theirMessenger.identity, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = theirNewDevice.Start()
+	s.Require().NoError(err)
+	defer theirNewDevice.Shutdown() // nolint: errcheck
+
+	chat := CreateOneToOneChat(common.PubkeyToHex(&s.m.identity.PublicKey), &s.m.identity.PublicKey, theirNewDevice.transport)
+	s.Require().NoError(theirNewDevice.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	_, err = theirNewDevice.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	resp, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool {
+			for _, notification := range r.ActivityCenterNotifications() {
+				if notification.Type == ActivityCenterNotificationTypeContactKeyChanged {
+					return true
+				}
+			}
+			return false
+		},
+		"no key change notification",
+	)
+	s.Require().NoError(err)
+
+	var notification *ActivityCenterNotification
+	for _, n := range resp.ActivityCenterNotifications() {
+		if n.Type == ActivityCenterNotificationTypeContactKeyChanged {
+			notification = n
+		}
+	}
+	s.Require().Equal(theirPk, notification.Author)
+
+	contact, ok = s.m.allContacts.Load(theirPk)
+	s.Require().True(ok)
+	s.Require().True(contact.NeedsReverification())
+	s.Require().False(contact.IsVerified())
+}
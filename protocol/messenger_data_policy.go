@@ -0,0 +1,106 @@
+package protocol
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/connection"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/transport"
+)
+
+var ErrInvalidDataPolicy = errors.New("invalid data policy")
+
+// loadDataPolicy applies the data policy configured by the user
+func (m *Messenger) loadDataPolicy() error {
+	modes, err := m.settings.DataPolicy()
+	if err != nil {
+		return err
+	}
+	m.dataPolicyChanged(m.dataPolicy.SetModes(modes))
+	return nil
+}
+
+// dataPolicyChanged notifies that the traffic of categories deferred so far is allowed again
+func (m *Messenger) dataPolicyChanged(resumed []connection.DataCategory) {
+	if len(resumed) == 0 {
+		return
+	}
+	m.logger.Info("resuming deferred traffic", zap.Any("categories", resumed))
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.DataPolicyResumed(resumed)
+	}
+}
+
+// DataPolicy returns on which connections each category of non-essential traffic is allowed
+func (m *Messenger) DataPolicy() map[connection.DataCategory]connection.DataMode {
+	return m.dataPolicy.Modes()
+}
+
+// SetDataPolicy sets on which connections the given categories of non-essential traffic
+// are allowed. The traffic deferred so far is resumed if it's allowed as a result.
+func (m *Messenger) SetDataPolicy(modes map[connection.DataCategory]connection.DataMode) error {
+	for category, mode := range modes {
+		if _, ok := connection.DefaultDataModes[category]; !ok {
+			return ErrInvalidDataPolicy
+		}
+		if mode != connection.DataAlways && mode != connection.DataUnmetered {
+			return ErrInvalidDataPolicy
+		}
+	}
+
+	configured, err := m.settings.DataPolicy()
+	if err != nil {
+		return err
+	}
+	if configured == nil {
+		configured = make(map[connection.DataCategory]connection.DataMode)
+	}
+	for category, mode := range modes {
+		configured[category] = mode
+	}
+
+	err = m.settings.SaveSettingField(settings.DataPolicy, configured)
+	if err != nil {
+		return err
+	}
+
+	m.dataPolicyChanged(m.dataPolicy.SetModes(modes))
+	return nil
+}
+
+// waitForDataPolicy blocks until category is allowed. It returns false if cancel
+// is closed or the messenger is stopped first.
+func (m *Messenger) waitForDataPolicy(category connection.DataCategory, cancel <-chan struct{}) bool {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-cancel:
+		case <-m.quit:
+		case <-done:
+			return
+		}
+		close(stop)
+	}()
+
+	return m.dataPolicy.Wait(category, stop)
+}
+
+// scheduleSyncCommunityFilters requests the history of community filters from
+// store nodes, once on an unmetered connection if so configured
+func (m *Messenger) scheduleSyncCommunityFilters(filters []*transport.Filter) (bool, error) {
+	deferred := m.dataPolicy.Defer(connection.DataCommunityHistory, func() {
+		_, err := m.scheduleSyncFilters(filters)
+		if err != nil {
+			m.logger.Error("failed to sync deferred community filters", zap.Error(err))
+		}
+	})
+	if deferred {
+		return false, nil
+	}
+	return m.scheduleSyncFilters(filters)
+}
@@ -0,0 +1,113 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/connection"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerDataPolicySuite(t *testing.T) {
+	suite.Run(t, new(MessengerDataPolicySuite))
+}
+
+type MessengerDataPolicySuite struct {
+	suite.Suite
+	m      *Messenger
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerDataPolicySuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.m, err = newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = s.m.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerDataPolicySuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerDataPolicySuite) TestSetDataPolicy() {
+	s.Require().Equal(connection.DefaultDataModes, s.m.DataPolicy())
+
+	err := s.m.SetDataPolicy(map[connection.DataCategory]connection.DataMode{"unknown": connection.DataAlways})
+	s.Require().ErrorIs(err, ErrInvalidDataPolicy)
+	err = s.m.SetDataPolicy(map[connection.DataCategory]connection.DataMode{connection.DataIPFS: "never"})
+	s.Require().ErrorIs(err, ErrInvalidDataPolicy)
+
+	err = s.m.SetDataPolicy(map[connection.DataCategory]connection.DataMode{connection.DataIPFS: connection.DataUnmetered})
+	s.Require().NoError(err)
+	err = s.m.SetDataPolicy(map[connection.DataCategory]connection.DataMode{connection.DataHistoryArchives: connection.DataAlways})
+	s.Require().NoError(err)
+
+	// The configured modes are persisted
+	modes, err := s.m.settings.DataPolicy()
+	s.Require().NoError(err)
+	s.Require().Equal(map[connection.DataCategory]connection.DataMode{
+		connection.DataIPFS:            connection.DataUnmetered,
+		connection.DataHistoryArchives: connection.DataAlways,
+	}, modes)
+
+	s.m.ConnectionChanged(connection.State{Type: connection.NewType(connection.Cellular)})
+	s.Require().False(s.m.dataPolicy.Allowed(connection.DataIPFS))
+	s.Require().True(s.m.dataPolicy.Allowed(connection.DataHistoryArchives))
+}
+
+func (s *MessengerDataPolicySuite) TestDeferCommunityHistory() {
+	s.m.ConnectionChanged(connection.State{Type: connection.NewType(connection.Cellular)})
+
+	willSync, err := s.m.scheduleSyncCommunityFilters(nil)
+	s.Require().NoError(err)
+	s.Require().False(willSync)
+
+	resumed := s.m.dataPolicy.ConnectionChanged(connection.State{Type: connection.NewType(connection.Wifi)})
+	s.Require().Equal([]connection.DataCategory{connection.DataCommunityHistory}, resumed)
+}
+
+func (s *MessengerDataPolicySuite) TestCancelDiscordImportWaitingForMedia() {
+	err := s.m.SetDataPolicy(map[connection.DataCategory]connection.DataMode{connection.DataMedia: connection.DataUnmetered})
+	s.Require().NoError(err)
+	s.m.ConnectionChanged(connection.State{Type: connection.NewType(connection.Cellular)})
+	s.Require().False(s.m.dataPolicy.Allowed(connection.DataMedia))
+
+	communityID := "0x01"
+	s.m.importingCommunities[communityID] = false
+	s.m.discordImportCancels[communityID] = make(chan struct{})
+	cancel := s.m.discordImportCancel(communityID)
+
+	allowed := make(chan bool)
+	go func() {
+		allowed <- s.m.waitForDataPolicy(connection.DataMedia, cancel)
+	}()
+
+	s.m.MarkDiscordCommunityImportAsCancelled(communityID)
+
+	select {
+	case a := <-allowed:
+		s.Require().False(a)
+	case <-time.After(5 * time.Second):
+		s.Fail("the import is still waiting for an unmetered connection")
+	}
+	s.Require().Nil(s.m.discordImportCancel(communityID))
+}
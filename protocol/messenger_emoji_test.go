@@ -204,3 +204,62 @@ func (s *MessengerEmojiSuite) TestEmojiPrivateGroup() {
 	s.Require().NoError(err)
 	s.Require().NoError(alice.Shutdown())
 }
+
+func (s *MessengerEmojiSuite) TestSendUnicodeEmoji() {
+	alice := s.m
+	alice.account = &multiaccounts.Account{KeyUID: "0xdeadbeef"}
+
+	bob := s.newMessenger(s.shh)
+	_, err := bob.Start()
+	s.Require().NoError(err)
+	defer bob.Shutdown() // nolint: errcheck
+
+	chat := CreatePublicChat(statusChatID, alice.transport)
+
+	err = alice.SaveChat(chat)
+	s.Require().NoError(err)
+
+	_, err = alice.Join(chat)
+	s.Require().NoError(err)
+
+	err = bob.SaveChat(chat)
+	s.Require().NoError(err)
+
+	_, err = bob.Join(chat)
+	s.Require().NoError(err)
+
+	message := buildTestMessage(*chat)
+	_, err = alice.SendChatMessage(context.Background(), message)
+	s.NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no messages",
+	)
+	s.Require().NoError(err)
+	messageID := response.Messages()[0].ID
+
+	_, err = bob.SendUnicodeEmojiReaction(context.Background(), chat.ID, messageID, "not an emoji")
+	s.Require().Equal(ErrInvalidEmoji, err)
+
+	response, err = bob.SendUnicodeEmojiReaction(context.Background(), chat.ID, messageID, "🚀")
+	s.Require().NoError(err)
+	s.Require().Len(response.EmojiReactions, 1)
+
+	emojiID := response.EmojiReactions[0].ID()
+
+	response, err = WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool { return len(r.EmojiReactions) > 0 },
+		"no emoji",
+	)
+	s.Require().NoError(err)
+
+	s.Require().Len(response.EmojiReactions, 1)
+	s.Require().Equal(emojiID, response.EmojiReactions[0].ID())
+	s.Require().Equal("🚀", response.EmojiReactions[0].Emoji)
+
+	_, err = bob.SendCustomEmojiReaction(context.Background(), chat.ID, messageID, "emoji-id")
+	s.Require().Error(err)
+}
@@ -0,0 +1,458 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerFileAttachmentSuite(t *testing.T) {
+	suite.Run(t, new(MessengerFileAttachmentSuite))
+}
+
+type MessengerFileAttachmentSuite struct {
+	suite.Suite
+	m   *Messenger
+	bob *Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerFileAttachmentSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.m = s.newMessenger()
+	s.bob = s.newMessenger()
+	_, err := s.m.Start()
+	s.Require().NoError(err)
+	_, err = s.bob.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerFileAttachmentSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	s.Require().NoError(s.bob.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerFileAttachmentSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerFileAttachmentSuite) writeFile(size int) (string, []byte) {
+	return s.writeNamedFile("document.pdf", nil, size)
+}
+
+func (s *MessengerFileAttachmentSuite) writeNamedFile(name string, header []byte, size int) (string, []byte) {
+	payload := make([]byte, size)
+	_, err := rand.Read(payload)
+	s.Require().NoError(err)
+	copy(payload, header)
+
+	dir, err := ioutil.TempDir("", "file-attachment")
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, name)
+	s.Require().NoError(ioutil.WriteFile(path, payload, 0600))
+	return path, payload
+}
+
+func (s *MessengerFileAttachmentSuite) sendFile(path string) *common.Message {
+	chat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = ""
+	message.FilePath = path
+
+	response, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+
+	sent := response.Messages()[0]
+	s.Require().Equal(protobuf.ChatMessage_FILE, sent.ContentType)
+	s.Require().Equal("document.pdf", sent.Text)
+	s.Require().Equal("application/pdf", sent.GetFile().MimeType)
+	return sent
+}
+
+func (s *MessengerFileAttachmentSuite) waitForFile(hash []byte) {
+	err := tt.RetryWithBackOff(func() error {
+		_, err := s.bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		exists, err := s.bob.persistence.HasFileAttachment(hash)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New("file not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerFileAttachmentSuite) TestSendInlinedFile() {
+	path, payload := s.writeFile(1024)
+	sent := s.sendFile(path)
+	s.Require().Zero(sent.GetFile().ChunksCount)
+
+	hash := sha256.Sum256(payload)
+	s.waitForFile(hash[:])
+
+	received, err := s.bob.persistence.FileAttachment(hash[:])
+	s.Require().NoError(err)
+	s.Require().Equal(payload, received)
+
+	message, err := s.bob.MessageByID(sent.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(protobuf.ChatMessage_FILE, message.ContentType)
+	s.Require().Equal(uint64(len(payload)), message.GetFile().Size)
+	s.Require().Equal(hash[:], message.GetFile().Hash)
+}
+
+func (s *MessengerFileAttachmentSuite) TestSendChunkedFile() {
+	path, payload := s.writeFile(2*fileChunkSize + 100)
+	sent := s.sendFile(path)
+	s.Require().Equal(uint32(3), sent.GetFile().ChunksCount)
+
+	hash := sha256.Sum256(payload)
+	s.waitForFile(hash[:])
+
+	received, err := s.bob.persistence.FileAttachment(hash[:])
+	s.Require().NoError(err)
+	s.Require().Equal(payload, received)
+
+	count, err := s.bob.persistence.FileAttachmentChunksCount(sent.ID, sent.From)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+}
+
+func (s *MessengerFileAttachmentSuite) TestDeleteFileForMeAndSync() {
+	path, _ := s.writeFile(1024)
+	sent := s.sendFile(path)
+
+	response, err := s.m.DeleteMessageForMeAndSync(context.Background(), sent.ChatId, sent.ID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	s.Require().True(response.Messages()[0].DeletedForMe)
+}
+
+func (s *MessengerFileAttachmentSuite) TestFileTooLarge() {
+	s.m.config.maxFileAttachmentSize = 100
+	path, _ := s.writeFile(101)
+
+	chat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = ""
+	message.FilePath = path
+
+	_, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().Equal(ErrFileAttachmentTooLarge, err)
+}
+
+// chunkedFile returns the description of a file sent in two chunks, along with the chunks
+func (s *MessengerFileAttachmentSuite) chunkedFile() (*protobuf.FileMessage, [][]byte) {
+	payload := make([]byte, fileChunkSize+100)
+	_, err := rand.Read(payload)
+	s.Require().NoError(err)
+
+	hash := sha256.Sum256(payload)
+	file := &protobuf.FileMessage{
+		Name:        "document.pdf",
+		MimeType:    "application/pdf",
+		Size:        uint64(len(payload)),
+		Hash:        hash[:],
+		ChunksCount: 2,
+	}
+
+	chunks := [][]byte{fileChunk(payload, 0), fileChunk(payload, 1)}
+	for _, chunk := range chunks {
+		chunkHash := sha256.Sum256(chunk)
+		file.ChunkHashes = append(file.ChunkHashes, chunkHash[:])
+	}
+	return file, chunks
+}
+
+// saveFileMessage stores the message describing the file in bob's database, as if sent by alice
+func (s *MessengerFileAttachmentSuite) saveFileMessage(file *protobuf.FileMessage) (*Chat, *common.Message) {
+	chat := CreateOneToOneChat("alice", &s.m.identity.PublicKey, s.bob.transport)
+	s.Require().NoError(s.bob.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.ID = "0x01"
+	message.From = common.PubkeyToHex(&s.m.identity.PublicKey)
+	message.ContentType = protobuf.ChatMessage_FILE
+	message.Payload = &protobuf.ChatMessage_File{File: file}
+	s.Require().NoError(s.bob.persistence.SaveMessages([]*common.Message{message}))
+	return chat, message
+}
+
+func (s *MessengerFileAttachmentSuite) receiveChunk(sender *ecdsa.PublicKey, messageID string, file *protobuf.FileMessage, index uint32, payload []byte) error {
+	contact, err := BuildContactFromPublicKey(sender)
+	s.Require().NoError(err)
+
+	state := &ReceivedMessageState{
+		Response:   &MessengerResponse{},
+		Timesource: s.bob.getTimesource(),
+		CurrentMessageState: &CurrentMessageState{
+			Contact:   contact,
+			PublicKey: sender,
+		},
+	}
+
+	return s.bob.HandleFileChunk(state, protobuf.FileChunk{
+		Clock:       s.bob.getTimesource().GetCurrentTime(),
+		ChatId:      "alice",
+		MessageId:   messageID,
+		FileHash:    file.Hash,
+		Index:       index,
+		ChunksCount: file.ChunksCount,
+		Payload:     payload,
+	})
+}
+
+func (s *MessengerFileAttachmentSuite) TestForgedFileChunkIsIgnored() {
+	file, chunks := s.chunkedFile()
+	_, message := s.saveFileMessage(file)
+	alice := &s.m.identity.PublicKey
+
+	eve, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	// Someone else than the author of the message
+	err = s.receiveChunk(&eve.PublicKey, message.ID, file, 0, chunks[1])
+	s.Require().Equal(ErrFileAttachmentChunkAlien, err)
+
+	// A chunk not matching its announced hash
+	err = s.receiveChunk(alice, message.ID, file, 0, chunks[1])
+	s.Require().Equal(ErrFileAttachmentCorrupted, err)
+
+	count, err := s.bob.persistence.FileAttachmentChunksCount(message.ID, message.From)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+
+	s.Require().NoError(s.receiveChunk(alice, message.ID, file, 0, chunks[0]))
+	s.Require().NoError(s.receiveChunk(alice, message.ID, file, 1, chunks[1]))
+
+	exists, err := s.bob.persistence.HasFileAttachment(file.Hash)
+	s.Require().NoError(err)
+	s.Require().True(exists)
+}
+
+func (s *MessengerFileAttachmentSuite) TestOnlyCorruptedFileChunkIsDropped() {
+	file, chunks := s.chunkedFile()
+	alice := &s.m.identity.PublicKey
+	messageID := "0x01"
+
+	// Chunks received before the message can't be verified yet
+	s.Require().NoError(s.receiveChunk(alice, messageID, file, 0, chunks[1]))
+	s.Require().NoError(s.receiveChunk(alice, messageID, file, 1, chunks[1]))
+
+	chat, message := s.saveFileMessage(file)
+	s.Require().NoError(s.bob.handleReceivedFileAttachment(chat, message, file))
+
+	received, err := s.bob.persistence.FileAttachmentChunks(message.ID, message.From, file.Hash)
+	s.Require().NoError(err)
+	s.Require().Len(received, 1)
+	s.Require().Equal(chunks[1], received[1])
+
+	s.Require().NoError(s.receiveChunk(alice, message.ID, file, 0, chunks[0]))
+
+	exists, err := s.bob.persistence.HasFileAttachment(file.Hash)
+	s.Require().NoError(err)
+	s.Require().True(exists)
+}
+
+func (s *MessengerFileAttachmentSuite) TestPendingFileChunksAreCapped() {
+	s.bob.config.maxFileAttachmentSize = fileChunkSize
+	file, chunks := s.chunkedFile()
+
+	eve, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	for i := 0; i < maxPendingFilesPerSender; i++ {
+		s.Require().NoError(s.receiveChunk(&eve.PublicKey, fmt.Sprintf("0x%02d", i), file, 0, chunks[0]))
+	}
+
+	err = s.receiveChunk(&eve.PublicKey, "0xff", file, 0, chunks[0])
+	s.Require().Equal(ErrFileAttachmentChunksFull, err)
+
+	// Other senders are not affected
+	s.Require().NoError(s.receiveChunk(&s.m.identity.PublicKey, "0xff", file, 0, chunks[0]))
+}
+
+// mp4Header is the beginning of an mp4 file, enough for its content type to be detected
+var mp4Header = []byte{0x00, 0x00, 0x00, 0x18, 'f', 't', 'y', 'p', 'm', 'p', '4', '2', 0x00, 0x00, 0x00, 0x00, 'm', 'p', '4', '2', 'i', 's', 'o', 'm'}
+
+func (s *MessengerFileAttachmentSuite) TestSendVideo() {
+	path, payload := s.writeNamedFile("video.mp4", mp4Header, fileChunkSize+100)
+
+	chat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = ""
+	message.VideoPath = path
+	message.VideoThumbnailPath = "../_assets/tests/elephant.jpg"
+	message.Payload = &protobuf.ChatMessage_Video{
+		Video: &protobuf.VideoMessage{DurationMs: 1500, Width: 640, Height: 480},
+	}
+
+	response, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+
+	sent := response.Messages()[0]
+	s.Require().Equal(protobuf.ChatMessage_VIDEO, sent.ContentType)
+	s.Require().Equal("video.mp4", sent.Text)
+	s.Require().Equal("video/mp4", sent.GetVideo().File.MimeType)
+	s.Require().Equal(uint32(2), sent.GetVideo().File.ChunksCount)
+	s.Require().NotEmpty(sent.GetVideo().Thumbnail)
+
+	hash := sha256.Sum256(payload)
+	s.waitForFile(hash[:])
+
+	received, err := s.bob.persistence.FileAttachment(hash[:])
+	s.Require().NoError(err)
+	s.Require().Equal(payload, received)
+
+	receivedMessage, err := s.bob.MessageByID(sent.ID)
+	s.Require().NoError(err)
+	video := receivedMessage.GetVideo()
+	s.Require().NotNil(video)
+	s.Require().Equal(uint64(1500), video.DurationMs)
+	s.Require().Equal(uint32(640), video.Width)
+	s.Require().Equal(uint32(480), video.Height)
+	s.Require().Equal(protobuf.ImageType_JPEG, video.ThumbnailType)
+	s.Require().Equal(sent.GetVideo().Thumbnail, video.Thumbnail)
+	s.Require().Equal(hash[:], video.File.Hash)
+}
+
+func (s *MessengerFileAttachmentSuite) TestSendVideoNotAVideo() {
+	path, _ := s.writeFile(100)
+
+	chat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.VideoPath = path
+
+	_, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().Equal(ErrVideoAttachmentNotVideo, err)
+}
+
+// sendTextToAlice makes sure alice received bob's bundle, and with it the capabilities of his installation
+func (s *MessengerFileAttachmentSuite) sendTextToAlice() {
+	chat := CreateOneToOneChat("alice", &s.m.identity.PublicKey, s.bob.transport)
+	s.Require().NoError(s.bob.SaveChat(chat))
+
+	_, err := s.bob.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerFileAttachmentSuite) TestSendFileToCapableInstallation() {
+	s.sendTextToAlice()
+
+	path, _ := s.writeFile(1024)
+	sent := s.sendFile(path)
+
+	response, err := WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"file not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sent.ID, response.Messages()[0].ID)
+	s.Require().Equal(protobuf.ChatMessage_FILE, response.Messages()[0].ContentType)
+}
+
+func (s *MessengerFileAttachmentSuite) TestSendFileFallback() {
+	// Bob's installation doesn't understand file attachments
+	s.bob.encryptor.SetCapabilities([]string{common.CapabilityVideoMessages})
+	s.sendTextToAlice()
+
+	path, _ := s.writeFile(1024)
+	sent := s.sendFile(path)
+	s.Require().Equal(common.OutgoingStatusSent, sent.OutgoingStatus)
+
+	response, err := WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"fallback not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+
+	received := response.Messages()[0]
+	s.Require().NotEqual(sent.ID, received.ID)
+	s.Require().Equal(protobuf.ChatMessage_TEXT_PLAIN, received.ContentType)
+	s.Require().Equal("Sent a file: document.pdf", received.Text)
+
+	// The original message is never sent to Bob's installation
+	_, err = s.bob.RetrieveAll()
+	s.Require().NoError(err)
+	_, err = s.bob.MessageByID(sent.ID)
+	s.Require().Equal(common.ErrRecordNotFound, err)
+}
+
+func (s *MessengerFileAttachmentSuite) TestSendFileToUnknownInstallation() {
+	// Bob's installation doesn't advertise its capabilities, it might be up to date
+	s.bob.encryptor.SetCapabilities(nil)
+	s.sendTextToAlice()
+
+	path, _ := s.writeFile(1024)
+	sent := s.sendFile(path)
+
+	response, err := WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"file not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sent.ID, response.Messages()[0].ID)
+	s.Require().Equal(protobuf.ChatMessage_FILE, response.Messages()[0].ContentType)
+}
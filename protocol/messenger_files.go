@@ -0,0 +1,383 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	userimage "github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// defaultMaxFileAttachmentSize is used when no limit has been configured
+	defaultMaxFileAttachmentSize = 20 * 1024 * 1024
+	// fileChunkSize is the maximum size of a file payload sent in a single message,
+	// bigger files are split in chunks of this size
+	fileChunkSize = 256 * 1024
+	// maxPendingFilesPerSender is how many files of the maximum size a sender can have
+	// waiting for their message to be received
+	maxPendingFilesPerSender = 4
+)
+
+var (
+	ErrFileAttachmentTooLarge   = errors.New("file attachment is too large")
+	ErrFileAttachmentEmpty      = errors.New("file attachment is empty")
+	ErrFileAttachmentCorrupted  = errors.New("file attachment does not match its hash")
+	ErrFileAttachmentChunkLarge = errors.New("file attachment chunk is too large")
+	ErrFileAttachmentChunkAlien = errors.New("file attachment chunk does not belong to its message")
+	ErrFileAttachmentChunksFull = errors.New("too many pending file attachment chunks")
+	ErrVideoAttachmentNotVideo  = errors.New("file attachment is not a video")
+)
+
+// FileTransferProgress is emitted each time a chunk of a file attachment is sent or received
+type FileTransferProgress struct {
+	ChatID      string `json:"chatId"`
+	MessageID   string `json:"messageId"`
+	FileHash    string `json:"fileHash"`
+	Outgoing    bool   `json:"outgoing"`
+	ChunksCount uint32 `json:"chunksCount"`
+	ChunksDone  uint32 `json:"chunksDone"`
+}
+
+func (m *Messenger) maxFileAttachmentSize() uint64 {
+	if m.config.maxFileAttachmentSize != 0 {
+		return m.config.maxFileAttachmentSize
+	}
+	return defaultMaxFileAttachmentSize
+}
+
+// maxPendingFileChunksPerSender bounds the chunks a single sender can have stored
+// before the message describing their file is received
+func (m *Messenger) maxPendingFileChunksPerSender() int {
+	return maxPendingFilesPerSender * int((m.maxFileAttachmentSize()+fileChunkSize-1)/fileChunkSize)
+}
+
+func (m *Messenger) fileTransferProgress(progress *FileTransferProgress) {
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.FileTransferProgress(progress)
+	}
+}
+
+// readFileAttachment reads the file at path and returns its description along with its content.
+// Files bigger than fileChunkSize are not inlined and have to be sent with sendFileChunks.
+func (m *Messenger) readFileAttachment(path string) (*protobuf.FileMessage, []byte, error) {
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(payload) == 0 {
+		return nil, nil, ErrFileAttachmentEmpty
+	}
+
+	if uint64(len(payload)) > m.maxFileAttachmentSize() {
+		return nil, nil, ErrFileAttachmentTooLarge
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(path))
+	if len(mimeType) == 0 {
+		mimeType = http.DetectContentType(payload)
+	}
+
+	hash := sha256.Sum256(payload)
+	file := &protobuf.FileMessage{
+		Name:     filepath.Base(path),
+		MimeType: mimeType,
+		Size:     uint64(len(payload)),
+		Hash:     hash[:],
+	}
+
+	if len(payload) <= fileChunkSize {
+		file.Payload = payload
+	} else {
+		file.ChunksCount = uint32((len(payload) + fileChunkSize - 1) / fileChunkSize)
+		for i := uint32(0); i < file.ChunksCount; i++ {
+			chunkHash := sha256.Sum256(fileChunk(payload, i))
+			file.ChunkHashes = append(file.ChunkHashes, chunkHash[:])
+		}
+	}
+
+	return file, payload, nil
+}
+
+// readVideoAttachment reads the video and the optional poster image of the message.
+// Duration and dimensions are expected to be set by the client in the video payload.
+func (m *Messenger) readVideoAttachment(message *common.Message) (*protobuf.VideoMessage, []byte, error) {
+	file, payload, err := m.readFileAttachment(message.VideoPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !strings.HasPrefix(file.MimeType, "video/") {
+		return nil, nil, ErrVideoAttachmentNotVideo
+	}
+
+	video := message.GetVideo()
+	if video == nil {
+		video = &protobuf.VideoMessage{}
+	}
+	video.File = file
+
+	if len(message.VideoThumbnailPath) != 0 {
+		thumbnail, err := m.OpenAndAdjustImage(userimage.CroppedImage{ImagePath: message.VideoThumbnailPath}, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		video.Thumbnail = thumbnail
+		video.ThumbnailType = images.ImageType(thumbnail)
+	}
+
+	return video, payload, nil
+}
+
+// fileChunk returns the payload of the chunk at index
+func fileChunk(payload []byte, index uint32) []byte {
+	start := int(index) * fileChunkSize
+	end := start + fileChunkSize
+	if end > len(payload) {
+		end = len(payload)
+	}
+	return payload[start:end]
+}
+
+// fileChunkMatches returns whether the chunk is part of the file, according
+// to the chunk hashes announced in the message describing it
+func fileChunkMatches(file *protobuf.FileMessage, index uint32, payload []byte) bool {
+	if index >= file.ChunksCount || int(index) >= len(file.ChunkHashes) {
+		return false
+	}
+	hash := sha256.Sum256(payload)
+	return bytes.Equal(hash[:], file.ChunkHashes[index])
+}
+
+// messageFileAttachment returns the file carried by file and video messages
+func messageFileAttachment(message *common.Message) *protobuf.FileMessage {
+	if file := message.GetFile(); file != nil {
+		return file
+	}
+	if video := message.GetVideo(); video != nil {
+		return video.File
+	}
+	return nil
+}
+
+// storeAndSendFileAttachment stores the file locally so that it can be served by the
+// media server, and sends its chunks if it was too large to be inlined in the message
+func (m *Messenger) storeAndSendFileAttachment(ctx context.Context, chat *Chat, message *common.Message, file *protobuf.FileMessage, payload []byte, skipInstallations []string) error {
+	if file.ChunksCount == 0 {
+		payload = file.Payload
+	}
+
+	err := m.persistence.SaveFileAttachment(file.Hash, payload)
+	if err != nil {
+		return err
+	}
+
+	if file.ChunksCount == 0 {
+		return nil
+	}
+
+	return m.sendFileChunks(ctx, chat, message, file, payload, skipInstallations)
+}
+
+func (m *Messenger) sendFileChunks(ctx context.Context, chat *Chat, message *common.Message, file *protobuf.FileMessage, payload []byte, skipInstallations []string) error {
+	progress := &FileTransferProgress{
+		ChatID:      chat.ID,
+		MessageID:   message.ID,
+		FileHash:    hex.EncodeToString(file.Hash),
+		Outgoing:    true,
+		ChunksCount: file.ChunksCount,
+	}
+
+	for i := uint32(0); i < file.ChunksCount; i++ {
+		chunk := &protobuf.FileChunk{
+			Clock:       message.Clock,
+			ChatId:      message.ChatId,
+			MessageId:   message.ID,
+			FileHash:    file.Hash,
+			Index:       i,
+			ChunksCount: file.ChunksCount,
+			Payload:     fileChunk(payload, i),
+		}
+
+		encodedMessage, err := proto.Marshal(chunk)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:         chat.ID,
+			Payload:             encodedMessage,
+			MessageType:         protobuf.ApplicationMetadataMessage_FILE_CHUNK,
+			ResendAutomatically: true,
+			SkipInstallations:   skipInstallations,
+		})
+		if err != nil {
+			return err
+		}
+
+		progress.ChunksDone = i + 1
+		m.fileTransferProgress(progress)
+	}
+
+	return nil
+}
+
+// handleReceivedFileAttachment stores inlined files and assembles chunked ones
+// in case all the chunks have been received before the message itself
+func (m *Messenger) handleReceivedFileAttachment(chat *Chat, message *common.Message, file *protobuf.FileMessage) error {
+	if file.Size > m.maxFileAttachmentSize() {
+		return ErrFileAttachmentTooLarge
+	}
+
+	if file.ChunksCount == 0 {
+		hash := sha256.Sum256(file.Payload)
+		if !bytes.Equal(hash[:], file.Hash) {
+			return ErrFileAttachmentCorrupted
+		}
+
+		err := m.persistence.SaveFileAttachment(file.Hash, file.Payload)
+		if err != nil {
+			return err
+		}
+
+		// The payload is served from file_attachments, no need to keep it around
+		file.Payload = nil
+		return nil
+	}
+
+	_, err := m.assembleFileAttachment(chat.ID, message.ID, message.From, file)
+	return err
+}
+
+func (m *Messenger) HandleFileChunk(state *ReceivedMessageState, chunk protobuf.FileChunk) error {
+	logger := m.logger.With(zap.String("site", "HandleFileChunk"))
+	if err := ValidateReceivedFileChunk(&chunk, state.Timesource.GetCurrentTime()); err != nil {
+		logger.Error("invalid file chunk", zap.Error(err))
+		return err
+	}
+
+	if len(chunk.Payload) > fileChunkSize {
+		return ErrFileAttachmentChunkLarge
+	}
+
+	exists, err := m.persistence.HasFileAttachment(chunk.FileHash)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	sender := state.CurrentMessageState.Contact.ID
+
+	// The message might have been received in the same batch
+	message := state.Response.GetMessage(chunk.MessageId)
+	if message == nil {
+		message, err = m.persistence.MessageByID(chunk.MessageId)
+		if err != nil && err != common.ErrRecordNotFound {
+			return err
+		}
+	}
+
+	var file *protobuf.FileMessage
+	if message != nil {
+		file = messageFileAttachment(message)
+		if file == nil || message.From != sender || !bytes.Equal(file.Hash, chunk.FileHash) {
+			return ErrFileAttachmentChunkAlien
+		}
+		if !fileChunkMatches(file, chunk.Index, chunk.Payload) {
+			return ErrFileAttachmentCorrupted
+		}
+	}
+
+	pending, err := m.persistence.PendingFileAttachmentChunksCount(sender)
+	if err != nil {
+		return err
+	}
+	if pending >= m.maxPendingFileChunksPerSender() {
+		return ErrFileAttachmentChunksFull
+	}
+
+	err = m.persistence.SaveFileAttachmentChunk(sender, &chunk)
+	if err != nil {
+		return err
+	}
+
+	// Otherwise the file is assembled once the message is received
+	if message == nil {
+		return nil
+	}
+
+	_, err = m.assembleFileAttachment(message.LocalChatID, message.ID, sender, file)
+	return err
+}
+
+// assembleFileAttachment reports the progress of the transfer and, once all the chunks
+// have been received, stores the reassembled file. It returns whether the file is complete.
+// Only chunks sent by the author of the message are taken into account, and their number
+// is the one announced in the message.
+func (m *Messenger) assembleFileAttachment(chatID string, messageID string, sender string, file *protobuf.FileMessage) (bool, error) {
+	chunks, err := m.persistence.FileAttachmentChunks(messageID, sender, file.Hash)
+	if err != nil {
+		return false, err
+	}
+
+	for index, payload := range chunks {
+		if !fileChunkMatches(file, index, payload) {
+			err = m.persistence.DeleteFileAttachmentChunk(messageID, sender, index)
+			if err != nil {
+				return false, err
+			}
+			delete(chunks, index)
+		}
+	}
+
+	m.fileTransferProgress(&FileTransferProgress{
+		ChatID:      chatID,
+		MessageID:   messageID,
+		FileHash:    hex.EncodeToString(file.Hash),
+		ChunksCount: file.ChunksCount,
+		ChunksDone:  uint32(len(chunks)),
+	})
+
+	if uint32(len(chunks)) < file.ChunksCount {
+		return false, nil
+	}
+
+	payloads := make([][]byte, file.ChunksCount)
+	for i := range payloads {
+		payloads[i] = chunks[uint32(i)]
+	}
+
+	payload := bytes.Join(payloads, nil)
+	actualHash := sha256.Sum256(payload)
+	if !bytes.Equal(actualHash[:], file.Hash) {
+		// Every chunk matches the hash announced for it, so it's the description
+		// of the file that is inconsistent and the file can't ever be assembled
+		if err := m.persistence.DeleteFileAttachmentChunks(messageID, sender); err != nil {
+			return false, err
+		}
+		return false, ErrFileAttachmentCorrupted
+	}
+
+	err = m.persistence.SaveFileAttachment(file.Hash, payload)
+	if err != nil {
+		return false, err
+	}
+
+	return true, m.persistence.DeleteFileAttachmentChunks(messageID, sender)
+}
@@ -84,6 +84,13 @@ func (m *Messenger) CreateGroupChatWithMembers(ctx context.Context, name string,
 
 	m.allChats.Store(chat.ID, &chat)
 
+	if m.featureFlags.SenderKeys {
+		_, err = m.Join(&chat)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	_, err = m.dispatchMessage(ctx, common.RawMessage{
 		LocalChatID: chat.ID,
 		Payload:     encodedMessage,
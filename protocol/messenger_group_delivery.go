@@ -0,0 +1,95 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+// Group messages sent with a sender key are acknowledged by each member outside of
+// datasync. Members who haven't acknowledged a message after groupMessageAckTimeout
+// are sent a copy over datasync, which retransmits it with an exponential backoff
+// until they acknowledge it.
+
+const (
+	groupMessageAckTimeout       = time.Minute
+	groupMessageAckCheckInterval = 10 * time.Second
+)
+
+// watchGroupMessageDelivery regularly hands unacknowledged group messages over to datasync
+func (m *Messenger) watchGroupMessageDelivery() {
+	if !m.featureFlags.Datasync || !m.featureFlags.SenderKeys {
+		return
+	}
+
+	go func() {
+		for {
+			select {
+			case <-time.After(groupMessageAckCheckInterval):
+				if m.online() {
+					err := m.resendUnacknowledgedGroupMessages(time.Now().Add(-groupMessageAckTimeout))
+					if err != nil {
+						m.logger.Debug("failed to resend unacknowledged group messages", zap.Error(err))
+					}
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// resendUnacknowledgedGroupMessages sends the group messages sent with a sender key
+// before sentBefore, to each member who hasn't acknowledged them, over datasync
+func (m *Messenger) resendUnacknowledgedGroupMessages(sentBefore time.Time) error {
+	confirmations, err := m.persistence.PendingSenderKeyConfirmations(sentBefore.Unix())
+	if err != nil {
+		return err
+	}
+
+	for _, confirmation := range confirmations {
+		rawMessage, err := m.persistence.RawMessageByID(types.EncodeHex(confirmation.MessageID))
+		if err != nil {
+			return err
+		}
+
+		recipient, err := crypto.DecompressPubkey(confirmation.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		// The confirmation is replaced by the one of the datasync message
+		resentMessage := *rawMessage
+		resentMessage.Sender = nil
+		resentMessage.Recipients = nil
+		resentMessage.ResendAutomatically = true
+		_, err = m.sender.SendPrivate(context.Background(), recipient, &resentMessage)
+		if err != nil {
+			m.logger.Debug("failed to resend group message", zap.String("id", rawMessage.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// updateMessageDeliveryCount refreshes the number of recipients who acknowledged a message
+func (m *Messenger) updateMessageDeliveryCount(message *common.Message) error {
+	id, err := types.DecodeHex(message.ID)
+	if err != nil {
+		return err
+	}
+
+	delivered, recipients, err := m.persistence.MessageDeliveryCount(id)
+	if err != nil {
+		return err
+	}
+
+	message.DeliveredCount = delivered
+	message.RecipientsCount = recipients
+	return m.persistence.UpdateMessageDeliveryCount(message.ID, delivered, recipients)
+}
@@ -0,0 +1,182 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerGroupDeliverySuite(t *testing.T) {
+	suite.Run(t, new(MessengerGroupDeliverySuite))
+}
+
+type MessengerGroupDeliverySuite struct {
+	suite.Suite
+
+	// If one wants to send messages between different instances of Messenger,
+	// a single Waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerGroupDeliverySuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerGroupDeliverySuite) TearDownTest() {
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerGroupDeliverySuite) startNewMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, []Option{WithSenderKeys()})
+	s.Require().NoError(err)
+
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+
+	return messenger
+}
+
+func (s *MessengerGroupDeliverySuite) createGroupChat(admin *Messenger, members ...*Messenger) *Chat {
+	var memberIDs []string
+	for _, member := range members {
+		s.Require().NoError(makeMutualContact(admin, &member.identity.PublicKey))
+		s.Require().NoError(makeMutualContact(member, &admin.identity.PublicKey))
+		memberIDs = append(memberIDs, common.PubkeyToHex(&member.identity.PublicKey))
+	}
+
+	response, err := admin.CreateGroupChatWithMembers(context.Background(), "delivery", memberIDs)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	chat := response.Chats()[0]
+
+	for _, member := range members {
+		_, err = WaitOnMessengerResponse(
+			member,
+			func(r *MessengerResponse) bool { return len(r.Chats()) == 1 && r.Chats()[0].Active },
+			"chat invitation not received",
+		)
+		s.Require().NoError(err)
+	}
+
+	return chat
+}
+
+func (s *MessengerGroupDeliverySuite) send(sender *Messenger, chat *Chat) *common.Message {
+	response, err := sender.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	return response.Messages()[0]
+}
+
+func (s *MessengerGroupDeliverySuite) receive(receiver *Messenger, messageID string) {
+	_, err := WaitOnMessengerResponse(
+		receiver,
+		func(r *MessengerResponse) bool {
+			for _, m := range r.Messages() {
+				if m.ID == messageID {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
+
+// waitForDeliveryCount waits for delivered recipients to acknowledge the message,
+// receivers keep retrieving messages so that datasync can run
+func (s *MessengerGroupDeliverySuite) waitForDeliveryCount(sender *Messenger, messageID string, delivered uint32, receivers ...*Messenger) {
+	err := tt.RetryWithBackOff(func() error {
+		for _, receiver := range receivers {
+			_, err := receiver.RetrieveAll()
+			if err != nil {
+				return err
+			}
+		}
+		_, err := sender.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		message, err := sender.MessageByID(messageID)
+		if err != nil {
+			return err
+		}
+		if message.DeliveredCount != delivered {
+			return errors.New("message not acknowledged")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerGroupDeliverySuite) TestGroupMessageAcknowledged() {
+	admin := s.startNewMessenger()
+	defer admin.Shutdown() // nolint: errcheck
+	alice := s.startNewMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.startNewMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+
+	chat := s.createGroupChat(admin, alice, bob)
+
+	sent := s.send(admin, chat)
+	s.Require().Equal(uint32(0), sent.DeliveredCount)
+	s.Require().Equal(uint32(2), sent.RecipientsCount)
+
+	s.receive(alice, sent.ID)
+	s.receive(bob, sent.ID)
+
+	s.waitForDeliveryCount(admin, sent.ID, 2)
+
+	message, err := admin.MessageByID(sent.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), message.RecipientsCount)
+	s.Require().Equal(common.OutgoingStatusDelivered, message.OutgoingStatus)
+}
+
+func (s *MessengerGroupDeliverySuite) TestUnacknowledgedGroupMessageResent() {
+	admin := s.startNewMessenger()
+	defer admin.Shutdown() // nolint: errcheck
+	alice := s.startNewMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.startNewMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+
+	chat := s.createGroupChat(admin, alice, bob)
+
+	sent := s.send(admin, chat)
+	s.receive(alice, sent.ID)
+	s.waitForDeliveryCount(admin, sent.ID, 1)
+
+	// Bob hasn't acknowledged the message yet, it's sent to him over datasync
+	s.Require().NoError(admin.resendUnacknowledgedGroupMessages(time.Now().Add(time.Second)))
+
+	pending, err := admin.persistence.PendingSenderKeyConfirmations(time.Now().Add(time.Second).Unix())
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+
+	s.receive(bob, sent.ID)
+	s.waitForDeliveryCount(admin, sent.ID, 2, bob)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/images"
@@ -875,6 +876,45 @@ func (m *Messenger) HandlePairInstallation(state *ReceivedMessageState, message
 	return nil
 }
 
+// HandleInstallationRevocation stops encrypting to an installation revoked by its owner
+func (m *Messenger) HandleInstallationRevocation(state *ReceivedMessageState, message protobuf.InstallationRevocation) error {
+	logger := m.logger.With(zap.String("site", "HandleInstallationRevocation"))
+	if err := ValidateReceivedInstallationRevocation(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate message", zap.Error(err))
+		return err
+	}
+
+	// Only the owner of an installation can revoke it, as the message is signed with their identity key
+	publicKey := state.CurrentMessageState.PublicKey
+	fromUs := common.IsPubKeyEqual(publicKey, &m.identity.PublicKey)
+
+	if fromUs && message.InstallationId == m.installationID {
+		logger.Warn("this installation has been revoked by another one")
+		return nil
+	}
+
+	err := m.encryptor.RevokeInstallation(publicKey, message.InstallationId)
+	if err != nil {
+		return err
+	}
+
+	if !fromUs {
+		return nil
+	}
+
+	installation, ok := state.AllInstallations.Load(message.InstallationId)
+	if !ok {
+		return nil
+	}
+
+	installation.Enabled = false
+	installation.Revoked = true
+	state.AllInstallations.Store(message.InstallationId, installation)
+	state.ModifiedInstallations.Store(message.InstallationId, true)
+
+	return nil
+}
+
 // HandleCommunityInvitation handles an community invitation
 func (m *Messenger) HandleCommunityInvitation(state *ReceivedMessageState, signer *ecdsa.PublicKey, invitation protobuf.CommunityInvitation, rawPayload []byte) error {
 	if invitation.PublicKey == nil {
@@ -976,6 +1016,13 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 }
 
 func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetlink string, cancel chan struct{}) {
+	if !m.dataPolicy.Allowed(connection.DataHistoryArchives) {
+		m.communitiesManager.LogStdout("deferring history archives download until on an unmetered connection")
+		if !m.waitForDataPolicy(connection.DataHistoryArchives, cancel) {
+			return
+		}
+	}
+
 	downloadTaskInfo, err := m.communitiesManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
 	if err != nil {
 		logMsg := "failed to download history archive data"
@@ -1567,6 +1614,17 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 			return errors.New("images are not allowed in public chats")
 		case protobuf.ChatMessage_AUDIO:
 			return errors.New("audio messages are not allowed in public chats")
+		case protobuf.ChatMessage_FILE:
+			return errors.New("files are not allowed in public chats")
+		case protobuf.ChatMessage_VIDEO:
+			return errors.New("videos are not allowed in public chats")
+		}
+	}
+
+	if file := messageFileAttachment(receivedMessage); file != nil {
+		err = m.handleReceivedFileAttachment(chat, receivedMessage, file)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -1602,6 +1660,12 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 	} else {
 		// Our own message, mark as sent
 		receivedMessage.OutgoingStatus = common.OutgoingStatusSent
+
+		// Sent from a paired device, the draft is not needed anymore
+		err = m.clearChatDraft(chat.ID, receivedMessage.Clock, state.Response)
+		if err != nil {
+			return err
+		}
 	}
 
 	contact := state.CurrentMessageState.Contact
@@ -2112,6 +2176,8 @@ func (m *Messenger) HandleEmojiReaction(state *ReceivedMessageState, pbEmojiR pr
 		return err
 	}
 
+	normalizeEmojiReaction(&pbEmojiR)
+
 	from := state.CurrentMessageState.Contact.ID
 
 	emojiReaction := &EmojiReaction{
@@ -2135,6 +2201,11 @@ func (m *Messenger) HandleEmojiReaction(state *ReceivedMessageState, pbEmojiR pr
 		return err // matchChatEntity returns a descriptive error message
 	}
 
+	// Retractions are accepted even if the custom emoji has been removed since
+	if len(pbEmojiR.CustomEmojiId) != 0 && !pbEmojiR.Retracted && !m.communityHasCustomEmoji(chat, pbEmojiR.CustomEmojiId) {
+		return communities.ErrCustomEmojiNotFound
+	}
+
 	// Set local chat id
 	emojiReaction.LocalChatID = chat.ID
 
@@ -317,3 +317,78 @@ func (s *MessengerInstallationSuite) TestSyncInstallationNewMessages() {
 	s.Require().NoError(bob2.Shutdown())
 	s.Require().NoError(alice.Shutdown())
 }
+
+func (s *MessengerInstallationSuite) TestRevokeInstallation() {
+	lostDevice, err := newMessengerWithKey(s.shh, s.privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = lostDevice.Start()
+	s.Require().NoError(err)
+	defer lostDevice.Shutdown() // nolint: errcheck
+
+	err = lostDevice.SetInstallationMetadata(lostDevice.installationID, &multidevice.InstallationMetadata{
+		Name:       "lost-name",
+		DeviceType: "lost-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = lostDevice.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Installations) > 0 },
+		"installation not received",
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.m.EnableInstallation(lostDevice.installationID))
+
+	// A contact receives a message from the lost device, and learns about it
+	contact := s.newMessenger(s.shh)
+	_, err = contact.Start()
+	s.Require().NoError(err)
+	defer contact.Shutdown() // nolint: errcheck
+
+	_, err = contact.CreateOneToOneChat(&requests.CreateOneToOneChat{ID: types.Hex2Bytes(types.EncodeHex(crypto.FromECDSAPub(&s.privateKey.PublicKey)))})
+	s.Require().NoError(err)
+
+	chat := CreateOneToOneChat(types.EncodeHex(crypto.FromECDSAPub(&contact.identity.PublicKey)), &contact.identity.PublicKey, lostDevice.transport)
+	s.Require().NoError(lostDevice.SaveChat(chat))
+	_, err = lostDevice.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		contact,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message not received",
+	)
+	s.Require().NoError(err)
+
+	_, err = s.m.RevokeInstallation(context.Background(), s.m.installationID)
+	s.Require().Error(err)
+
+	response, err := s.m.RevokeInstallation(context.Background(), lostDevice.installationID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Installations, 1)
+	s.Require().True(response.Installations[0].Revoked)
+	s.Require().False(response.Installations[0].Enabled)
+
+	s.Require().Error(s.m.EnableInstallation(lostDevice.installationID))
+
+	// The contact stops encrypting to the lost device
+	err = tt.RetryWithBackOff(func() error {
+		_, err := contact.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		installations, err := contact.encryptor.GetInstallations(&s.privateKey.PublicKey)
+		if err != nil {
+			return err
+		}
+		for _, installation := range installations {
+			if installation.ID == lostDevice.installationID && installation.Revoked && !installation.Enabled {
+				return nil
+			}
+		}
+		return errors.New("installation not revoked")
+	})
+	s.Require().NoError(err)
+}
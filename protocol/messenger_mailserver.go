@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
@@ -557,43 +558,48 @@ func (m *Messenger) processMailserverBatch(batch MailserverBatch) error {
 	}
 	logger := m.logger.With(zap.Any("chatIDs", batch.ChatIDs), zap.String("fromString", time.Unix(int64(batch.From), 0).Format(time.RFC3339)), zap.String("toString", time.Unix(int64(batch.To), 0).Format(time.RFC3339)), zap.Any("topic", topicStrings), zap.Int64("from", int64(batch.From)), zap.Int64("to", int64(batch.To)))
 	logger.Info("syncing topic")
-	ctx, cancel := context.WithTimeout(context.Background(), mailserverRequestTimeout)
-	defer cancel()
 
 	mailserverID, err := m.activeMailserverID()
 	if err != nil {
 		return err
 	}
 
-	for i := 0; i < len(batch.Topics); i += maxTopicsPerRequest {
-		j := i + maxTopicsPerRequest
-		if j > len(batch.Topics) {
-			j = len(batch.Topics)
-		}
-
-		topicsForIteration := batch.Topics[i:j]
-
-		cursor, storeCursor, err := m.transport.SendMessagesRequestForTopics(ctx, mailserverID, batch.From, batch.To, nil, nil, topicsForIteration, true)
-		if err != nil {
-			logger.Error("failed to send request", zap.Error(err))
-			return err
-		}
+	// Store nodes are queried one pubsub topic at a time
+	pubsubTopics, topicsByPubsubTopic := m.topicsByPubsubTopic(batch.Topics)
+	for _, pubsubTopic := range pubsubTopics {
+		topics := topicsByPubsubTopic[pubsubTopic]
+		for i := 0; i < len(topics); i += maxTopicsPerRequest {
+			j := i + maxTopicsPerRequest
+			if j > len(topics) {
+				j = len(topics)
+			}
 
-		for len(cursor) != 0 || storeCursor != nil {
-			logger.Info("retrieved cursor", zap.String("cursor", types.EncodeHex(cursor)))
-			err = func() error {
-				ctx, cancel := context.WithTimeout(context.Background(), mailserverRequestTimeout)
-				defer cancel()
+			topicsForIteration := topics[i:j]
 
-				cursor, storeCursor, err = m.transport.SendMessagesRequestForTopics(ctx, mailserverID, batch.From, batch.To, cursor, storeCursor, topicsForIteration, true)
+			// Resume the query interrupted by a restart, if any
+			storeCursor, err := m.transport.StoreCursor(topicsForIteration)
+			if err != nil {
+				return err
+			}
+			if storeCursor != nil && bytes.Equal(storeCursor.StoreNode, mailserverID) && storeCursor.PubsubTopic == pubsubTopic && storeCursor.From >= batch.From && storeCursor.To <= batch.To {
+				logger.Info("resuming store query", zap.Uint32("cursorFrom", storeCursor.From), zap.Uint32("cursorTo", storeCursor.To))
+				err = m.syncStoreRange(logger, mailserverID, pubsubTopic, topicsForIteration, storeCursor.From, storeCursor.To, storeCursor.Cursor)
 				if err != nil {
 					return err
 				}
-				return nil
-			}()
+			}
+
+			// Only what hasn't been retrieved yet from this store node is requested
+			ranges, err := m.transport.UnsyncedRanges(mailserverID, topicsForIteration, batch.From, batch.To, tolerance)
 			if err != nil {
 				return err
 			}
+			for _, r := range ranges {
+				err = m.syncStoreRange(logger, mailserverID, pubsubTopic, topicsForIteration, r.From, r.To, nil)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -605,6 +611,100 @@ func (m *Messenger) processMailserverBatch(batch MailserverBatch) error {
 	return nil
 }
 
+// syncStoreRange retrieves the messages of topics between from and to, starting
+// at storeCursor if not nil. The position is saved after each page so that the
+// query can be resumed after a restart, and the range covered so far is marked
+// as synced
+func (m *Messenger) syncStoreRange(logger *zap.Logger, mailserverID []byte, pubsubTopic string, topics []types.TopicType, from, to uint32, storeCursor *types.StoreRequestCursor) error {
+	activeMailserver := m.getActiveMailserver()
+
+	var cursor []byte
+	pages := 0
+	for {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), mailserverRequestTimeout)
+			defer cancel()
+
+			var err error
+			cursor, storeCursor, err = m.transport.SendMessagesRequestForTopics(ctx, mailserverID, from, to, cursor, storeCursor, pubsubTopic, topics, true)
+			return err
+		}()
+		if err != nil {
+			if pages == 0 {
+				logger.Error("failed to send request", zap.Error(err))
+				m.recordMailserverRequest(activeMailserver, true, false)
+			} else {
+				// Part of the history was returned
+				m.recordMailserverRequest(activeMailserver, false, true)
+			}
+			return err
+		}
+		pages++
+
+		if len(cursor) == 0 && storeCursor == nil {
+			break
+		}
+		logger.Info("retrieved cursor", zap.String("cursor", types.EncodeHex(cursor)))
+
+		if storeCursor != nil {
+			err = m.saveStoreProgress(mailserverID, pubsubTopic, topics, from, to, storeCursor)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	m.recordMailserverRequest(activeMailserver, false, false)
+
+	err := m.transport.AddSyncedRange(mailserverID, topics, transport.SyncedRange{From: from, To: to})
+	if err != nil {
+		return err
+	}
+	return m.transport.DeleteStoreCursor(topics)
+}
+
+// saveStoreProgress saves the position of a store query. Pages are returned
+// newest first, so everything sent after the cursor has been retrieved
+func (m *Messenger) saveStoreProgress(mailserverID []byte, pubsubTopic string, topics []types.TopicType, from, to uint32, storeCursor *types.StoreRequestCursor) error {
+	err := m.transport.SaveStoreCursor(topics, &transport.StoreCursor{
+		StoreNode:   mailserverID,
+		PubsubTopic: pubsubTopic,
+		From:        from,
+		To:          to,
+		Cursor:      storeCursor,
+	})
+	if err != nil {
+		return err
+	}
+
+	secs := storeCursor.SenderTime / int64(time.Second)
+	if storeCursor.SenderTime%int64(time.Second) != 0 {
+		secs++
+	}
+	if secs <= int64(from) || secs >= int64(to) {
+		return nil
+	}
+	return m.transport.AddSyncedRange(mailserverID, topics, transport.SyncedRange{From: uint32(secs), To: to})
+}
+
+// topicsByPubsubTopic groups topics by the pubsub topic of their filter, the default
+// one if empty
+func (m *Messenger) topicsByPubsubTopic(topics []types.TopicType) ([]string, map[string][]types.TopicType) {
+	var pubsubTopics []string
+	topicsByPubsubTopic := make(map[string][]types.TopicType)
+	for _, topic := range topics {
+		var pubsubTopic string
+		if filter := m.transport.FilterByTopic(topic[:]); filter != nil {
+			pubsubTopic = filter.PubsubTopic
+		}
+		if _, ok := topicsByPubsubTopic[pubsubTopic]; !ok {
+			pubsubTopics = append(pubsubTopics, pubsubTopic)
+		}
+		topicsByPubsubTopic[pubsubTopic] = append(topicsByPubsubTopic[pubsubTopic], topic)
+	}
+	return pubsubTopics, topicsByPubsubTopic
+}
+
 type MailserverBatch struct {
 	From    uint32
 	To      uint32
@@ -641,6 +741,27 @@ func (m *Messenger) RequestHistoricMessagesForFilter(
 	return m.transport.SendMessagesRequestForFilter(ctx, activeMailserverID, from, to, cursor, previousStoreCursor, filter, waitForResponse)
 }
 
+// SyncedRanges returns the periods of chatID whose messages are confirmed to
+// have been all retrieved from the active store node, oldest first
+func (m *Messenger) SyncedRanges(chatID string) ([]transport.SyncedRange, error) {
+	mailserverID, err := m.activeMailserverID()
+	if err != nil {
+		return nil, err
+	}
+	if mailserverID == nil {
+		return nil, nil
+	}
+
+	topics, err := m.topicsForChat(chatID)
+	if err != nil {
+		return nil, err
+	}
+	if len(topics) == 0 {
+		return nil, nil
+	}
+	return m.transport.SyncedRanges(mailserverID, topics)
+}
+
 func (m *Messenger) SyncChatFromSyncedFrom(chatID string) (uint32, error) {
 	var from uint32
 	_, err := m.performMailserverRequest(func() (*MessengerResponse, error) {
@@ -809,5 +930,13 @@ func (m *Messenger) ConnectionChanged(state connection.State) {
 		m.sender.StartDatasync()
 	}
 
+	wasOffline := m.connectionState.Offline
 	m.connectionState = state
+
+	m.dataPolicyChanged(m.dataPolicy.ConnectionChanged(state))
+
+	// Resend the messages that couldn't be sent while offline
+	if wasOffline && !state.Offline {
+		m.triggerOutbox()
+	}
 }
@@ -2,10 +2,6 @@ package protocol
 
 import (
 	"context"
-	"crypto/rand"
-	"math"
-	"math/big"
-	"sort"
 	"strings"
 	"time"
 
@@ -32,25 +28,6 @@ func (m *Messenger) mailserversByFleet(fleet string) []mailservers.Mailserver {
 	return items
 }
 
-type byRTTMsAndCanConnectBefore []SortedMailserver
-
-func (s byRTTMsAndCanConnectBefore) Len() int {
-	return len(s)
-}
-
-func (s byRTTMsAndCanConnectBefore) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
-}
-
-func (s byRTTMsAndCanConnectBefore) Less(i, j int) bool {
-	// Slightly inaccurate as time sensitive sorting, but it does not matter so much
-	now := time.Now()
-	if s[i].CanConnectAfter.Before(now) && s[j].CanConnectAfter.Before(now) {
-		return s[i].RTTMs < s[j].RTTMs
-	}
-	return s[i].CanConnectAfter.Before(s[j].CanConnectAfter)
-}
-
 func (m *Messenger) activeMailserverID() ([]byte, error) {
 	if m.mailserverCycle.activeMailserver == nil {
 		return nil, nil
@@ -147,10 +124,6 @@ func (m *Messenger) cycleMailservers() {
 	}
 }
 
-func poolSize(fleetSize int) int {
-	return int(math.Ceil(float64(fleetSize) / 4))
-}
-
 func (m *Messenger) getFleet() (string, error) {
 	var fleet string
 	dbFleet, err := m.settings.GetFleet()
@@ -195,6 +168,7 @@ type SortedMailserver struct {
 	Address         string
 	RTTMs           int
 	CanConnectAfter time.Time
+	Score           float64
 }
 
 func (m *Messenger) findNewMailserver() error {
@@ -251,6 +225,13 @@ func (m *Messenger) findNewMailserver() error {
 		return err
 	}
 
+	mailserversByAddress := make(map[string]mailservers.Mailserver)
+	for idx := range allMailservers {
+		mailserversByAddress[allMailservers[idx].Address] = allMailservers[idx]
+	}
+
+	m.recordMailserverPings(mailserversByAddress, pingResult)
+
 	var availableMailservers []*mailservers.PingResult
 	for _, result := range pingResult {
 		if result.Err != nil {
@@ -265,10 +246,11 @@ func (m *Messenger) findNewMailserver() error {
 		return nil
 	}
 
-	mailserversByAddress := make(map[string]mailservers.Mailserver)
-	for idx := range allMailservers {
-		mailserversByAddress[allMailservers[idx].Address] = allMailservers[idx]
+	scores, err := m.mailserverScores(fleet)
+	if err != nil {
+		return err
 	}
+
 	var sortedMailservers []SortedMailserver
 	for _, ping := range availableMailservers {
 		address := ping.Address
@@ -276,6 +258,7 @@ func (m *Messenger) findNewMailserver() error {
 		sortedMailserver := SortedMailserver{
 			Address: address,
 			RTTMs:   *ping.RTTMs,
+			Score:   scores[ms.ID],
 		}
 		m.mailPeersMutex.Lock()
 		pInfo, ok := m.mailserverCycle.peers[ms.ID]
@@ -287,23 +270,15 @@ func (m *Messenger) findNewMailserver() error {
 		sortedMailservers = append(sortedMailservers, sortedMailserver)
 
 	}
-	sort.Sort(byRTTMsAndCanConnectBefore(sortedMailservers))
-
-	// Picks a random mailserver amongs the ones with the lowest latency
-	// The pool size is 1/4 of the mailservers were pinged successfully
-	pSize := poolSize(len(sortedMailservers) - 1)
-	if pSize <= 0 {
-		pSize = len(sortedMailservers)
-	}
-
-	r, err := rand.Int(rand.Reader, big.NewInt(int64(pSize)))
+	// Picks a random mailserver amongst the ones with the lowest latency, favoring the
+	// ones with the best history
+	msPing, err := pickMailserver(sortedMailservers)
 	if err != nil {
 		return err
 	}
 
-	msPing := sortedMailservers[r.Int64()]
 	ms := mailserversByAddress[msPing.Address]
-	m.logger.Info("connecting to mailserver", zap.String("address", ms.Address))
+	m.logger.Info("connecting to mailserver", zap.String("address", ms.Address), zap.Float64("score", msPing.Score))
 	return m.connectToMailserver(ms)
 }
 
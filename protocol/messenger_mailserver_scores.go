@@ -0,0 +1,125 @@
+package protocol
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+// recordMailserverPings updates the scores of the mailservers with the results of pinging them
+func (m *Messenger) recordMailserverPings(mailserversByAddress map[string]mailservers.Mailserver, pingResults []*mailservers.PingResult) {
+	for _, result := range pingResults {
+		ms, ok := mailserversByAddress[result.Address]
+		if !ok {
+			continue
+		}
+
+		rttMs := 0
+		if result.RTTMs != nil {
+			rttMs = *result.RTTMs
+		}
+
+		err := m.mailservers.RecordPing(ms.ID, ms.Fleet, rttMs, result.Err != nil)
+		if err != nil {
+			m.logger.Warn("failed to record mailserver ping", zap.String("id", ms.ID), zap.Error(err))
+		}
+	}
+}
+
+// recordMailserverRequest updates the score of a mailserver with the outcome of a
+// history request, incomplete if it failed after some of the history was returned
+func (m *Messenger) recordMailserverRequest(ms *mailservers.Mailserver, failed bool, incomplete bool) {
+	if ms == nil {
+		return
+	}
+
+	err := m.mailservers.RecordRequest(ms.ID, ms.Fleet, failed, incomplete)
+	if err != nil {
+		m.logger.Warn("failed to record mailserver request", zap.String("id", ms.ID), zap.Error(err))
+	}
+}
+
+// mailserverScores returns the scores of the mailservers of fleet by id
+func (m *Messenger) mailserverScores(fleet string) (map[string]float64, error) {
+	scores, err := m.mailservers.MailserverScores(fleet)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]float64)
+	for _, s := range scores {
+		result[s.ID] = s.Score
+	}
+	return result, nil
+}
+
+type byRTTMsAndCanConnectBefore []SortedMailserver
+
+func (s byRTTMsAndCanConnectBefore) Len() int {
+	return len(s)
+}
+
+func (s byRTTMsAndCanConnectBefore) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byRTTMsAndCanConnectBefore) Less(i, j int) bool {
+	// Slightly inaccurate as time sensitive sorting, but it does not matter so much
+	now := time.Now()
+	if s[i].CanConnectAfter.Before(now) && s[j].CanConnectAfter.Before(now) {
+		return s[i].RTTMs < s[j].RTTMs
+	}
+	return s[i].CanConnectAfter.Before(s[j].CanConnectAfter)
+}
+
+func poolSize(fleetSize int) int {
+	return int(math.Ceil(float64(fleetSize) / 4))
+}
+
+// pickMailserver picks one of the candidates with the lowest latency at random, weighted
+// by their score. The pool size is 1/4 of the candidates, and mailservers that can't be
+// connected to yet are only picked if there is no other one.
+func pickMailserver(candidates []SortedMailserver) (SortedMailserver, error) {
+	sorted := make([]SortedMailserver, len(candidates))
+	copy(sorted, candidates)
+	sort.Sort(byRTTMsAndCanConnectBefore(sorted))
+
+	pSize := poolSize(len(sorted) - 1)
+	if pSize <= 0 {
+		pSize = len(sorted)
+	}
+	pool := sorted[:pSize]
+
+	total := 0.0
+	for _, c := range pool {
+		total += c.Score
+	}
+
+	// 2^53 keeps the random number exactly representable as a float64
+	precision := int64(1) << 53
+	r, err := rand.Int(rand.Reader, big.NewInt(precision))
+	if err != nil {
+		return SortedMailserver{}, err
+	}
+
+	// Without any score, e.g. on a fresh install, the pool is picked from uniformly
+	if total == 0 {
+		return pool[r.Int64()%int64(len(pool))], nil
+	}
+
+	target := float64(r.Int64()) / float64(precision) * total
+	for _, c := range pool {
+		target -= c.Score
+		if target < 0 {
+			return c, nil
+		}
+	}
+
+	return pool[len(pool)-1], nil
+}
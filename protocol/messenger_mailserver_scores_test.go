@@ -0,0 +1,56 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickMailserver(t *testing.T) {
+	candidates := []SortedMailserver{
+		{Address: "broken", RTTMs: 10, Score: 0},
+		{Address: "good", RTTMs: 20, Score: 0.9},
+		{Address: "backing-off", RTTMs: 1, Score: 1, CanConnectAfter: time.Now().Add(time.Hour)},
+	}
+	// The pool holds the 2 fastest mailservers out of 9
+	for i := 0; i < 6; i++ {
+		candidates = append(candidates, SortedMailserver{Address: "slow", RTTMs: 500 + i, Score: 1})
+	}
+
+	for i := 0; i < 20; i++ {
+		picked, err := pickMailserver(candidates)
+		require.NoError(t, err)
+		require.Equal(t, "good", picked.Address)
+	}
+
+	// Mailservers backing off are picked when there is nothing else
+	picked, err := pickMailserver(candidates[2:3])
+	require.NoError(t, err)
+	require.Equal(t, "backing-off", picked.Address)
+}
+
+func TestPickMailserverNeverPicksSlowOnes(t *testing.T) {
+	candidates := []SortedMailserver{
+		{Address: "slow", RTTMs: 900, Score: 1},
+	}
+	for i := 0; i < 8; i++ {
+		candidates = append(candidates, SortedMailserver{Address: "fast", RTTMs: 10 + i, Score: 0.1})
+	}
+
+	for i := 0; i < 100; i++ {
+		picked, err := pickMailserver(candidates)
+		require.NoError(t, err)
+		require.Equal(t, "fast", picked.Address)
+	}
+
+	// Without scores, the fastest mailservers are still picked
+	for i := range candidates {
+		candidates[i].Score = 0
+	}
+	for i := 0; i < 100; i++ {
+		picked, err := pickMailserver(candidates)
+		require.NoError(t, err)
+		require.Equal(t, "fast", picked.Address)
+	}
+}
@@ -133,7 +133,9 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		message.ContentType != protobuf.ChatMessage_STICKER &&
 		message.ContentType != protobuf.ChatMessage_EMOJI &&
 		message.ContentType != protobuf.ChatMessage_IMAGE &&
-		message.ContentType != protobuf.ChatMessage_AUDIO {
+		message.ContentType != protobuf.ChatMessage_AUDIO &&
+		message.ContentType != protobuf.ChatMessage_FILE &&
+		message.ContentType != protobuf.ChatMessage_VIDEO {
 		return nil, ErrInvalidDeleteTypeAuthor
 	}
 
@@ -200,7 +202,9 @@ func (m *Messenger) DeleteMessageForMeAndSync(ctx context.Context, chatID string
 		message.ContentType != protobuf.ChatMessage_STICKER &&
 		message.ContentType != protobuf.ChatMessage_EMOJI &&
 		message.ContentType != protobuf.ChatMessage_IMAGE &&
-		message.ContentType != protobuf.ChatMessage_AUDIO {
+		message.ContentType != protobuf.ChatMessage_AUDIO &&
+		message.ContentType != protobuf.ChatMessage_FILE &&
+		message.ContentType != protobuf.ChatMessage_VIDEO {
 		return nil, ErrInvalidDeleteTypeAuthor
 	}
 
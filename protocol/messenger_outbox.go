@@ -0,0 +1,112 @@
+package protocol
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// Messages that haven't been sent yet are kept in the outbox, the raw messages table,
+// and resent with an exponential backoff, including after a restart, until they are
+// sent or their deadline passes. Only then they are reported as expired.
+
+const (
+	// defaultOutboxDeadline is used when no deadline has been configured
+	defaultOutboxDeadline = 24 * time.Hour
+	// outboxMaxMessagesPerChat is the number of messages of a chat kept in the outbox,
+	// older ones are given up on
+	outboxMaxMessagesPerChat = 50
+)
+
+var (
+	ErrOutboxDeadlineExceeded = errors.New("message not sent before the outbox deadline")
+	ErrOutboxFull             = errors.New("too many messages waiting to be sent in the chat")
+)
+
+func (m *Messenger) outboxDeadline() time.Duration {
+	if m.config.outboxDeadline != 0 {
+		return m.config.outboxDeadline
+	}
+	return defaultOutboxDeadline
+}
+
+// inOutbox returns whether message is resent by the outbox. Messages sent pairwise
+// are resent by datasync when it's enabled, unless they target some installations only.
+func (m *Messenger) inOutbox(message *common.RawMessage) bool {
+	if message.Sent || message.Failed {
+		return false
+	}
+
+	if message.MessageType != protobuf.ApplicationMetadataMessage_CHAT_MESSAGE &&
+		message.MessageType != protobuf.ApplicationMetadataMessage_EMOJI_REACTION {
+		return false
+	}
+
+	chat, ok := m.allChats.Load(message.LocalChatID)
+	if !ok {
+		return false
+	}
+
+	targeted := len(message.Installations) != 0 || len(message.SkipInstallations) != 0
+	return chat.Public() || chat.CommunityChat() || (chat.OneToOne() && (!m.featureFlags.Datasync || targeted))
+}
+
+// triggerOutbox resends the messages in the outbox without waiting for the next check
+func (m *Messenger) triggerOutbox() {
+	select {
+	case m.outboxTrigger <- struct{}{}:
+	default:
+	}
+}
+
+func outboxDeadlineExceeded(message *common.RawMessage, deadline time.Duration, t common.TimeSource) bool {
+	return t.GetCurrentTime() > message.FirstSent+uint64(deadline.Milliseconds())
+}
+
+// failOutboxMessage gives up on sending message and reports it as expired
+func (m *Messenger) failOutboxMessage(message *common.RawMessage, reason error) error {
+	m.logger.Debug("giving up on sending message", zap.String("id", message.ID), zap.Error(reason))
+
+	message.Failed = true
+	err := m.persistence.SaveRawMessage(message)
+	if err != nil {
+		return err
+	}
+
+	if m.config.envelopesMonitorConfig != nil {
+		id, err := types.DecodeHex(message.ID)
+		if err != nil {
+			return err
+		}
+		m.config.envelopesMonitorConfig.EnvelopeEventsHandler.EnvelopeExpired([][]byte{id}, reason)
+	}
+
+	return nil
+}
+
+// processExpiredMessages resends the messages in the outbox whose envelopes expired,
+// and returns the identifiers of the other ones
+func (m *Messenger) processExpiredMessages(identifiers [][]byte) [][]byte {
+	var expired [][]byte
+	resend := false
+
+	for _, identifier := range identifiers {
+		rawMessage, err := m.persistence.RawMessageByID(types.EncodeHex(identifier))
+		if err == nil && m.inOutbox(rawMessage) {
+			resend = true
+			continue
+		}
+		expired = append(expired, identifier)
+	}
+
+	if resend {
+		m.triggerOutbox()
+	}
+
+	return expired
+}
@@ -0,0 +1,177 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerOutboxSuite(t *testing.T) {
+	suite.Run(t, new(MessengerOutboxSuite))
+}
+
+type expiredEnvelopesHandler struct {
+	transport.EnvelopeEventsHandler
+
+	mutex   sync.Mutex
+	expired map[string]error
+}
+
+func (h *expiredEnvelopesHandler) EnvelopeExpired(identifiers [][]byte, err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for _, identifier := range identifiers {
+		h.expired[types.EncodeHex(identifier)] = err
+	}
+}
+
+func (h *expiredEnvelopesHandler) expiredError(id string) (error, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	err, ok := h.expired[id]
+	return err, ok
+}
+
+type MessengerOutboxSuite struct {
+	suite.Suite
+	m       *Messenger
+	handler *expiredEnvelopesHandler
+	chat    *Chat
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerOutboxSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	s.m, err = newMessengerWithKey(s.shh, privateKey, s.logger, []Option{WithOutboxDeadline(time.Hour)})
+	s.Require().NoError(err)
+
+	s.handler = &expiredEnvelopesHandler{expired: make(map[string]error)}
+	s.m.config.envelopesMonitorConfig = &transport.EnvelopesMonitorConfig{EnvelopeEventsHandler: s.handler}
+
+	s.chat = CreatePublicChat("test-outbox", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(s.chat))
+}
+
+func (s *MessengerOutboxSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerOutboxSuite) send() *common.RawMessage {
+	message := buildTestMessage(*s.chat)
+	_, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	rawMessage, err := s.m.persistence.RawMessageByID(message.ID)
+	s.Require().NoError(err)
+	return rawMessage
+}
+
+func (s *MessengerOutboxSuite) TestExpiredEnvelopeResent() {
+	rawMessage := s.send()
+	s.Require().NotZero(rawMessage.FirstSent)
+
+	id, err := types.DecodeHex(rawMessage.ID)
+	s.Require().NoError(err)
+	otherID := []byte("not-in-the-outbox")
+
+	interceptor := EnvelopeEventsInterceptor{s.handler, s.m}
+	interceptor.EnvelopeExpired([][]byte{id, otherID}, nil)
+
+	// Only the envelope of the message out of the outbox is reported
+	_, ok := s.handler.expiredError(rawMessage.ID)
+	s.Require().False(ok)
+	_, ok = s.handler.expiredError(types.EncodeHex(otherID))
+	s.Require().True(ok)
+
+	// The backoff has elapsed
+	rawMessage.LastSent = s.m.getTimesource().GetCurrentTime() - 60*uint64(time.Second.Milliseconds())
+	s.Require().NoError(s.m.persistence.SaveRawMessage(rawMessage))
+
+	s.Require().NoError(s.m.resendExpiredMessages())
+
+	resent, err := s.m.persistence.RawMessageByID(rawMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(2, resent.SendCount)
+	s.Require().Equal(rawMessage.FirstSent, resent.FirstSent)
+	s.Require().False(resent.Failed)
+}
+
+func (s *MessengerOutboxSuite) TestDeadlineExceeded() {
+	rawMessage := s.send()
+	rawMessage.FirstSent = s.m.getTimesource().GetCurrentTime() - 2*uint64(time.Hour.Milliseconds())
+	s.Require().NoError(s.m.persistence.SaveRawMessage(rawMessage))
+
+	s.Require().NoError(s.m.resendExpiredMessages())
+
+	failed, err := s.m.persistence.RawMessageByID(rawMessage.ID)
+	s.Require().NoError(err)
+	s.Require().True(failed.Failed)
+	s.Require().Equal(1, failed.SendCount)
+
+	err, ok := s.handler.expiredError(rawMessage.ID)
+	s.Require().True(ok)
+	s.Require().Equal(ErrOutboxDeadlineExceeded, err)
+
+	// Resending it manually gives it a new deadline
+	s.Require().NoError(s.m.ReSendChatMessage(context.Background(), rawMessage.ID))
+
+	resent, err := s.m.persistence.RawMessageByID(rawMessage.ID)
+	s.Require().NoError(err)
+	s.Require().False(resent.Failed)
+	s.Require().False(outboxDeadlineExceeded(resent, s.m.outboxDeadline(), s.m.getTimesource()))
+}
+
+func (s *MessengerOutboxSuite) TestBoundedPerChat() {
+	now := s.m.getTimesource().GetCurrentTime()
+	for i := 0; i <= outboxMaxMessagesPerChat; i++ {
+		s.Require().NoError(s.m.persistence.SaveRawMessage(&common.RawMessage{
+			ID:          types.EncodeHex([]byte(fmt.Sprintf("outbox-%d", i))),
+			LocalChatID: s.chat.ID,
+			MessageType: protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+			SendCount:   1,
+			LastSent:    now,
+			FirstSent:   now - uint64(outboxMaxMessagesPerChat-i),
+		}))
+	}
+
+	s.Require().NoError(s.m.resendExpiredMessages())
+
+	// Only the oldest message is given up on
+	oldest := types.EncodeHex([]byte("outbox-0"))
+	err, ok := s.handler.expiredError(oldest)
+	s.Require().True(ok)
+	s.Require().Equal(ErrOutboxFull, err)
+
+	ids, err := s.m.persistence.ExpiredMessagesIDs()
+	s.Require().NoError(err)
+	s.Require().Len(ids, outboxMaxMessagesPerChat)
+	s.Require().NotContains(ids, oldest)
+}
@@ -0,0 +1,81 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerPaddingSuite(t *testing.T) {
+	suite.Run(t, new(MessengerPaddingSuite))
+}
+
+type MessengerPaddingSuite struct {
+	suite.Suite
+	m   *Messenger
+	bob *Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerPaddingSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.m = s.newMessenger(WithMessagePadding(common.DefaultPaddingBuckets))
+	// Bob doesn't pad his messages, but still reads padded ones
+	s.bob = s.newMessenger()
+	_, err := s.m.Start()
+	s.Require().NoError(err)
+	_, err = s.bob.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerPaddingSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	s.Require().NoError(s.bob.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerPaddingSuite) newMessenger(options ...Option) *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, options)
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerPaddingSuite) TestOneToOnePadding() {
+	theirChat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(theirChat))
+
+	message := buildTestMessage(*theirChat)
+	message.Text = "padded"
+	_, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("padded", response.Messages()[0].Text)
+}
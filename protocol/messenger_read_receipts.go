@@ -0,0 +1,135 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxReadReceiptsBatchSize is the maximum number of message ids acknowledged in a single message
+const maxReadReceiptsBatchSize = 100
+
+func (m *Messenger) ReadReceipts(messageID string) ([]*ReadReceipt, error) {
+	return m.persistence.ReadReceipts(messageID)
+}
+
+// sendReadReceipts lets the other members of a one-to-one or group chat know that
+// the given messages have been read. Receipts are opt in, and nothing is sent unless
+// the user enabled them.
+func (m *Messenger) sendReadReceipts(ctx context.Context, chat *Chat, ids []string) error {
+	if chat == nil || len(ids) == 0 {
+		return nil
+	}
+
+	var messageType protobuf.MessageType
+	switch {
+	case chat.OneToOne():
+		messageType = protobuf.MessageType_ONE_TO_ONE
+	case chat.PrivateGroupChat():
+		messageType = protobuf.MessageType_PRIVATE_GROUP
+	default:
+		return nil
+	}
+
+	enabled, err := m.settings.ShouldSendReadReceipts()
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+
+	for start := 0; start < len(ids); start += maxReadReceiptsBatchSize {
+		end := start + maxReadReceiptsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+		receipts := &protobuf.ReadReceipts{
+			Clock:       clock,
+			ChatId:      chat.ID,
+			MessageType: messageType,
+			MessageIds:  ids[start:end],
+		}
+
+		encodedMessage, err := proto.Marshal(receipts)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:         chat.ID,
+			Payload:             encodedMessage,
+			MessageType:         protobuf.ApplicationMetadataMessage_READ_RECEIPTS,
+			ResendAutomatically: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) HandleReadReceipts(state *ReceivedMessageState, pb protobuf.ReadReceipts) error {
+	logger := m.logger.With(zap.String("site", "HandleReadReceipts"))
+	if err := ValidateReceivedReadReceipts(&pb, state.Timesource.GetCurrentTime()); err != nil {
+		logger.Error("invalid read receipts", zap.Error(err))
+		return err
+	}
+
+	reader := state.CurrentMessageState.Contact.ID
+	ourID := common.PubkeyToHex(&m.identity.PublicKey)
+
+	// Receipts sent by our own devices are only relevant to the other members
+	if reader == ourID {
+		return nil
+	}
+
+	messages, err := m.persistence.MessagesByIDs(pb.MessageIds)
+	if err != nil {
+		return err
+	}
+
+	var receipts []*ReadReceipt
+	for _, message := range messages {
+		if message.From != ourID {
+			continue
+		}
+
+		chat, ok := state.AllChats.Load(message.LocalChatID)
+		if !ok {
+			continue
+		}
+
+		// Only members of the chat the message was sent to can acknowledge it
+		if !(chat.OneToOne() && chat.ID == reader) && !(chat.PrivateGroupChat() && chat.HasMember(reader)) {
+			logger.Warn("read receipt from a non member", zap.String("messageID", message.ID))
+			continue
+		}
+
+		receipts = append(receipts, &ReadReceipt{
+			MessageID: message.ID,
+			ChatID:    chat.ID,
+			Reader:    reader,
+			Clock:     pb.Clock,
+		})
+	}
+
+	if len(receipts) == 0 {
+		return nil
+	}
+
+	saved, err := m.persistence.SaveReadReceipts(receipts)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddReadReceipts(saved)
+	return nil
+}
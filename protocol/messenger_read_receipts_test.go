@@ -0,0 +1,131 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerReadReceiptsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerReadReceiptsSuite))
+}
+
+type MessengerReadReceiptsSuite struct {
+	suite.Suite
+	m   *Messenger
+	bob *Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerReadReceiptsSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.m = s.newMessenger()
+	s.bob = s.newMessenger()
+	_, err := s.m.Start()
+	s.Require().NoError(err)
+	_, err = s.bob.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerReadReceiptsSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	s.Require().NoError(s.bob.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerReadReceiptsSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	return messenger
+}
+
+// sendToBob sends a message to bob and waits for him to receive it
+func (s *MessengerReadReceiptsSuite) sendToBob() *common.Message {
+	chat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	response, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	sent := response.Messages()[0]
+
+	_, err = WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message not received",
+	)
+	s.Require().NoError(err)
+	return sent
+}
+
+func (s *MessengerReadReceiptsSuite) TestReadReceiptsSent() {
+	s.Require().NoError(s.bob.settings.SaveSettingField(settings.SendReadReceipts, true))
+
+	sent := s.sendToBob()
+	chatID := common.PubkeyToHex(&s.m.identity.PublicKey)
+
+	count, _, err := s.bob.MarkMessagesSeen(chatID, []string{sent.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), count)
+
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.ReadReceipts) > 0 },
+		"read receipts not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.ReadReceipts, 1)
+
+	receipt := response.ReadReceipts[0]
+	s.Require().Equal(sent.ID, receipt.MessageID)
+	s.Require().Equal(common.PubkeyToHex(&s.bob.identity.PublicKey), receipt.Reader)
+	s.Require().Equal(sent.LocalChatID, receipt.ChatID)
+
+	receipts, err := s.m.ReadReceipts(sent.ID)
+	s.Require().NoError(err)
+	s.Require().Len(receipts, 1)
+	s.Require().Equal(receipt.Reader, receipts[0].Reader)
+}
+
+func (s *MessengerReadReceiptsSuite) TestReadReceiptsDisabled() {
+	sent := s.sendToBob()
+	chatID := common.PubkeyToHex(&s.m.identity.PublicKey)
+
+	err := s.bob.MarkAllRead(chatID)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.ReadReceipts) > 0 },
+		"read receipts not received",
+	)
+	s.Require().Error(err)
+
+	receipts, err := s.m.ReadReceipts(sent.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(receipts)
+}
@@ -48,6 +48,8 @@ type MessengerResponse struct {
 	DiscordChannels               []*discord.Channel
 	DiscordOldestMessageTimestamp int
 	SavedAddresses                []*wallet.SavedAddress
+	ChatDrafts                    []*ChatDraft
+	ReadReceipts                  []*ReadReceipt
 
 	// notifications a list of notifications derived from messenger events
 	// that are useful to notify the user about
@@ -104,6 +106,8 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		DiscordMessages               []*protobuf.DiscordMessage           `json:"discordMessages,omitempty"`
 		DiscordMessageAttachments     []*protobuf.DiscordMessageAttachment `json:"discordMessageAtachments,omitempty"`
 		SavedAddresses                []*wallet.SavedAddress               `json:"savedAddresses,omitempty"`
+		ChatDrafts                    []*ChatDraft                         `json:"chatDrafts,omitempty"`
+		ReadReceipts                  []*ReadReceipt                       `json:"readReceipts,omitempty"`
 	}{
 		Contacts:                r.Contacts,
 		Installations:           r.Installations,
@@ -119,6 +123,8 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		Accounts:                r.Accounts,
 		VerificationRequests:    r.VerificationRequests,
 		SavedAddresses:          r.SavedAddresses,
+		ChatDrafts:              r.ChatDrafts,
+		ReadReceipts:            r.ReadReceipts,
 
 		Messages:                      r.Messages(),
 		Notifications:                 r.Notifications(),
@@ -250,6 +256,8 @@ func (r *MessengerResponse) IsEmpty() bool {
 		len(r.VerificationRequests)+
 		len(r.RequestsToJoinCommunity) == 0 &&
 		len(r.SavedAddresses) == 0 &&
+		len(r.ChatDrafts) == 0 &&
+		len(r.ReadReceipts) == 0 &&
 		r.currentStatus == nil
 }
 
@@ -282,6 +290,8 @@ func (r *MessengerResponse) Merge(response *MessengerResponse) error {
 	r.AddTrustStatuses(response.trustStatus)
 	r.AddActivityCenterNotifications(response.ActivityCenterNotifications())
 	r.CommunityChanges = append(r.CommunityChanges, response.CommunityChanges...)
+	r.ChatDrafts = append(r.ChatDrafts, response.ChatDrafts...)
+	r.AddReadReceipts(response.ReadReceipts)
 
 	return nil
 }
@@ -322,6 +332,14 @@ func (r *MessengerResponse) AddBookmarks(bookmarks []*browsers.Bookmark) {
 	}
 }
 
+func (r *MessengerResponse) AddChatDraft(draft *ChatDraft) {
+	r.ChatDrafts = append(r.ChatDrafts, draft)
+}
+
+func (r *MessengerResponse) AddReadReceipts(receipts []*ReadReceipt) {
+	r.ReadReceipts = append(r.ReadReceipts, receipts...)
+}
+
 func (r *MessengerResponse) AddVerificationRequest(vr *verification.Request) {
 	r.VerificationRequests = append(r.VerificationRequests, vr)
 }
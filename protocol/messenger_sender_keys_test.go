@@ -0,0 +1,184 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerSenderKeysSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSenderKeysSuite))
+}
+
+type MessengerSenderKeysSuite struct {
+	suite.Suite
+
+	// If one wants to send messages between different instances of Messenger,
+	// a single Waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerSenderKeysSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerSenderKeysSuite) TearDownTest() {
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerSenderKeysSuite) startNewMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, []Option{WithSenderKeys()})
+	s.Require().NoError(err)
+
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+
+	return messenger
+}
+
+func (s *MessengerSenderKeysSuite) createGroupChat(admin *Messenger, members ...*Messenger) *Chat {
+	var memberIDs []string
+	for _, member := range members {
+		s.Require().NoError(makeMutualContact(admin, &member.identity.PublicKey))
+		s.Require().NoError(makeMutualContact(member, &admin.identity.PublicKey))
+		memberIDs = append(memberIDs, common.PubkeyToHex(&member.identity.PublicKey))
+	}
+
+	response, err := admin.CreateGroupChatWithMembers(context.Background(), "sender-keys", memberIDs)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	chat := response.Chats()[0]
+
+	for _, member := range members {
+		_, err = WaitOnMessengerResponse(
+			member,
+			func(r *MessengerResponse) bool { return len(r.Chats()) == 1 && r.Chats()[0].Active },
+			"chat invitation not received",
+		)
+		s.Require().NoError(err)
+	}
+
+	return chat
+}
+
+func (s *MessengerSenderKeysSuite) currentSenderKey(m *Messenger, chatID string) uint32 {
+	keyID, err := m.encryptor.GetCurrentKeyForGroup(encryption.SenderKeyGroupID(chatID, &m.identity.PublicKey))
+	s.Require().NoError(err)
+	return keyID
+}
+
+func (s *MessengerSenderKeysSuite) sendAndReceive(sender *Messenger, chat *Chat, receivers ...*Messenger) {
+	message := buildTestMessage(*chat)
+	response, err := sender.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	messageID := response.Messages()[0].ID
+
+	for _, receiver := range receivers {
+		_, err = WaitOnMessengerResponse(
+			receiver,
+			func(r *MessengerResponse) bool {
+				for _, m := range r.Messages() {
+					if m.ID == messageID {
+						return true
+					}
+				}
+				return false
+			},
+			"message not received",
+		)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *MessengerSenderKeysSuite) TestGroupChatMessagesWithSenderKey() {
+	admin := s.startNewMessenger()
+	defer admin.Shutdown() // nolint: errcheck
+	alice := s.startNewMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.startNewMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+
+	chat := s.createGroupChat(admin, alice, bob)
+	s.Require().Zero(s.currentSenderKey(admin, chat.ID))
+	s.Require().NotNil(admin.transport.FilterByChatID(chat.ID))
+	s.Require().NotNil(alice.transport.FilterByChatID(chat.ID))
+
+	s.sendAndReceive(admin, chat, alice, bob)
+	keyID := s.currentSenderKey(admin, chat.ID)
+	s.Require().NotZero(keyID)
+
+	// The same sender key is used as long as the members don't change
+	s.sendAndReceive(admin, chat, alice, bob)
+	s.Require().Equal(keyID, s.currentSenderKey(admin, chat.ID))
+
+	// Members use their own sender key
+	s.sendAndReceive(alice, chat, admin, bob)
+	s.Require().NotZero(s.currentSenderKey(alice, chat.ID))
+}
+
+func (s *MessengerSenderKeysSuite) TestSenderKeyRotatedOnMembershipChange() {
+	admin := s.startNewMessenger()
+	defer admin.Shutdown() // nolint: errcheck
+	alice := s.startNewMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.startNewMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+
+	chat := s.createGroupChat(admin, alice, bob)
+
+	s.sendAndReceive(admin, chat, alice, bob)
+	keyID := s.currentSenderKey(admin, chat.ID)
+
+	_, err := admin.RemoveMembersFromGroupChat(context.Background(), chat.ID, []string{common.PubkeyToHex(&bob.identity.PublicKey)})
+	s.Require().NoError(err)
+
+	chat, ok := admin.allChats.Load(chat.ID)
+	s.Require().True(ok)
+
+	s.sendAndReceive(admin, chat, alice)
+	s.Require().NotEqual(keyID, s.currentSenderKey(admin, chat.ID))
+}
+
+func (s *MessengerSenderKeysSuite) TestGroupTopicNotJoinedWithoutSenderKeys() {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	admin, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = admin.Start()
+	s.Require().NoError(err)
+	defer admin.Shutdown() // nolint: errcheck
+
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.Require().NoError(makeMutualContact(admin, &member.PublicKey))
+
+	response, err := admin.CreateGroupChatWithMembers(context.Background(), "no-sender-keys", []string{common.PubkeyToHex(&member.PublicKey)})
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	chat := response.Chats()[0]
+	s.Require().Nil(admin.transport.FilterByChatID(chat.ID))
+
+	s.Require().NoError(admin.Init())
+	s.Require().Nil(admin.transport.FilterByChatID(chat.ID))
+}
@@ -0,0 +1,146 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// maxSessionResetResentMessages is the maximum number of unconfirmed messages
+	// resent when a contact resets their session with us
+	maxSessionResetResentMessages = 50
+	// sessionResetResendWindow is how old unconfirmed messages can be to be resent
+	// when a contact resets their session with us
+	sessionResetResendWindow = 24 * time.Hour
+)
+
+// resetSession is called when messages from publicKey can't be decrypted anymore.
+// It drops our sessions with them, asks them to do the same and to resend the
+// messages we missed, and lets the user know in the chat.
+func (m *Messenger) resetSession(state *ReceivedMessageState, publicKey *ecdsa.PublicKey) error {
+	chatID := contactIDFromPublicKey(publicKey)
+
+	reset := &protobuf.SessionReset{
+		Clock:          m.getTimesource().GetCurrentTime(),
+		InstallationId: m.installationID,
+	}
+	encodedMessage, err := proto.Marshal(reset)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.sender.SendSessionReset(context.Background(), publicKey, common.RawMessage{
+		LocalChatID: chatID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_SESSION_RESET,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.addSessionResetMessage(state, chatID)
+}
+
+// HandleSessionReset drops our sessions with the installation that sent the reset, so that
+// new ones are negotiated, and resends the messages they haven't confirmed yet
+func (m *Messenger) HandleSessionReset(state *ReceivedMessageState, message protobuf.SessionReset) error {
+	logger := m.logger.With(zap.String("site", "HandleSessionReset"))
+	if err := ValidateReceivedSessionReset(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Warn("failed to validate message", zap.Error(err))
+		return err
+	}
+
+	publicKey := state.CurrentMessageState.PublicKey
+	if common.IsPubKeyEqual(publicKey, &m.identity.PublicKey) && message.InstallationId == m.installationID {
+		return nil
+	}
+
+	err := m.encryptor.ResetSession(publicKey, message.InstallationId)
+	if err != nil {
+		return err
+	}
+
+	err = m.resendUnconfirmedMessages(publicKey)
+	if err != nil {
+		logger.Warn("failed to resend unconfirmed messages", zap.Error(err))
+	}
+
+	return m.addSessionResetMessage(state, contactIDFromPublicKey(publicKey))
+}
+
+// addSessionResetMessage adds a system message to the one-to-one chat with a contact
+// whose sessions have been reset
+func (m *Messenger) addSessionResetMessage(state *ReceivedMessageState, chatID string) error {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok || !chat.OneToOne() {
+		return nil
+	}
+
+	clock, timestamp := chat.NextClockAndTimestamp(m.getTimesource())
+	message := &common.Message{
+		ChatMessage: protobuf.ChatMessage{
+			ChatId:      chat.ID,
+			Text:        "Secure session reset",
+			MessageType: protobuf.MessageType_ONE_TO_ONE,
+			ContentType: protobuf.ChatMessage_SYSTEM_MESSAGE_SESSION_RESET,
+			Clock:       clock,
+			Timestamp:   timestamp,
+		},
+		From:             common.PubkeyToHex(&m.identity.PublicKey),
+		WhisperTimestamp: timestamp,
+		LocalChatID:      chat.ID,
+		Seen:             true,
+		ID:               types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s-session-reset-%d", chat.ID, clock)))),
+	}
+
+	state.Response.AddMessage(message)
+	return nil
+}
+
+// resendUnconfirmedMessages sends again the recent messages publicKey hasn't acknowledged yet.
+// They are still scheduled by datasync, so they are sent directly this time, so that
+// they are received without waiting for the next datasync retry.
+// Older messages are left to datasync, a reset must not flood the contact.
+func (m *Messenger) resendUnconfirmedMessages(publicKey *ecdsa.PublicKey) error {
+	ids, err := m.persistence.PendingConfirmationMessageIDs(crypto.CompressPubkey(publicKey), maxSessionResetResentMessages)
+	if err != nil {
+		return err
+	}
+
+	sentAfter := m.getTimesource().GetCurrentTime() - uint64(sessionResetResendWindow.Milliseconds())
+	for _, id := range ids {
+		rawMessage, err := m.persistence.RawMessageByID(id)
+		if err != nil {
+			return err
+		}
+
+		sentAt := rawMessage.FirstSent
+		if sentAt == 0 {
+			sentAt = rawMessage.LastSent
+		}
+		if sentAt < sentAfter {
+			continue
+		}
+
+		_, err = m.sender.SendPrivate(context.Background(), publicKey, &common.RawMessage{
+			LocalChatID: rawMessage.LocalChatID,
+			Payload:     rawMessage.Payload,
+			MessageType: rawMessage.MessageType,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerSessionResetSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSessionResetSuite))
+}
+
+type MessengerSessionResetSuite struct {
+	suite.Suite
+	m   *Messenger
+	bob *Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerSessionResetSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.m = s.newMessenger()
+	s.bob = s.newMessenger()
+	_, err := s.m.Start()
+	s.Require().NoError(err)
+	_, err = s.bob.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSessionResetSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	s.Require().NoError(s.bob.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerSessionResetSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerSessionResetSuite) sendText(from *Messenger, chat *Chat, text string) {
+	message := buildTestMessage(*chat)
+	message.Text = text
+	_, err := from.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+}
+
+func hasSessionResetMessage(response *MessengerResponse) bool {
+	for _, message := range response.Messages() {
+		if message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_SESSION_RESET {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MessengerSessionResetSuite) TestSessionReset() {
+	theirChat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(theirChat))
+
+	ourChat := CreateOneToOneChat("alice", &s.m.identity.PublicKey, s.bob.transport)
+	s.Require().NoError(s.bob.SaveChat(ourChat))
+
+	// Establish a session
+	s.sendText(s.m, theirChat, "hello")
+	_, err := WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message not received",
+	)
+	s.Require().NoError(err)
+
+	s.sendText(s.bob, ourChat, "hello back")
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message not received",
+	)
+	s.Require().NoError(err)
+
+	// Bob loses his sessions, as if restoring an old backup
+	s.Require().NoError(s.bob.encryptor.ResetSession(&s.m.identity.PublicKey, ""))
+
+	// Bob can't decrypt the messages and resets the session
+	var lost []string
+	err = tt.RetryWithBackOff(func() error {
+		text := fmt.Sprintf("lost %d", len(lost))
+		lost = append(lost, text)
+		s.sendText(s.m, theirChat, text)
+
+		response, err := s.bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, message := range response.Messages() {
+			s.Require().NotContains(lost, message.Text)
+		}
+		if !hasSessionResetMessage(response) {
+			return errors.New("session not reset")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// Alice resets the session and resends the messages
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		hasSessionResetMessage,
+		"session reset not received",
+	)
+	s.Require().NoError(err)
+
+	received := make(map[string]bool)
+	_, err = WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				received[message.Text] = true
+			}
+			for _, text := range lost {
+				if !received[text] {
+					return false
+				}
+			}
+			return true
+		},
+		"lost messages not received",
+	)
+	s.Require().NoError(err)
+
+	// The new session works both ways
+	s.sendText(s.bob, ourChat, "all good")
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == "all good" && message.From == common.PubkeyToHex(&s.bob.identity.PublicKey) {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
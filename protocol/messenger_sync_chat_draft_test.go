@@ -0,0 +1,205 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerSyncChatDraftSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSyncChatDraftSuite))
+}
+
+type MessengerSyncChatDraftSuite struct {
+	suite.Suite
+	privateKey *ecdsa.PrivateKey
+	alice1     *Messenger
+	alice2     *Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single Waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerSyncChatDraftSuite) newMessenger() *Messenger {
+	if s.privateKey == nil {
+		privateKey, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+
+		s.privateKey = privateKey
+	}
+
+	messenger, err := newMessengerWithKey(s.shh, s.privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerSyncChatDraftSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.alice1 = s.newMessenger()
+	s.alice2 = s.newMessenger()
+	_, err := s.alice1.Start()
+	s.Require().NoError(err)
+	_, err = s.alice2.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSyncChatDraftSuite) TearDownTest() {
+	s.Require().NoError(s.alice1.Shutdown())
+	s.Require().NoError(s.alice2.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerSyncChatDraftSuite) Pair() {
+	err := s.alice2.SetInstallationMetadata(s.alice2.installationID, &multidevice.InstallationMetadata{
+		Name:       "alice2",
+		DeviceType: "alice2",
+	})
+	s.Require().NoError(err)
+	response, err := s.alice2.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+
+	// Wait for the message to reach its destination
+	_, err = WaitOnMessengerResponse(
+		s.alice1,
+		func(r *MessengerResponse) bool { return len(r.Installations) > 0 },
+		"installation not received",
+	)
+	s.Require().NoError(err)
+
+	err = s.alice1.EnableInstallation(s.alice2.installationID)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSyncChatDraftSuite) TestSyncAndClearChatDraft() {
+	chatID := "foobardrafttest"
+	_, err := s.alice1.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	_, err = s.alice2.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	s.Pair()
+
+	response, err := s.alice1.SaveChatDraft(context.Background(), &requests.SaveChatDraft{
+		ChatID: chatID,
+		Text:   "half written",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.ChatDrafts, 1)
+
+	response, err = WaitOnMessengerResponse(
+		s.alice2,
+		func(r *MessengerResponse) bool { return len(r.ChatDrafts) > 0 },
+		"draft not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(chatID, response.ChatDrafts[0].ChatID)
+	s.Require().Equal("half written", response.ChatDrafts[0].Text)
+
+	draft, err := s.alice2.ChatDraft(chatID)
+	s.Require().NoError(err)
+	s.Require().NotNil(draft)
+	s.Require().Equal("half written", draft.Text)
+
+	// Sending the message from alice2 clears the draft on both devices
+	response, err = s.alice2.SendChatMessage(context.Background(), buildTestMessage(*s.alice2.Chat(chatID)))
+	s.Require().NoError(err)
+	s.Require().Len(response.ChatDrafts, 1)
+	s.Require().True(response.ChatDrafts[0].Removed)
+
+	draft, err = s.alice2.ChatDraft(chatID)
+	s.Require().NoError(err)
+	s.Require().Nil(draft)
+
+	_, err = WaitOnMessengerResponse(
+		s.alice1,
+		func(r *MessengerResponse) bool { return len(r.ChatDrafts) > 0 && r.ChatDrafts[0].Removed },
+		"draft removal not received",
+	)
+	s.Require().NoError(err)
+
+	draft, err = s.alice1.ChatDraft(chatID)
+	s.Require().NoError(err)
+	s.Require().Nil(draft)
+}
+
+func (s *MessengerSyncChatDraftSuite) TestOutdatedChatDraftIsIgnored() {
+	chatID := "foobardrafttest"
+	_, err := s.alice1.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	saved, err := s.alice1.persistence.SaveChatDraftIfNewer(&ChatDraft{ChatID: chatID, Text: "newer", Clock: 20})
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	saved, err = s.alice1.persistence.SaveChatDraftIfNewer(&ChatDraft{ChatID: chatID, Text: "older", Clock: 10})
+	s.Require().NoError(err)
+	s.Require().False(saved)
+
+	drafts, err := s.alice1.ChatDrafts()
+	s.Require().NoError(err)
+	s.Require().Len(drafts, 1)
+	s.Require().Equal("newer", drafts[0].Text)
+}
+
+func (s *MessengerSyncChatDraftSuite) TestBackToBackChatDraftSaves() {
+	chatID := "foobardrafttest"
+	_, err := s.alice1.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	// A draft synced from a device with a clock ahead of ours
+	saved, err := s.alice1.persistence.SaveChatDraftIfNewer(&ChatDraft{
+		ChatID: chatID,
+		Text:   "from the future",
+		Clock:  s.alice1.getTimesource().GetCurrentTime() + 60000,
+	})
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	for _, text := range []string{"h", "he", "hel", "hell", "hello"} {
+		_, err = s.alice1.SaveChatDraft(context.Background(), &requests.SaveChatDraft{
+			ChatID: chatID,
+			Text:   text,
+		})
+		s.Require().NoError(err)
+	}
+
+	draft, err := s.alice1.ChatDraft(chatID)
+	s.Require().NoError(err)
+	s.Require().NotNil(draft)
+	s.Require().Equal("hello", draft.Text)
+
+	_, err = s.alice1.DeleteChatDraft(context.Background(), chatID)
+	s.Require().NoError(err)
+
+	_, err = s.alice1.SaveChatDraft(context.Background(), &requests.SaveChatDraft{
+		ChatID: chatID,
+		Text:   "again",
+	})
+	s.Require().NoError(err)
+
+	draft, err = s.alice1.ChatDraft(chatID)
+	s.Require().NoError(err)
+	s.Require().NotNil(draft)
+	s.Require().Equal("again", draft.Text)
+}
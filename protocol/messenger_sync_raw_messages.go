@@ -188,6 +188,17 @@ func (m *Messenger) HandleSyncRawMessages(rawMessages []*protobuf.RawMessage) er
 				m.logger.Error("failed to handleSyncSavedAddress when HandleSyncRawMessages", zap.Error(err))
 				continue
 			}
+		case protobuf.ApplicationMetadataMessage_SYNC_CHAT_DRAFT:
+			var message protobuf.SyncChatDraft
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.handleSyncChatDraft(state, message)
+			if err != nil {
+				m.logger.Error("failed to handleSyncChatDraft when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
 		}
 	}
 	response, err := m.saveDataAndPrepareResponse(state)
@@ -2303,14 +2303,15 @@ func (s *MessengerSuite) TestShouldResendEmoji() {
 	s.Error(err)
 	s.False(ok)
 
-	// messages that already sent to many times shouldn't be resend
+	// messages sent many times CAN be resend after the maximum backoff (960 seconds)
 	ok, err = shouldResendMessage(&common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        false,
-		SendCount:   messageResendMaxCount + 1,
+		SendCount:   100,
+		LastSent:    s.m.getTimesource().GetCurrentTime() - 965*uint64(time.Second.Milliseconds()),
 	}, s.m.getTimesource())
 	s.NoError(err)
-	s.False(ok)
+	s.True(ok)
 
 	// message sent one time CAN'T be resend in 15 seconds (only after 30)
 	ok, err = shouldResendMessage(&common.RawMessage{
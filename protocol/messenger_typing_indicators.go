@@ -0,0 +1,226 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// typingIndicatorInterval is the minimum interval between two typing
+	// indicators sent in the same chat
+	typingIndicatorInterval = 3 * time.Second
+	// typingIndicatorTimeout is how long a member is considered to be typing
+	// after their last typing indicator, unless they tell us they stopped
+	typingIndicatorTimeout = 6 * time.Second
+	// typingIndicatorMaxAge is the maximum age of a typing indicator envelope,
+	// older ones (i.e. fetched from a mailserver) are ignored
+	typingIndicatorMaxAge = 30 * time.Second
+)
+
+var ErrTypingIndicatorNotSupported = errors.New("typing indicators are only supported in one-to-one and group chats")
+
+// TypingIndicator is signaled when a member of a chat starts or stops typing
+type TypingIndicator struct {
+	ChatID string `json:"chatId"`
+	From   string `json:"from"`
+	Typing bool   `json:"typing"`
+
+	expiresAt time.Time
+}
+
+// typingIndicators keeps track of the typing indicators sent and received, nothing is persisted
+type typingIndicators struct {
+	sync.Mutex
+	// sent is the time the last typing indicator was sent, by chat id
+	sent map[string]time.Time
+	// received are the members currently typing, by chat id and member
+	received map[string]*TypingIndicator
+}
+
+func newTypingIndicators() *typingIndicators {
+	return &typingIndicators{
+		sent:     make(map[string]time.Time),
+		received: make(map[string]*TypingIndicator),
+	}
+}
+
+// shouldSend rate limits the typing indicators sent in a chat. Stop indicators
+// are only sent if a start indicator was sent before.
+func (t *typingIndicators) shouldSend(chatID string, typing bool, now time.Time) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	lastSent, ok := t.sent[chatID]
+	if !typing {
+		delete(t.sent, chatID)
+		return ok
+	}
+
+	if ok && now.Sub(lastSent) < typingIndicatorInterval {
+		return false
+	}
+
+	t.sent[chatID] = now
+	return true
+}
+
+// receive stores the indicator and returns whether its state changed
+func (t *typingIndicators) receive(indicator *TypingIndicator) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	key := indicator.ChatID + indicator.From
+	_, wasTyping := t.received[key]
+
+	if !indicator.Typing {
+		delete(t.received, key)
+		return wasTyping
+	}
+
+	t.received[key] = indicator
+	return !wasTyping
+}
+
+// expire removes and returns the indicators that timed out
+func (t *typingIndicators) expire(now time.Time) []*TypingIndicator {
+	t.Lock()
+	defer t.Unlock()
+
+	var expired []*TypingIndicator
+	for key, indicator := range t.received {
+		if now.After(indicator.expiresAt) {
+			delete(t.received, key)
+			expired = append(expired, &TypingIndicator{
+				ChatID: indicator.ChatID,
+				From:   indicator.From,
+				Typing: false,
+			})
+		}
+	}
+	return expired
+}
+
+func (m *Messenger) typingIndicator(indicator *TypingIndicator) {
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.TypingIndicator(indicator)
+	}
+}
+
+// SendTypingIndicator lets the other members of the chat know that we started or stopped typing.
+// Indicators are sent as ephemeral messages, they are not persisted nor resent.
+func (m *Messenger) SendTypingIndicator(ctx context.Context, chatID string, typing bool) error {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return ErrChatNotFound
+	}
+
+	var messageType protobuf.MessageType
+	switch {
+	case chat.OneToOne():
+		messageType = protobuf.MessageType_ONE_TO_ONE
+	case chat.PrivateGroupChat():
+		messageType = protobuf.MessageType_PRIVATE_GROUP
+	default:
+		return ErrTypingIndicatorNotSupported
+	}
+
+	if !m.typingIndicators.shouldSend(chatID, typing, time.Now()) {
+		return nil
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	indicator := &protobuf.TypingIndicator{
+		Clock:       clock,
+		ChatId:      chatID,
+		MessageType: messageType,
+		Typing:      typing,
+	}
+
+	encodedMessage, err := proto.Marshal(indicator)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chatID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_TYPING_INDICATOR,
+		ResendAutomatically: false,
+		Ephemeral:           true,
+	})
+	return err
+}
+
+func (m *Messenger) HandleTypingIndicator(state *ReceivedMessageState, pb protobuf.TypingIndicator) error {
+	logger := m.logger.With(zap.String("site", "HandleTypingIndicator"))
+	if err := ValidateReceivedTypingIndicator(&pb, state.Timesource.GetCurrentTime()); err != nil {
+		logger.Error("invalid typing indicator", zap.Error(err))
+		return err
+	}
+
+	now := time.Now()
+	sentAt := time.Unix(0, int64(state.CurrentMessageState.WhisperTimestamp)*int64(time.Millisecond))
+	if now.Sub(sentAt) > typingIndicatorMaxAge {
+		return nil
+	}
+
+	from := state.CurrentMessageState.Contact.ID
+	if from == common.PubkeyToHex(&m.identity.PublicKey) {
+		return nil
+	}
+
+	var chat *Chat
+	switch pb.MessageType {
+	case protobuf.MessageType_ONE_TO_ONE:
+		chat, _ = state.AllChats.Load(from)
+	case protobuf.MessageType_PRIVATE_GROUP:
+		chat, _ = state.AllChats.Load(pb.ChatId)
+		if chat != nil && !chat.HasMember(from) {
+			chat = nil
+		}
+	}
+
+	if chat == nil || !chat.Active {
+		return nil
+	}
+
+	indicator := &TypingIndicator{
+		ChatID:    chat.ID,
+		From:      from,
+		Typing:    pb.Typing,
+		expiresAt: now.Add(typingIndicatorTimeout),
+	}
+
+	if m.typingIndicators.receive(indicator) {
+		m.typingIndicator(indicator)
+	}
+
+	return nil
+}
+
+// timeoutTypingIndicators signals that members stopped typing once their indicator expired
+func (m *Messenger) timeoutTypingIndicators() {
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case now := <-ticker.C:
+				for _, indicator := range m.typingIndicators.expire(now) {
+					m.typingIndicator(indicator)
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
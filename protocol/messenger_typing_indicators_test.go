@@ -0,0 +1,135 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestTypingIndicatorsRateLimit(t *testing.T) {
+	indicators := newTypingIndicators()
+	now := time.Now()
+
+	// Stop indicators are only sent after a start indicator
+	require.False(t, indicators.shouldSend("chat-id", false, now))
+	require.True(t, indicators.shouldSend("chat-id", true, now))
+	require.False(t, indicators.shouldSend("chat-id", true, now.Add(time.Second)))
+	require.True(t, indicators.shouldSend("other-chat-id", true, now.Add(time.Second)))
+	require.True(t, indicators.shouldSend("chat-id", true, now.Add(typingIndicatorInterval)))
+	require.True(t, indicators.shouldSend("chat-id", false, now.Add(typingIndicatorInterval)))
+	require.False(t, indicators.shouldSend("chat-id", false, now.Add(typingIndicatorInterval)))
+}
+
+func TestTypingIndicatorsExpire(t *testing.T) {
+	indicators := newTypingIndicators()
+	now := time.Now()
+
+	indicator := &TypingIndicator{ChatID: "chat-id", From: "from", Typing: true, expiresAt: now.Add(typingIndicatorTimeout)}
+	require.True(t, indicators.receive(indicator))
+	// Refreshing an indicator doesn't change the state
+	require.False(t, indicators.receive(indicator))
+	require.Empty(t, indicators.expire(now))
+
+	expired := indicators.expire(now.Add(typingIndicatorTimeout + time.Second))
+	require.Len(t, expired, 1)
+	require.Equal(t, "from", expired[0].From)
+	require.False(t, expired[0].Typing)
+}
+
+func TestMessengerTypingIndicatorsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerTypingIndicatorsSuite))
+}
+
+type MessengerTypingIndicatorsSuite struct {
+	suite.Suite
+	m   *Messenger
+	bob *Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerTypingIndicatorsSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.m = s.newMessenger()
+	s.bob = s.newMessenger()
+	_, err := s.m.Start()
+	s.Require().NoError(err)
+	_, err = s.bob.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerTypingIndicatorsSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	s.Require().NoError(s.bob.Shutdown())
+	_ = s.logger.Sync()
+}
+
+func (s *MessengerTypingIndicatorsSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerTypingIndicatorsSuite) TestSendTypingIndicator() {
+	chat := CreateOneToOneChat("bob", &s.bob.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	bobChat := CreateOneToOneChat("m", &s.m.identity.PublicKey, s.bob.transport)
+	s.Require().NoError(s.bob.SaveChat(bobChat))
+
+	s.Require().NoError(s.m.SendTypingIndicator(context.Background(), chat.ID, true))
+
+	// Typing indicators are never persisted
+	ids, err := s.m.persistence.RawMessagesIDsByType(protobuf.ApplicationMetadataMessage_TYPING_INDICATOR)
+	s.Require().NoError(err)
+	s.Require().Empty(ids)
+
+	from := common.PubkeyToHex(&s.m.identity.PublicKey)
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+
+		s.bob.typingIndicators.Lock()
+		defer s.bob.typingIndicators.Unlock()
+		if _, ok := s.bob.typingIndicators.received[bobChat.ID+from]; !ok {
+			return errors.New("typing indicator not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerTypingIndicatorsSuite) TestSendTypingIndicatorPublicChat() {
+	chat := CreatePublicChat("status", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	err := s.m.SendTypingIndicator(context.Background(), chat.ID, true)
+	s.Require().Equal(ErrTypingIndicatorNotSupported, err)
+}
@@ -70,6 +70,15 @@
 // 1670921937_add_album_id.up.sql (55B)
 // 1673373000_add_replied.up.sql (67B)
 // 1673428910_add_image_width_height.up.sql (117B)
+// 1674210659_add_chat_drafts.up.sql (257B)
+// 1674300000_add_file_attachments.up.sql (1.037kB)
+// 1674300001_add_video_messages.up.sql (367B)
+// 1674300003_add_read_receipts.up.sql (271B)
+// 1674300004_add_emoji_reactions_emoji.up.sql (395B)
+// 1674300006_add_communities_channel_key_recipients.up.sql (176B)
+// 1674300009_add_group_message_delivery.up.sql (236B)
+// 1674300010_add_raw_messages_outbox.up.sql (346B)
+// 1674300016_add_raw_messages_installations.up.sql (157B)
 // README.md (554B)
 // doc.go (850B)
 
@@ -1540,6 +1549,186 @@ func _1673428910_add_image_width_heightUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1674210659_add_chat_draftsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x8e\x41\x0a\xc2\x30\x14\x44\xf7\x3d\xc5\xec\x54\xf0\x06\xae\x7e\xe3\x2f\x06\xbf\x49\x49\xa3\xe8\x4a\x4a\x1b\xa9\x68\x5b\xb1\x41\x3c\xbe\x16\xc1\x8d\x0b\x97\x33\xbc\x79\x8c\x72\x4c\x9e\xe1\x29\x15\x86\xce\x60\xac\x07\xef\x75\xe1\x0b\x54\x4d\x19\x8f\xf5\xbd\x3c\xc5\x01\xd3\x04\x9f\x7c\xae\xb1\x23\xa7\x56\xe4\x90\x3b\xbd\x21\x77\xc0\x9a\x0f\xb0\x06\xca\x9a\x4c\xb4\xf2\x70\x9c\x0b\x29\x9e\xbf\x27\x31\x3c\xe3\x97\x1f\xd5\x66\x2b\x82\x25\x67\xb4\x15\x8f\xc9\x64\x64\xee\x61\xb8\xf5\xdd\x10\x8e\xb1\xff\x87\x96\x31\x96\x55\xd3\x86\xee\xfd\x28\x15\x9b\x8e\x5d\x75\xed\xab\x0b\xb4\xf1\xdf\xd1\x47\xda\xf6\x8f\x50\x23\xb5\x56\x98\xcc\xaf\x30\x23\x29\x38\x99\x2d\x92\x17\xed\x16\x87\xda\x01\x01\x00\x00")
+
+func _1674210659_add_chat_draftsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674210659_add_chat_draftsUpSql,
+		"1674210659_add_chat_drafts.up.sql",
+	)
+}
+
+func _1674210659_add_chat_draftsUpSql() (*asset, error) {
+	bytes, err := _1674210659_add_chat_draftsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674210659_add_chat_drafts.up.sql", size: 257, mode: os.FileMode(0644), modTime: time.Unix(1674210659, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf6, 0x14, 0x56, 0xc1, 0x9d, 0xfa, 0xdd, 0xd7, 0x6e, 0x12, 0xed, 0x4b, 0xf0, 0x6c, 0x8f, 0xc3, 0x2e, 0xe3, 0x4b, 0x57, 0x9c, 0xf9, 0x47, 0x73, 0xc7, 0x7, 0xe2, 0x6c, 0x53, 0x39, 0xcb, 0xe5}}
+	return a, nil
+}
+
+var __1674300000_add_file_attachmentsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\xcf\x6f\xda\x30\x14\xc7\xef\xf9\x2b\xbe\x37\x40\xa2\xd2\xee\x9c\x4c\x30\x5b\x34\xd7\x41\xa9\x99\xda\x53\x64\x92\xb7\xc6\x2a\x71\x50\x9e\x91\xc6\xfe\xfa\xc9\x24\x6c\x45\x5d\xa5\x22\xf5\x9a\xf7\xde\x27\xdf\x1f\x16\xca\xc8\x02\x46\x2c\x95\xc4\x91\xa9\x2f\x5b\x62\xb6\xcf\xc4\x10\xab\x15\xd2\x5c\x6d\xef\x35\x7e\xba\x3d\x95\xde\xb6\x84\x1f\xa2\x48\xbf\x89\x02\x3a\x37\xd0\x5b\xa5\xb0\x92\x6b\xb1\x55\x06\x93\xc9\x22\xf9\x38\xab\x75\x2d\x95\xe1\x74\xf8\x34\x20\xbb\xdf\x84\x4c\x9b\xb7\x9c\x2f\xb7\x60\x1a\xcb\x0d\x96\x2a\x5f\xde\x72\x54\x35\x47\xff\xc2\x65\xd5\x1d\x7d\xf8\x04\x0d\x67\xdc\x59\x09\xf1\xa8\x25\x49\x0b\x29\x8c\x1c\xcf\xb3\xf5\xf9\x0f\xf2\x31\x7b\x30\x0f\xc3\x8d\x0d\xc1\x56\x4d\x4b\x3e\x30\xa6\x09\xf0\xd7\x07\x36\x45\x76\x2f\x8a\x27\x7c\x97\x4f\xc8\x35\xd2\x5c\xaf\x55\x96\x1a\x14\x72\xa3\x44\x2a\xe7\x09\x70\xb0\xa7\x7d\x67\xeb\x61\xff\xa2\x3d\x99\x2d\x92\xe4\xee\x0e\x1b\xf2\xb5\xf3\xcf\x18\x4c\xc2\xf6\x84\x5d\x77\xf4\x35\x42\x87\xd0\x10\x46\x1b\xa8\x89\xab\xde\xed\xe2\x6a\xfc\x1c\x55\xc1\x0e\x6b\x2e\x30\x98\x7c\x4d\xfd\x3c\x12\x39\x1e\xda\x70\x21\x32\xf9\x80\xdd\x09\xdc\xb5\xd4\x79\x02\xed\x99\x50\x59\x3f\x09\x08\xf6\x85\x22\xcd\xf5\x38\xec\x6d\x45\x37\xc4\x30\x96\x72\x0e\x63\x94\x58\xba\xfa\xcd\x6b\x8b\xf6\x07\x69\xff\x1d\x5d\xbf\x89\xab\xd1\xd0\x92\xf3\x35\xfd\xba\xea\xfc\xdd\x40\xe3\xe0\x75\x19\xd3\x7f\xb2\xe6\x97\x78\x5e\x53\x67\x57\x75\x65\x5f\x75\x5e\xc8\x58\xca\x98\x41\xa6\x57\xf2\xf1\x43\x19\x94\xa3\xc1\x5c\xbf\xb3\x30\x1d\x16\x66\x8b\xe4\x4f\x00\x00\x00\xff\xff\xf7\x09\xd3\x51\x0d\x04\x00\x00")
+
+func _1674300000_add_file_attachmentsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300000_add_file_attachmentsUpSql,
+		"1674300000_add_file_attachments.up.sql",
+	)
+}
+
+func _1674300000_add_file_attachmentsUpSql() (*asset, error) {
+	bytes, err := _1674300000_add_file_attachmentsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300000_add_file_attachments.up.sql", size: 1037, mode: os.FileMode(0644), modTime: time.Unix(1674300000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe1, 0xb5, 0x71, 0xb, 0xa9, 0x5c, 0x2c, 0x8d, 0xbe, 0x42, 0x7, 0x7a, 0x29, 0xaf, 0x2a, 0x44, 0xe8, 0xff, 0x1c, 0xf7, 0x3e, 0x2c, 0x74, 0xf5, 0x10, 0xd7, 0x50, 0x6d, 0x2b, 0xd7, 0xda, 0x81}}
+	return a, nil
+}
+
+var __1674300001_add_video_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\xcc\x41\x0e\xc2\x20\x10\x40\xd1\xbd\xa7\x98\x23\xb8\x77\x05\x82\x89\xc9\x08\x49\x03\x6b\x82\x61\x52\x48\xa4\x35\x65\xd0\xf4\xf6\xf5\x02\x2e\x34\x5d\xfc\xe5\x7f\x02\x9d\x1e\xc0\x09\x89\x1a\x7a\xa3\x25\x54\x6a\x2d\x8e\xd4\x40\x28\x05\x67\x8b\xfe\x66\xe0\x55\x12\xcd\x81\x73\xaf\xf7\x29\x96\x07\x48\xb4\xf2\x74\x10\x7f\xac\x81\xd7\x27\xc1\xd5\x38\x30\xf6\x93\x47\x04\xa5\x2f\xc2\xa3\x83\xe3\x4f\x62\xea\x4b\xe4\x32\x4f\xa1\xb6\x3d\xb8\x77\x49\x9c\xf7\x80\x32\x95\x31\xf3\x57\x69\x03\x72\xab\x81\x13\x6f\x01\x00\x00")
+
+func _1674300001_add_video_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300001_add_video_messagesUpSql,
+		"1674300001_add_video_messages.up.sql",
+	)
+}
+
+func _1674300001_add_video_messagesUpSql() (*asset, error) {
+	bytes, err := _1674300001_add_video_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300001_add_video_messages.up.sql", size: 367, mode: os.FileMode(0644), modTime: time.Unix(1674300001, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbe, 0x99, 0x4f, 0xbf, 0x8f, 0x32, 0x82, 0x47, 0xe7, 0xb5, 0x79, 0x50, 0x53, 0xcd, 0x63, 0xbb, 0x4e, 0x0, 0x24, 0xce, 0x2d, 0x61, 0xb9, 0xe7, 0x22, 0xf7, 0x3e, 0x6d, 0x7d, 0x5e, 0xe, 0xae}}
+	return a, nil
+}
+
+var __1674300003_add_read_receiptsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x8f\x41\x0e\x82\x30\x14\x44\xf7\x3d\xc5\x2c\x21\xe1\x06\xae\x6a\xfd\x68\x43\x6d\x4d\xa9\x06\x56\x84\x94\x46\x89\x1a\x0d\x70\xff\x88\x8a\x31\x24\xba\xfd\xef\xbf\xcc\x8c\xb0\xc4\x1d\xc1\xf1\xa5\x22\xc8\x14\xda\x38\x50\x21\x73\x97\xa3\x0b\x75\x53\x75\xc1\x87\xf6\x3e\xf4\x88\x18\x70\x0d\x7d\x5f\x1f\x43\xd5\x36\x38\x70\x2b\x36\xdc\xbe\xfe\xf5\x5e\xa9\x64\xc4\x4f\x21\x74\x3f\x91\x3f\xd5\xc3\x3f\xcd\x5f\x6e\xfe\x0c\xa9\xdd\xec\xba\xb3\x72\xcb\x6d\x89\x8c\x4a\x44\xdf\xe0\x64\x4a\x89\x61\x34\x84\xd1\xa9\x92\xc2\x41\xae\xb5\xb1\xc4\xe2\x05\x63\xe2\x3d\x48\xea\x15\x15\xf3\x09\xd5\xa7\xc4\x68\xce\x40\x34\x81\x51\x7f\x00\x6a\x87\x73\xc8\x0f\x01\x00\x00")
+
+func _1674300003_add_read_receiptsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300003_add_read_receiptsUpSql,
+		"1674300003_add_read_receipts.up.sql",
+	)
+}
+
+func _1674300003_add_read_receiptsUpSql() (*asset, error) {
+	bytes, err := _1674300003_add_read_receiptsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300003_add_read_receipts.up.sql", size: 271, mode: os.FileMode(0644), modTime: time.Unix(1674300003, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5, 0x4a, 0x4b, 0xb5, 0x64, 0x1, 0x1e, 0xe4, 0x89, 0x79, 0x7f, 0xc6, 0x49, 0xed, 0xb, 0xcf, 0xc7, 0xd, 0xf6, 0xb1, 0x3c, 0xc0, 0xf0, 0x44, 0x7e, 0xb6, 0xbd, 0xd6, 0xbc, 0x3a, 0xd8, 0x6f}}
+	return a, nil
+}
+
+var __1674300004_add_emoji_reactions_emojiUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8e\x41\x6a\xc3\x30\x10\x45\xf7\x3a\xc5\xdf\x25\x81\xdc\x20\xab\xb1\x3c\xa6\xa6\x13\x2b\x28\x72\x69\x56\x46\x38\x86\xa8\x54\x56\xa9\x9c\x45\x6e\x5f\xd2\x94\x10\xa8\x29\xdd\xce\xfb\xbc\x37\x24\x8e\x2d\x1c\x15\xc2\x18\x62\x7a\x0b\xdd\xe7\xe0\xfb\x29\xa4\x31\x83\xca\x12\xda\x48\xbb\x6d\x6e\x08\x2f\x64\xf5\x13\x59\x34\xc6\xa1\x69\x45\x50\x72\x45\xad\x38\x2c\x16\x1b\xf5\x4f\x53\x7f\xce\x53\x8a\xdd\x6d\x11\x8e\x7f\x3b\x95\xb6\x4c\x8e\x7f\xac\x75\xf5\xbd\xe2\xd7\x7a\xef\xf6\xe8\x53\x8c\xe7\x31\x4c\x61\xc8\xdd\xa3\x34\x63\xa9\x70\xa7\x97\x6b\xa3\x10\x53\xdc\x03\x6b\x05\xcc\x74\xaf\xe7\xd1\xc7\x61\x16\x7c\xf8\xcb\x7b\xf2\x33\xa2\x93\xcf\xa7\xdf\xd7\x9d\xad\xb7\x64\x0f\x78\xe6\x03\x96\x8f\x9f\xac\x11\x8e\x2b\x98\x06\xda\x34\x95\xd4\xda\xc1\xf2\x4e\x48\xb3\x5a\x6d\xd4\x57\x00\x00\x00\xff\xff\x61\xb3\x1b\xde\x8b\x01\x00\x00")
+
+func _1674300004_add_emoji_reactions_emojiUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300004_add_emoji_reactions_emojiUpSql,
+		"1674300004_add_emoji_reactions_emoji.up.sql",
+	)
+}
+
+func _1674300004_add_emoji_reactions_emojiUpSql() (*asset, error) {
+	bytes, err := _1674300004_add_emoji_reactions_emojiUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300004_add_emoji_reactions_emoji.up.sql", size: 395, mode: os.FileMode(0644), modTime: time.Unix(1674300004, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf1, 0xa7, 0x9e, 0xdc, 0x87, 0xd2, 0x57, 0x7b, 0x18, 0x96, 0xf9, 0x69, 0xdb, 0x30, 0x67, 0x43, 0x8c, 0x66, 0x77, 0xb, 0x46, 0x5c, 0x40, 0xb3, 0x64, 0x8b, 0xf6, 0xd2, 0xdf, 0x42, 0xb0, 0xcd}}
+	return a, nil
+}
+
+var __1674300006_add_communities_channel_key_recipientsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\xcc\xb1\x0a\xc2\x30\x14\x46\xe1\xbd\x4f\xf1\x8f\x2d\xf4\x0d\x9c\x62\xb8\x42\x30\xa6\x25\xbd\x42\x3b\x05\x8d\x01\x83\x6d\x2c\xb6\x1d\x7c\x7b\xab\x38\x08\xce\x87\xef\x48\x4b\x82\x09\x2c\xb6\x9a\xa0\x76\x30\x15\x83\x5a\xd5\x70\x03\x7f\x1f\x86\x25\xc5\x39\x86\xc9\xf9\xeb\x29\xa5\xd0\xbb\x5b\x78\xba\x47\xf0\x71\x8c\x21\xcd\x13\xf2\x0c\x58\xd3\xec\xe2\x05\x4c\x2d\x7f\xb8\x39\x6a\x5d\xae\x61\x5c\xce\x7d\xf4\x6f\xf2\xdf\x6a\xab\x0e\xc2\x76\xd8\x53\x87\xfc\x7b\x28\x7f\x44\x81\xca\x40\x56\x66\xa7\x95\x64\x58\xaa\xb5\x90\x94\x15\x9b\xec\x05\xa4\xb1\x9f\x6f\xb0\x00\x00\x00")
+
+func _1674300006_add_communities_channel_key_recipientsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300006_add_communities_channel_key_recipientsUpSql,
+		"1674300006_add_communities_channel_key_recipients.up.sql",
+	)
+}
+
+func _1674300006_add_communities_channel_key_recipientsUpSql() (*asset, error) {
+	bytes, err := _1674300006_add_communities_channel_key_recipientsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300006_add_communities_channel_key_recipients.up.sql", size: 176, mode: os.FileMode(0644), modTime: time.Unix(1674300006, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd6, 0xd5, 0x4, 0x8e, 0x58, 0x86, 0x9d, 0xed, 0xe7, 0x2a, 0xa4, 0xfe, 0x24, 0xa2, 0x19, 0xd2, 0x12, 0x96, 0x22, 0xcc, 0x68, 0x3e, 0x19, 0xc6, 0xaa, 0x92, 0x74, 0xf3, 0xe1, 0x76, 0x6e, 0x72}}
+	return a, nil
+}
+
+var __1674300009_add_group_message_deliveryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4a\x2c\x8f\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x8d\x4f\xce\xcf\x4b\xcb\x2c\xca\x4d\x2c\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x4e\xcd\x2b\x89\x4f\x2c\x51\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x47\x24\x03\x4b\x8b\x53\x8b\x60\x26\xa2\x18\x92\x92\x9a\x93\x59\x96\x5a\x94\x9a\x02\xb4\xa7\x34\x8f\x42\xc3\x8a\x52\x93\x33\x0b\x32\x81\xce\x2a\x26\x60\x1a\x00\x1f\x59\xf0\x1f\xec\x00\x00\x00")
+
+func _1674300009_add_group_message_deliveryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300009_add_group_message_deliveryUpSql,
+		"1674300009_add_group_message_delivery.up.sql",
+	)
+}
+
+func _1674300009_add_group_message_deliveryUpSql() (*asset, error) {
+	bytes, err := _1674300009_add_group_message_deliveryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300009_add_group_message_delivery.up.sql", size: 236, mode: os.FileMode(0644), modTime: time.Unix(1674300009, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe5, 0x19, 0xc9, 0xaa, 0x19, 0xac, 0xb0, 0x4, 0x63, 0x85, 0xae, 0x4f, 0x5f, 0x12, 0x9d, 0x73, 0x3e, 0x52, 0x5a, 0x6a, 0x53, 0x29, 0xba, 0xf5, 0xd, 0xc8, 0x65, 0x39, 0x5c, 0x40, 0x75, 0xad}}
+	return a, nil
+}
+
+var __1674300010_add_raw_messages_outboxUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xc1\x6a\xc3\x30\x10\x44\xef\xfe\x8a\xb9\x14\x27\x25\x01\xf7\x6c\x7a\x50\x62\xa5\x14\x14\xbb\xc4\xf2\x39\x28\xf5\xda\x11\x58\x52\x91\x36\xa4\xfd\xfb\xe2\x96\x42\x69\x2f\xb9\x2e\x6f\x1f\x33\x23\x94\x96\x07\x68\xb1\x51\x12\xd1\x5c\x8f\x8e\x52\x32\x23\x25\x88\xaa\xc2\xb6\x51\xdd\xbe\xc6\x60\x63\xe2\x63\x22\xcf\x78\xae\x35\xea\x46\xa3\xee\x94\x42\x25\x77\xa2\x53\x1a\x45\x99\xdd\xa4\x31\x76\xa2\x1e\x9b\xa6\x51\x52\xd4\xff\x35\x3b\xa1\x5a\x59\x66\xeb\x35\xf6\x3f\xdf\x66\x8a\x64\xfa\x0f\x58\x0f\x3e\x13\xc2\x85\x4f\xe1\x1d\xaf\xe1\xe2\x79\x3e\xd8\x88\x9e\x4c\x3f\x59\x4f\x18\x62\x70\x5f\x90\xb3\x63\x34\x6c\x83\x5f\x7d\x23\xb3\x70\x32\x89\x61\x98\xc9\xbd\xf1\x0c\x9c\x19\x27\xc2\x14\xfc\x88\x31\x78\xca\xba\x97\x4a\xe8\x3f\xc9\x5b\xa9\x7f\x37\x7f\xc4\x56\xb4\x7a\x91\x38\x0e\x6c\x1d\x2d\xf2\xbb\x94\xaf\x90\xfb\x70\xcd\x97\x10\xed\xbc\x8c\x7c\x92\x87\x25\xee\xf1\x50\x14\x45\x99\x7d\x06\x00\x00\xff\xff\x2c\x9a\x8c\x51\x5a\x01\x00\x00")
+
+func _1674300010_add_raw_messages_outboxUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300010_add_raw_messages_outboxUpSql,
+		"1674300010_add_raw_messages_outbox.up.sql",
+	)
+}
+
+func _1674300010_add_raw_messages_outboxUpSql() (*asset, error) {
+	bytes, err := _1674300010_add_raw_messages_outboxUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300010_add_raw_messages_outbox.up.sql", size: 346, mode: os.FileMode(0644), modTime: time.Unix(1674300010, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x56, 0xb0, 0x9c, 0x1c, 0xe9, 0x6a, 0xf9, 0x72, 0x89, 0x3b, 0x80, 0x8a, 0x64, 0xf0, 0xb0, 0xfc, 0x75, 0xdd, 0xb7, 0x9f, 0x4f, 0x47, 0xb1, 0x53, 0xa7, 0xc5, 0x89, 0xe, 0x67, 0xba, 0xa4, 0x9f}}
+	return a, nil
+}
+
+var __1674300016_add_raw_messages_installationsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4a\x2c\x8f\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xcc\x2b\x2e\x49\xcc\xc9\x49\x2c\xc9\xcc\xcf\x2b\x56\x08\x71\x8d\x08\x51\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\x50\x57\xb7\xe6\x22\xc6\xac\xe2\xec\xcc\x82\x78\x62\x0d\x04\x04\x00\x00\xff\xff\x5c\xbb\x13\x9e\x9d\x00\x00\x00")
+
+func _1674300016_add_raw_messages_installationsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300016_add_raw_messages_installationsUpSql,
+		"1674300016_add_raw_messages_installations.up.sql",
+	)
+}
+
+func _1674300016_add_raw_messages_installationsUpSql() (*asset, error) {
+	bytes, err := _1674300016_add_raw_messages_installationsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300016_add_raw_messages_installations.up.sql", size: 157, mode: os.FileMode(0644), modTime: time.Unix(1674300016, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xca, 0x71, 0x2b, 0xf, 0x9c, 0x9a, 0x99, 0x9e, 0xdd, 0x6a, 0x5a, 0xd2, 0x5c, 0xfb, 0x4e, 0x73, 0xbd, 0xea, 0x1a, 0xe9, 0x72, 0x5b, 0xe8, 0x9b, 0x9c, 0x57, 0x29, 0xd0, 0xf3, 0x42, 0x22, 0x5c}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -1811,6 +2000,25 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1673428910_add_image_width_height.up.sql": _1673428910_add_image_width_heightUpSql,
 
+	"1674210659_add_chat_drafts.up.sql": _1674210659_add_chat_draftsUpSql,
+
+	"1674300000_add_file_attachments.up.sql": _1674300000_add_file_attachmentsUpSql,
+
+	"1674300001_add_video_messages.up.sql": _1674300001_add_video_messagesUpSql,
+
+	"1674300003_add_read_receipts.up.sql": _1674300003_add_read_receiptsUpSql,
+
+	"1674300004_add_emoji_reactions_emoji.up.sql": _1674300004_add_emoji_reactions_emojiUpSql,
+
+	"1674300006_add_communities_channel_key_recipients.up.sql": _1674300006_add_communities_channel_key_recipientsUpSql,
+
+	"1674300009_add_group_message_delivery.up.sql": _1674300009_add_group_message_deliveryUpSql,
+
+	"1674300010_add_raw_messages_outbox.up.sql": _1674300010_add_raw_messages_outboxUpSql,
+
+
+	"1674300016_add_raw_messages_installations.up.sql": _1674300016_add_raw_messages_installationsUpSql,
+
 	"README.md": readmeMd,
 
 	"doc.go": docGo,
@@ -1927,6 +2135,15 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1670921937_add_album_id.up.sql":                                          &bintree{_1670921937_add_album_idUpSql, map[string]*bintree{}},
 	"1673373000_add_replied.up.sql":                                           &bintree{_1673373000_add_repliedUpSql, map[string]*bintree{}},
 	"1673428910_add_image_width_height.up.sql":                                &bintree{_1673428910_add_image_width_heightUpSql, map[string]*bintree{}},
+	"1674210659_add_chat_drafts.up.sql":                                       &bintree{_1674210659_add_chat_draftsUpSql, map[string]*bintree{}},
+	"1674300000_add_file_attachments.up.sql":                                  &bintree{_1674300000_add_file_attachmentsUpSql, map[string]*bintree{}},
+	"1674300001_add_video_messages.up.sql":                                    &bintree{_1674300001_add_video_messagesUpSql, map[string]*bintree{}},
+	"1674300003_add_read_receipts.up.sql":                                     &bintree{_1674300003_add_read_receiptsUpSql, map[string]*bintree{}},
+	"1674300004_add_emoji_reactions_emoji.up.sql":                             &bintree{_1674300004_add_emoji_reactions_emojiUpSql, map[string]*bintree{}},
+	"1674300006_add_communities_channel_key_recipients.up.sql":                &bintree{_1674300006_add_communities_channel_key_recipientsUpSql, map[string]*bintree{}},
+	"1674300009_add_group_message_delivery.up.sql":                            &bintree{_1674300009_add_group_message_deliveryUpSql, map[string]*bintree{}},
+	"1674300010_add_raw_messages_outbox.up.sql":                               &bintree{_1674300010_add_raw_messages_outboxUpSql, map[string]*bintree{}},
+	"1674300016_add_raw_messages_installations.up.sql":                        &bintree{_1674300016_add_raw_messages_installationsUpSql, map[string]*bintree{}},
 	"README.md": &bintree{readmeMd, map[string]*bintree{}},
 	"doc.go":    &bintree{docGo, map[string]*bintree{}},
 }}
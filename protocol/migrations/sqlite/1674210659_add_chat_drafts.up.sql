@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS chat_drafts (
+  chat_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  text VARCHAR NOT NULL DEFAULT '',
+  response_to VARCHAR NOT NULL DEFAULT '',
+  attachments BLOB,
+  clock INT NOT NULL,
+  removed BOOLEAN NOT NULL DEFAULT FALSE
+);
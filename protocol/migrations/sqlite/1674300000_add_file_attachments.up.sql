@@ -0,0 +1,23 @@
+ALTER TABLE user_messages ADD COLUMN file_name VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE user_messages ADD COLUMN file_mime_type VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE user_messages ADD COLUMN file_size INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN file_hash BLOB;
+ALTER TABLE user_messages ADD COLUMN file_chunks_count INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN file_chunk_hashes BLOB;
+
+CREATE TABLE IF NOT EXISTS file_attachments (
+  hash BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  payload BLOB NOT NULL
+);
+
+-- Pending chunks are bound to the message describing the file and to its sender,
+-- so that chunks sent by someone else can't take their place
+CREATE TABLE IF NOT EXISTS file_attachment_chunks (
+  message_id VARCHAR NOT NULL,
+  sender VARCHAR NOT NULL,
+  file_hash BLOB NOT NULL,
+  chunk_index INT NOT NULL,
+  payload BLOB NOT NULL,
+  PRIMARY KEY (message_id, sender, chunk_index) ON CONFLICT IGNORE
+);
+CREATE INDEX IF NOT EXISTS file_attachment_chunks_sender ON file_attachment_chunks(sender);
@@ -0,0 +1,5 @@
+ALTER TABLE user_messages ADD COLUMN video_thumbnail BLOB;
+ALTER TABLE user_messages ADD COLUMN video_thumbnail_type INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN video_duration_ms INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN video_width INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN video_height INT NOT NULL DEFAULT 0;
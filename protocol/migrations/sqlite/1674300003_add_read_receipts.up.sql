@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS read_receipts (
+  message_id VARCHAR NOT NULL,
+  reader VARCHAR NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  clock INT NOT NULL,
+  PRIMARY KEY (message_id, reader) ON CONFLICT IGNORE
+);
+
+CREATE INDEX read_receipts_chat_id ON read_receipts(chat_id);
@@ -0,0 +1,11 @@
+ALTER TABLE emoji_reactions ADD COLUMN emoji VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE emoji_reactions ADD COLUMN custom_emoji_id VARCHAR NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS communities_custom_emojis (
+  community_id BLOB NOT NULL,
+  id VARCHAR NOT NULL,
+  name VARCHAR NOT NULL,
+  payload BLOB NOT NULL,
+  hash BLOB NOT NULL,
+  PRIMARY KEY (community_id, id) ON CONFLICT REPLACE
+);
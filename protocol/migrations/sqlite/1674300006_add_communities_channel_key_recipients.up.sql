@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS communities_channel_key_recipients (
+  chat_id TEXT NOT NULL,
+  public_key TEXT NOT NULL,
+  PRIMARY KEY (chat_id, public_key) ON CONFLICT REPLACE
+);
@@ -0,0 +1,3 @@
+ALTER TABLE raw_message_confirmations ADD COLUMN sent_at INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN delivered_count INT NOT NULL DEFAULT 0;
+ALTER TABLE user_messages ADD COLUMN recipients_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE raw_messages ADD COLUMN first_sent INT NOT NULL DEFAULT 0;
+ALTER TABLE raw_messages ADD COLUMN failed BOOLEAN NOT NULL DEFAULT FALSE;
+-- Messages already in the outbox count their deadline from the migration, their
+-- last attempt might be long gone
+UPDATE raw_messages SET first_sent = CAST(strftime('%s', 'now') AS INTEGER) * 1000;
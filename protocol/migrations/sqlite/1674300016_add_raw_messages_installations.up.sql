@@ -0,0 +1,2 @@
+ALTER TABLE raw_messages ADD COLUMN installations TEXT NOT NULL DEFAULT '';
+ALTER TABLE raw_messages ADD COLUMN skip_installations TEXT NOT NULL DEFAULT '';
@@ -815,7 +815,9 @@ func (db sqlitePersistence) SaveContactChatIdentity(contactID string, chatIdenti
 	return
 }
 
-func (db sqlitePersistence) ExpiredMessagesIDs(maxSendCount int) ([]string, error) {
+// ExpiredMessagesIDs returns the ids of the messages in the outbox, that haven't been sent
+// nor given up on, the most recent first
+func (db sqlitePersistence) ExpiredMessagesIDs() ([]string, error) {
 	ids := []string{}
 
 	rows, err := db.db.Query(`
@@ -824,11 +826,12 @@ func (db sqlitePersistence) ExpiredMessagesIDs(maxSendCount int) ([]string, erro
 			FROM
 				raw_messages
 			WHERE
-			message_type IN (?, ?) AND sent = ? AND send_count <= ?`,
+			message_type IN (?, ?) AND sent = ? AND failed = ?
+			ORDER BY first_sent DESC`,
 		protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
 		protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		false,
-		maxSendCount)
+		false)
 	if err != nil {
 		return ids, err
 	}
@@ -738,7 +738,7 @@ func TestExpiredMessagesIDs(t *testing.T) {
 	require.NoError(t, err)
 	p := newSQLitePersistence(db)
 
-	ids, err := p.ExpiredMessagesIDs(messageResendMaxCount)
+	ids, err := p.ExpiredMessagesIDs()
 	require.NoError(t, err)
 	require.Empty(t, ids)
 
@@ -749,7 +749,7 @@ func TestExpiredMessagesIDs(t *testing.T) {
 	require.NoError(t, err)
 
 	//make sure it appered in expired emoji reactions list
-	ids, err = p.ExpiredMessagesIDs(messageResendMaxCount)
+	ids, err = p.ExpiredMessagesIDs()
 	require.NoError(t, err)
 	require.Equal(t, 1, len(ids))
 
@@ -760,9 +760,19 @@ func TestExpiredMessagesIDs(t *testing.T) {
 	require.NoError(t, err)
 
 	//make sure it didn't appear in expired emoji reactions list
-	ids, err = p.ExpiredMessagesIDs(messageResendMaxCount)
+	ids, err = p.ExpiredMessagesIDs()
 	require.NoError(t, err)
 	require.Equal(t, 1, len(ids))
+
+	//give up on the expired emoji reaction
+	rawEmojiReaction.Failed = true
+	err = p.SaveRawMessage(rawEmojiReaction)
+	require.NoError(t, err)
+
+	//make sure it's not in the outbox anymore
+	ids, err = p.ExpiredMessagesIDs()
+	require.NoError(t, err)
+	require.Empty(t, ids)
 }
 
 func TestPersistenceEmojiReactions(t *testing.T) {
@@ -1445,6 +1455,39 @@ func TestConfirmationsAtLeastOne(t *testing.T) {
 	require.Equal(t, types.HexBytes(messageID1), messageID)
 }
 
+func TestPendingConfirmationMessageIDs(t *testing.T) {
+	publicKey := []byte("pk-1")
+
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, p.InsertPendingConfirmation(&common.RawMessageConfirmation{
+			DataSyncID: []byte("datasync-id-" + strconv.Itoa(i)),
+			MessageID:  []byte("message-id-" + strconv.Itoa(i)),
+			PublicKey:  publicKey,
+		}))
+	}
+	require.NoError(t, p.InsertPendingConfirmation(&common.RawMessageConfirmation{
+		DataSyncID: []byte("datasync-id-other"),
+		MessageID:  []byte("message-id-other"),
+		PublicKey:  []byte("pk-2"),
+	}))
+
+	_, err = p.MarkAsConfirmed([]byte("datasync-id-3"), false)
+	require.NoError(t, err)
+
+	// Only the most recent unconfirmed messages are returned
+	ids, err := p.PendingConfirmationMessageIDs(publicKey, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{types.EncodeHex([]byte("message-id-2"))}, ids)
+
+	ids, err = p.PendingConfirmationMessageIDs(publicKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{types.EncodeHex([]byte("message-id-2")), types.EncodeHex([]byte("message-id-1"))}, ids)
+}
+
 func TestActivityCenterReadUnread(t *testing.T) {
 	nID1 := types.HexBytes([]byte("1"))
 	nID2 := types.HexBytes([]byte("2"))
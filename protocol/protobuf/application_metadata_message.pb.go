@@ -84,6 +84,12 @@ const (
 	ApplicationMetadataMessage_SYNC_SAVED_ADDRESS                      ApplicationMetadataMessage_Type = 59
 	ApplicationMetadataMessage_COMMUNITY_CANCEL_REQUEST_TO_JOIN        ApplicationMetadataMessage_Type = 60
 	ApplicationMetadataMessage_CANCEL_CONTACT_VERIFICATION             ApplicationMetadataMessage_Type = 61
+	ApplicationMetadataMessage_SYNC_CHAT_DRAFT                         ApplicationMetadataMessage_Type = 62
+	ApplicationMetadataMessage_FILE_CHUNK                              ApplicationMetadataMessage_Type = 63
+	ApplicationMetadataMessage_READ_RECEIPTS                           ApplicationMetadataMessage_Type = 64
+	ApplicationMetadataMessage_TYPING_INDICATOR                        ApplicationMetadataMessage_Type = 65
+	ApplicationMetadataMessage_INSTALLATION_REVOCATION                 ApplicationMetadataMessage_Type = 66
+	ApplicationMetadataMessage_SESSION_RESET                           ApplicationMetadataMessage_Type = 67
 )
 
 var ApplicationMetadataMessage_Type_name = map[int32]string{
@@ -148,6 +154,12 @@ var ApplicationMetadataMessage_Type_name = map[int32]string{
 	59: "SYNC_SAVED_ADDRESS",
 	60: "COMMUNITY_CANCEL_REQUEST_TO_JOIN",
 	61: "CANCEL_CONTACT_VERIFICATION",
+	62: "SYNC_CHAT_DRAFT",
+	63: "FILE_CHUNK",
+	64: "READ_RECEIPTS",
+	65: "TYPING_INDICATOR",
+	66: "INSTALLATION_REVOCATION",
+	67: "SESSION_RESET",
 }
 
 var ApplicationMetadataMessage_Type_value = map[string]int32{
@@ -212,6 +224,12 @@ var ApplicationMetadataMessage_Type_value = map[string]int32{
 	"SYNC_SAVED_ADDRESS":                      59,
 	"COMMUNITY_CANCEL_REQUEST_TO_JOIN":        60,
 	"CANCEL_CONTACT_VERIFICATION":             61,
+	"SYNC_CHAT_DRAFT":                         62,
+	"FILE_CHUNK":                              63,
+	"READ_RECEIPTS":                           64,
+	"TYPING_INDICATOR":                        65,
+	"INSTALLATION_REVOCATION":                 66,
+	"SESSION_RESET":                           67,
 }
 
 func (x ApplicationMetadataMessage_Type) String() string {
@@ -290,62 +308,66 @@ func init() {
 }
 
 var fileDescriptor_ad09a6406fcf24c7 = []byte{
-	// 908 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x5b, 0x73, 0x13, 0x37,
-	0x14, 0x6e, 0x20, 0x4d, 0x40, 0xb9, 0xa0, 0x88, 0x5c, 0x9c, 0xbb, 0x31, 0x34, 0x04, 0x68, 0x4d,
-	0x0b, 0x6d, 0xa7, 0x2d, 0xe5, 0x41, 0x96, 0x4e, 0x6c, 0xe1, 0x5d, 0x69, 0x91, 0xb4, 0x66, 0xdc,
-	0x17, 0xcd, 0x52, 0x5c, 0x26, 0x33, 0x40, 0x3c, 0xc4, 0x3c, 0xe4, 0x9f, 0xf6, 0x57, 0xf4, 0x37,
-	0x74, 0xb4, 0x57, 0x27, 0xd9, 0x90, 0xa7, 0x64, 0xcf, 0xf7, 0xe9, 0x48, 0xe7, 0x3b, 0xdf, 0x39,
-	0x46, 0xad, 0x64, 0x3c, 0xfe, 0x70, 0xfc, 0x77, 0x32, 0x39, 0x3e, 0xf9, 0xe4, 0x3e, 0x8e, 0x26,
-	0xc9, 0xbb, 0x64, 0x92, 0xb8, 0x8f, 0xa3, 0xd3, 0xd3, 0xe4, 0xfd, 0xa8, 0x3d, 0xfe, 0x7c, 0x32,
-	0x39, 0x21, 0xb7, 0xd2, 0x3f, 0x6f, 0xbf, 0xfc, 0xd3, 0xfa, 0x6f, 0x19, 0x6d, 0xd1, 0xea, 0x40,
-	0x98, 0xf3, 0xc3, 0x8c, 0x4e, 0x76, 0xd0, 0xed, 0xd3, 0xe3, 0xf7, 0x9f, 0x92, 0xc9, 0x97, 0xcf,
-	0xa3, 0xc6, 0x4c, 0x73, 0xe6, 0x70, 0x51, 0x57, 0x01, 0xd2, 0x40, 0xf3, 0xe3, 0xe4, 0xec, 0xc3,
-	0x49, 0xf2, 0xae, 0x71, 0x23, 0xc5, 0x8a, 0x4f, 0xf2, 0x12, 0xcd, 0x4e, 0xce, 0xc6, 0xa3, 0xc6,
-	0xcd, 0xe6, 0xcc, 0xe1, 0xf2, 0xb3, 0x47, 0xed, 0xe2, 0xbe, 0xf6, 0xd5, 0x77, 0xb5, 0xed, 0xd9,
-	0x78, 0xa4, 0xd3, 0x63, 0xad, 0x7f, 0x97, 0xd0, 0xac, 0xff, 0x24, 0x0b, 0x68, 0x3e, 0x96, 0x7d,
-	0xa9, 0xde, 0x48, 0xfc, 0x0d, 0xc1, 0x68, 0x91, 0xf5, 0xa8, 0x75, 0x21, 0x18, 0x43, 0xbb, 0x80,
-	0x67, 0x08, 0x41, 0xcb, 0x4c, 0x49, 0x4b, 0x99, 0x75, 0x71, 0xc4, 0xa9, 0x05, 0x7c, 0x83, 0xec,
-	0xa2, 0xcd, 0x10, 0xc2, 0x0e, 0x68, 0xd3, 0x13, 0x51, 0x1e, 0x2e, 0x8f, 0xdc, 0x24, 0x6b, 0x68,
-	0x25, 0xa2, 0x42, 0x3b, 0x21, 0x8d, 0xa5, 0x41, 0x40, 0xad, 0x50, 0x12, 0xcf, 0xfa, 0xb0, 0x19,
-	0x4a, 0x76, 0x3e, 0xfc, 0x2d, 0xb9, 0x8f, 0xf6, 0x35, 0xbc, 0x8e, 0xc1, 0x58, 0x47, 0x39, 0xd7,
-	0x60, 0x8c, 0x3b, 0x52, 0xda, 0x59, 0x4d, 0xa5, 0xa1, 0x2c, 0x25, 0xcd, 0x91, 0xc7, 0xe8, 0x80,
-	0x32, 0x06, 0x91, 0x75, 0xd7, 0x71, 0xe7, 0xc9, 0x13, 0xf4, 0x90, 0x03, 0x0b, 0x84, 0x84, 0x6b,
-	0xc9, 0xb7, 0xc8, 0x06, 0xba, 0x5b, 0x90, 0xa6, 0x81, 0xdb, 0x64, 0x15, 0x61, 0x03, 0x92, 0x9f,
-	0x8b, 0x22, 0xb2, 0x8f, 0xb6, 0x2f, 0xe6, 0x9e, 0x26, 0x2c, 0x78, 0x69, 0x2e, 0x15, 0xe9, 0x72,
-	0x01, 0xf1, 0x62, 0x3d, 0x4c, 0x19, 0x53, 0xb1, 0xb4, 0x78, 0x89, 0xdc, 0x43, 0xbb, 0x97, 0xe1,
-	0x28, 0xee, 0x04, 0x82, 0x39, 0xdf, 0x17, 0xbc, 0x4c, 0xf6, 0xd0, 0x56, 0xd1, 0x0f, 0xa6, 0x38,
-	0x38, 0xca, 0x07, 0xa0, 0xad, 0x30, 0x10, 0x82, 0xb4, 0xf8, 0x0e, 0x69, 0xa1, 0xbd, 0x28, 0x36,
-	0x3d, 0x27, 0x95, 0x15, 0x47, 0x82, 0x65, 0x29, 0x34, 0x74, 0x85, 0xb1, 0x3a, 0x93, 0x1c, 0x7b,
-	0x85, 0xbe, 0xce, 0x71, 0x1a, 0x4c, 0xa4, 0xa4, 0x01, 0xbc, 0x42, 0xb6, 0xd1, 0xc6, 0x65, 0xf2,
-	0xeb, 0x18, 0xf4, 0x10, 0x13, 0xf2, 0x00, 0x35, 0xaf, 0x00, 0xab, 0x14, 0x77, 0x7d, 0xd5, 0x75,
-	0xf7, 0xa5, 0xfa, 0xe1, 0x55, 0x5f, 0x52, 0x1d, 0x9c, 0x1f, 0x5f, 0xf3, 0x16, 0x84, 0x50, 0xbd,
-	0x12, 0x4e, 0x43, 0xae, 0xf3, 0x3a, 0xd9, 0x44, 0x6b, 0x5d, 0xad, 0xe2, 0x28, 0x95, 0xc5, 0x09,
-	0x39, 0x10, 0x36, 0xab, 0x6e, 0x83, 0xac, 0xa0, 0xa5, 0x2c, 0xc8, 0x41, 0x5a, 0x61, 0x87, 0xb8,
-	0xe1, 0xd9, 0x4c, 0x85, 0x61, 0x2c, 0x85, 0x1d, 0x3a, 0x0e, 0x86, 0x69, 0x11, 0xa5, 0xec, 0x4d,
-	0xd2, 0x40, 0xab, 0x15, 0x34, 0x95, 0x67, 0xcb, 0xbf, 0xba, 0x42, 0xca, 0x6e, 0x2b, 0xf7, 0x4a,
-	0x09, 0x89, 0xb7, 0xc9, 0x1d, 0xb4, 0x10, 0x09, 0x59, 0xda, 0x7e, 0xc7, 0xcf, 0x0e, 0x70, 0x51,
-	0xcd, 0xce, 0xae, 0x7f, 0x89, 0xb1, 0xd4, 0xc6, 0xa6, 0x18, 0x9d, 0x3d, 0x5f, 0x0b, 0x87, 0x00,
-	0xa6, 0xe6, 0x65, 0xdf, 0x9b, 0xaa, 0xce, 0x33, 0xf9, 0xd5, 0xb8, 0x49, 0xb6, 0xd0, 0x3a, 0x95,
-	0x4a, 0x0e, 0x43, 0x15, 0x1b, 0x17, 0x82, 0xd5, 0x82, 0xb9, 0x0e, 0xb5, 0xac, 0x87, 0xef, 0x95,
-	0x53, 0x95, 0x96, 0xac, 0x21, 0x54, 0x03, 0xe0, 0xb8, 0xe5, 0xbb, 0x56, 0x85, 0xf3, 0xab, 0x8c,
-	0x17, 0x90, 0xe3, 0xfb, 0x04, 0xa1, 0xb9, 0x0e, 0x65, 0xfd, 0x38, 0xc2, 0x0f, 0x4a, 0x47, 0x7a,
-	0x65, 0x07, 0xbe, 0x52, 0x06, 0xd2, 0x82, 0xce, 0xa8, 0xdf, 0x95, 0x8e, 0xbc, 0x08, 0x67, 0xd3,
-	0x08, 0x1c, 0x1f, 0x78, 0xc7, 0xd5, 0x52, 0xb8, 0x30, 0xa1, 0x30, 0x06, 0x38, 0x7e, 0x98, 0x2a,
-	0xe1, 0x39, 0x1d, 0xa5, 0xfa, 0x21, 0xd5, 0x7d, 0x7c, 0x48, 0xd6, 0x11, 0xc9, 0x5e, 0x18, 0x00,
-	0xd5, 0xae, 0x27, 0x8c, 0x55, 0x7a, 0x88, 0x1f, 0x79, 0x19, 0xd3, 0xb8, 0x01, 0x6b, 0x85, 0xec,
-	0xe2, 0xc7, 0xa4, 0x89, 0x76, 0xaa, 0x46, 0x50, 0xcd, 0x7a, 0x62, 0x00, 0x2e, 0xa4, 0x5d, 0x09,
-	0x36, 0x10, 0xb2, 0x8f, 0x9f, 0xf8, 0x26, 0xa6, 0x67, 0x22, 0xad, 0x8e, 0x44, 0x00, 0x2e, 0x12,
-	0xcc, 0xc6, 0x1a, 0xf0, 0xf7, 0x7e, 0xbe, 0x53, 0xe4, 0x0d, 0x0d, 0x02, 0xb0, 0xe5, 0xa8, 0xfd,
-	0x90, 0x6a, 0x9a, 0x6d, 0x94, 0x62, 0x9c, 0x0a, 0x43, 0xb6, 0xbd, 0x78, 0x1a, 0xac, 0xce, 0x66,
-	0xec, 0x3c, 0xf8, 0x94, 0x1c, 0xa0, 0xd6, 0x95, 0xb6, 0xa8, 0x5c, 0xfb, 0x63, 0xd5, 0x81, 0x92,
-	0x9c, 0x57, 0x64, 0xf0, 0x4f, 0xbe, 0xa4, 0xe2, 0x68, 0x71, 0xc3, 0x00, 0x74, 0xe9, 0x7e, 0xfc,
-	0xcc, 0x9b, 0xe2, 0xc2, 0xfb, 0xce, 0x11, 0x9e, 0xfb, 0x14, 0xc5, 0x2a, 0xaa, 0x65, 0xfc, 0x5c,
-	0x5a, 0xc3, 0xea, 0xd8, 0x58, 0xe0, 0x2e, 0x36, 0xa0, 0xf1, 0x2f, 0x65, 0xc7, 0xa7, 0xd9, 0x65,
-	0x7d, 0xbf, 0x96, 0x1d, 0xbf, 0x50, 0xb9, 0xe3, 0xc0, 0x84, 0xf1, 0x89, 0x7f, 0xcb, 0x76, 0x50,
-	0x8d, 0x04, 0x01, 0xd0, 0x01, 0xe0, 0xdf, 0x3d, 0x9e, 0xa6, 0xc8, 0x9d, 0xee, 0xb7, 0x6e, 0x58,
-	0x19, 0xfe, 0x8f, 0xb2, 0xf5, 0x86, 0x0e, 0x80, 0x17, 0xcb, 0x19, 0xbf, 0xf0, 0xdb, 0xa4, 0xca,
-	0xcb, 0xa8, 0x64, 0x10, 0x5c, 0x1a, 0xbc, 0x3f, 0xbd, 0x32, 0x39, 0x56, 0x5b, 0xf7, 0xcb, 0xce,
-	0xd2, 0x5f, 0x0b, 0xed, 0xa7, 0x2f, 0x8a, 0xdf, 0xc3, 0xb7, 0x73, 0xe9, 0x7f, 0xcf, 0xff, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0x84, 0x77, 0xdc, 0x48, 0xb6, 0x07, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x6b, 0x73, 0x13, 0x37,
+	0x14, 0x6d, 0x20, 0x4d, 0x40, 0x79, 0x29, 0xca, 0xcb, 0x79, 0x1b, 0x43, 0x43, 0x80, 0xd6, 0xb4,
+	0xd0, 0x76, 0xda, 0x52, 0xda, 0xca, 0xd2, 0x8d, 0x2d, 0xbc, 0x2b, 0x2d, 0x92, 0xd6, 0x8c, 0xfb,
+	0x45, 0xb3, 0x14, 0x97, 0xc9, 0x0c, 0x10, 0x0f, 0x31, 0x1f, 0xf2, 0x47, 0xfb, 0x2b, 0xfa, 0x23,
+	0x3a, 0xda, 0xa7, 0x9d, 0x38, 0xe5, 0x53, 0xb2, 0xf7, 0x1c, 0x5d, 0xe9, 0x9e, 0x7b, 0xee, 0x35,
+	0x6a, 0x24, 0xc3, 0xe1, 0xbb, 0xd3, 0xbf, 0x92, 0xd1, 0xe9, 0xd9, 0x07, 0xf7, 0x7e, 0x30, 0x4a,
+	0xde, 0x24, 0xa3, 0xc4, 0xbd, 0x1f, 0x9c, 0x9f, 0x27, 0x6f, 0x07, 0xcd, 0xe1, 0xc7, 0xb3, 0xd1,
+	0x19, 0xb9, 0x95, 0xfe, 0x79, 0xfd, 0xe9, 0xef, 0xc6, 0xbf, 0x2b, 0x68, 0x87, 0x56, 0x07, 0xc2,
+	0x9c, 0x1f, 0x66, 0x74, 0xb2, 0x87, 0x6e, 0x9f, 0x9f, 0xbe, 0xfd, 0x90, 0x8c, 0x3e, 0x7d, 0x1c,
+	0xd4, 0x66, 0xea, 0x33, 0xc7, 0x8b, 0xba, 0x0a, 0x90, 0x1a, 0x9a, 0x1f, 0x26, 0x17, 0xef, 0xce,
+	0x92, 0x37, 0xb5, 0x1b, 0x29, 0x56, 0x7c, 0x92, 0xe7, 0x68, 0x76, 0x74, 0x31, 0x1c, 0xd4, 0x6e,
+	0xd6, 0x67, 0x8e, 0x97, 0x9f, 0x3c, 0x68, 0x16, 0xf7, 0x35, 0xaf, 0xbf, 0xab, 0x69, 0x2f, 0x86,
+	0x03, 0x9d, 0x1e, 0x6b, 0xfc, 0xb3, 0x8c, 0x66, 0xfd, 0x27, 0x59, 0x40, 0xf3, 0xb1, 0xec, 0x4a,
+	0xf5, 0x4a, 0xe2, 0x2f, 0x08, 0x46, 0x8b, 0xac, 0x43, 0xad, 0x0b, 0xc1, 0x18, 0xda, 0x06, 0x3c,
+	0x43, 0x08, 0x5a, 0x66, 0x4a, 0x5a, 0xca, 0xac, 0x8b, 0x23, 0x4e, 0x2d, 0xe0, 0x1b, 0x64, 0x1f,
+	0x6d, 0x87, 0x10, 0xb6, 0x40, 0x9b, 0x8e, 0x88, 0xf2, 0x70, 0x79, 0xe4, 0x26, 0xd9, 0x40, 0xab,
+	0x11, 0x15, 0xda, 0x09, 0x69, 0x2c, 0x0d, 0x02, 0x6a, 0x85, 0x92, 0x78, 0xd6, 0x87, 0x4d, 0x5f,
+	0xb2, 0xc9, 0xf0, 0x97, 0xe4, 0x2e, 0x3a, 0xd4, 0xf0, 0x32, 0x06, 0x63, 0x1d, 0xe5, 0x5c, 0x83,
+	0x31, 0xee, 0x44, 0x69, 0x67, 0x35, 0x95, 0x86, 0xb2, 0x94, 0x34, 0x47, 0x1e, 0xa2, 0x23, 0xca,
+	0x18, 0x44, 0xd6, 0x7d, 0x8e, 0x3b, 0x4f, 0x1e, 0xa1, 0xfb, 0x1c, 0x58, 0x20, 0x24, 0x7c, 0x96,
+	0x7c, 0x8b, 0x6c, 0xa1, 0xb5, 0x82, 0x34, 0x0e, 0xdc, 0x26, 0xeb, 0x08, 0x1b, 0x90, 0x7c, 0x22,
+	0x8a, 0xc8, 0x21, 0xda, 0xbd, 0x9c, 0x7b, 0x9c, 0xb0, 0xe0, 0xa5, 0xb9, 0x52, 0xa4, 0xcb, 0x05,
+	0xc4, 0x8b, 0xd3, 0x61, 0xca, 0x98, 0x8a, 0xa5, 0xc5, 0x4b, 0xe4, 0x0e, 0xda, 0xbf, 0x0a, 0x47,
+	0x71, 0x2b, 0x10, 0xcc, 0xf9, 0xbe, 0xe0, 0x65, 0x72, 0x80, 0x76, 0x8a, 0x7e, 0x30, 0xc5, 0xc1,
+	0x51, 0xde, 0x03, 0x6d, 0x85, 0x81, 0x10, 0xa4, 0xc5, 0x2b, 0xa4, 0x81, 0x0e, 0xa2, 0xd8, 0x74,
+	0x9c, 0x54, 0x56, 0x9c, 0x08, 0x96, 0xa5, 0xd0, 0xd0, 0x16, 0xc6, 0xea, 0x4c, 0x72, 0xec, 0x15,
+	0xfa, 0x7f, 0x8e, 0xd3, 0x60, 0x22, 0x25, 0x0d, 0xe0, 0x55, 0xb2, 0x8b, 0xb6, 0xae, 0x92, 0x5f,
+	0xc6, 0xa0, 0xfb, 0x98, 0x90, 0x7b, 0xa8, 0x7e, 0x0d, 0x58, 0xa5, 0x58, 0xf3, 0x55, 0x4f, 0xbb,
+	0x2f, 0xd5, 0x0f, 0xaf, 0xfb, 0x92, 0xa6, 0xc1, 0xf9, 0xf1, 0x0d, 0x6f, 0x41, 0x08, 0xd5, 0x0b,
+	0xe1, 0x34, 0xe4, 0x3a, 0x6f, 0x92, 0x6d, 0xb4, 0xd1, 0xd6, 0x2a, 0x8e, 0x52, 0x59, 0x9c, 0x90,
+	0x3d, 0x61, 0xb3, 0xea, 0xb6, 0xc8, 0x2a, 0x5a, 0xca, 0x82, 0x1c, 0xa4, 0x15, 0xb6, 0x8f, 0x6b,
+	0x9e, 0xcd, 0x54, 0x18, 0xc6, 0x52, 0xd8, 0xbe, 0xe3, 0x60, 0x98, 0x16, 0x51, 0xca, 0xde, 0x26,
+	0x35, 0xb4, 0x5e, 0x41, 0x63, 0x79, 0x76, 0xfc, 0xab, 0x2b, 0xa4, 0xec, 0xb6, 0x72, 0x2f, 0x94,
+	0x90, 0x78, 0x97, 0xac, 0xa0, 0x85, 0x48, 0xc8, 0xd2, 0xf6, 0x7b, 0x7e, 0x76, 0x80, 0x8b, 0x6a,
+	0x76, 0xf6, 0xfd, 0x4b, 0x8c, 0xa5, 0x36, 0x36, 0xc5, 0xe8, 0x1c, 0xf8, 0x5a, 0x38, 0x04, 0x30,
+	0x36, 0x2f, 0x87, 0xde, 0x54, 0xd3, 0x3c, 0x93, 0x5f, 0x8d, 0xeb, 0x64, 0x07, 0x6d, 0x52, 0xa9,
+	0x64, 0x3f, 0x54, 0xb1, 0x71, 0x21, 0x58, 0x2d, 0x98, 0x6b, 0x51, 0xcb, 0x3a, 0xf8, 0x4e, 0x39,
+	0x55, 0x69, 0xc9, 0x1a, 0x42, 0xd5, 0x03, 0x8e, 0x1b, 0xbe, 0x6b, 0x55, 0x38, 0xbf, 0xca, 0x78,
+	0x01, 0x39, 0xbe, 0x4b, 0x10, 0x9a, 0x6b, 0x51, 0xd6, 0x8d, 0x23, 0x7c, 0xaf, 0x74, 0xa4, 0x57,
+	0xb6, 0xe7, 0x2b, 0x65, 0x20, 0x2d, 0xe8, 0x8c, 0xfa, 0x55, 0xe9, 0xc8, 0xcb, 0x70, 0x36, 0x8d,
+	0xc0, 0xf1, 0x91, 0x77, 0xdc, 0x54, 0x0a, 0x17, 0x26, 0x14, 0xc6, 0x00, 0xc7, 0xf7, 0x53, 0x25,
+	0x3c, 0xa7, 0xa5, 0x54, 0x37, 0xa4, 0xba, 0x8b, 0x8f, 0xc9, 0x26, 0x22, 0xd9, 0x0b, 0x03, 0xa0,
+	0xda, 0x75, 0x84, 0xb1, 0x4a, 0xf7, 0xf1, 0x03, 0x2f, 0x63, 0x1a, 0x37, 0x60, 0xad, 0x90, 0x6d,
+	0xfc, 0x90, 0xd4, 0xd1, 0x5e, 0xd5, 0x08, 0xaa, 0x59, 0x47, 0xf4, 0xc0, 0x85, 0xb4, 0x2d, 0xc1,
+	0x06, 0x42, 0x76, 0xf1, 0x23, 0xdf, 0xc4, 0xf4, 0x4c, 0xa4, 0xd5, 0x89, 0x08, 0xc0, 0x45, 0x82,
+	0xd9, 0x58, 0x03, 0xfe, 0xda, 0xcf, 0x77, 0x8a, 0xbc, 0xa2, 0x41, 0x00, 0xb6, 0x1c, 0xb5, 0x6f,
+	0x52, 0x4d, 0xb3, 0x8d, 0x52, 0x8c, 0x53, 0x61, 0xc8, 0xa6, 0x17, 0x4f, 0x83, 0xd5, 0xd9, 0x8c,
+	0x4d, 0x82, 0x8f, 0xc9, 0x11, 0x6a, 0x5c, 0x6b, 0x8b, 0xca, 0xb5, 0xdf, 0x56, 0x1d, 0x28, 0xc9,
+	0x79, 0x45, 0x06, 0x7f, 0xe7, 0x4b, 0x2a, 0x8e, 0x16, 0x37, 0xf4, 0x40, 0x97, 0xee, 0xc7, 0x4f,
+	0xbc, 0x29, 0x2e, 0xbd, 0x6f, 0x82, 0xf0, 0xd4, 0xa7, 0x28, 0x56, 0xd1, 0x54, 0xc6, 0xf7, 0xa5,
+	0x35, 0xac, 0x8e, 0x8d, 0x05, 0xee, 0x62, 0x03, 0x1a, 0xff, 0x50, 0x76, 0x7c, 0x9c, 0x5d, 0xd6,
+	0xf7, 0x63, 0xd9, 0xf1, 0x4b, 0x95, 0x3b, 0x0e, 0x4c, 0x18, 0x9f, 0xf8, 0xa7, 0x6c, 0x07, 0x4d,
+	0x91, 0x20, 0x00, 0xda, 0x03, 0xfc, 0xb3, 0xc7, 0xd3, 0x14, 0xb9, 0xd3, 0xfd, 0xd6, 0x0d, 0x2b,
+	0xc3, 0xff, 0x52, 0xb6, 0xde, 0xd0, 0x1e, 0xf0, 0x62, 0x39, 0xe3, 0x67, 0x7e, 0x9b, 0x54, 0x79,
+	0x19, 0x95, 0x0c, 0x82, 0x2b, 0x83, 0xf7, 0xab, 0x57, 0x26, 0xc7, 0xa6, 0xd6, 0xfd, 0x9c, 0xac,
+	0xa1, 0x95, 0xca, 0xfb, 0x5c, 0xd3, 0x13, 0x8b, 0x7f, 0x23, 0xcb, 0x08, 0xa5, 0xd6, 0x60, 0x9d,
+	0x58, 0x76, 0xf1, 0xef, 0xde, 0x91, 0xde, 0xe2, 0x4e, 0x03, 0x03, 0x11, 0x59, 0x83, 0xff, 0xf0,
+	0x2b, 0xdf, 0xf6, 0x23, 0x21, 0xdb, 0x4e, 0x48, 0xee, 0xd3, 0x29, 0x8d, 0xa9, 0xef, 0xe3, 0xc4,
+	0x60, 0x6a, 0xe8, 0xa9, 0xfc, 0xaa, 0x56, 0xea, 0x6b, 0x30, 0x26, 0x5f, 0x58, 0x60, 0x31, 0x6b,
+	0x2d, 0xfd, 0xb9, 0xd0, 0x7c, 0xfc, 0xac, 0xf8, 0x35, 0x7e, 0x3d, 0x97, 0xfe, 0xf7, 0xf4, 0xbf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0xd5, 0x22, 0x19, 0x34, 0x08, 0x00, 0x00,
 }
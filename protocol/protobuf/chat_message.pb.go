@@ -67,6 +67,10 @@ const (
 	ChatMessage_CONTACT_REQUEST       ChatMessage_ContentType = 11
 	ChatMessage_DISCORD_MESSAGE       ChatMessage_ContentType = 12
 	ChatMessage_IDENTITY_VERIFICATION ChatMessage_ContentType = 13
+	ChatMessage_FILE                  ChatMessage_ContentType = 14
+	ChatMessage_VIDEO                 ChatMessage_ContentType = 15
+	// Only local
+	ChatMessage_SYSTEM_MESSAGE_SESSION_RESET ChatMessage_ContentType = 16
 )
 
 var ChatMessage_ContentType_name = map[int32]string{
@@ -84,6 +88,9 @@ var ChatMessage_ContentType_name = map[int32]string{
 	11: "CONTACT_REQUEST",
 	12: "DISCORD_MESSAGE",
 	13: "IDENTITY_VERIFICATION",
+	14: "FILE",
+	15: "VIDEO",
+	16: "SYSTEM_MESSAGE_SESSION_RESET",
 }
 
 var ChatMessage_ContentType_value = map[string]int32{
@@ -101,6 +108,9 @@ var ChatMessage_ContentType_value = map[string]int32{
 	"CONTACT_REQUEST":                      11,
 	"DISCORD_MESSAGE":                      12,
 	"IDENTITY_VERIFICATION":                13,
+	"FILE":                                 14,
+	"VIDEO":                                15,
+	"SYSTEM_MESSAGE_SESSION_RESET":         16,
 }
 
 func (x ChatMessage_ContentType) String() string {
@@ -108,7 +118,7 @@ func (x ChatMessage_ContentType) String() string {
 }
 
 func (ChatMessage_ContentType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{10, 0}
+	return fileDescriptor_263952f55fd35689, []int{13, 0}
 }
 
 type StickerMessage struct {
@@ -260,6 +270,268 @@ func (m *AudioMessage) GetDurationMs() uint64 {
 	return 0
 }
 
+type FileMessage struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size     uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// sha256 of the whole file, used to verify the reassembled payload
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of FileChunk messages carrying the payload, 0 when inlined
+	ChunksCount uint32 `protobuf:"varint,5,opt,name=chunks_count,json=chunksCount,proto3" json:"chunks_count,omitempty"`
+	// Inlined payload, only set when the file fits in a single message
+	Payload []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// sha256 of each chunk, so that a corrupted chunk can be told apart from the others
+	ChunkHashes          [][]byte `protobuf:"bytes,7,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileMessage) Reset()         { *m = FileMessage{} }
+func (m *FileMessage) String() string { return proto.CompactTextString(m) }
+func (*FileMessage) ProtoMessage()    {}
+func (*FileMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{3}
+}
+
+func (m *FileMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileMessage.Unmarshal(m, b)
+}
+func (m *FileMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileMessage.Marshal(b, m, deterministic)
+}
+func (m *FileMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileMessage.Merge(m, src)
+}
+func (m *FileMessage) XXX_Size() int {
+	return xxx_messageInfo_FileMessage.Size(m)
+}
+func (m *FileMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileMessage proto.InternalMessageInfo
+
+func (m *FileMessage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FileMessage) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *FileMessage) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FileMessage) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *FileMessage) GetChunksCount() uint32 {
+	if m != nil {
+		return m.ChunksCount
+	}
+	return 0
+}
+
+func (m *FileMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FileMessage) GetChunkHashes() [][]byte {
+	if m != nil {
+		return m.ChunkHashes
+	}
+	return nil
+}
+
+type VideoMessage struct {
+	// The video itself, either inlined or sent in chunks
+	File *FileMessage `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Poster image displayed before the video is played
+	Thumbnail            []byte    `protobuf:"bytes,2,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	ThumbnailType        ImageType `protobuf:"varint,3,opt,name=thumbnail_type,json=thumbnailType,proto3,enum=protobuf.ImageType" json:"thumbnail_type,omitempty"`
+	DurationMs           uint64    `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Width                uint32    `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height               uint32    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *VideoMessage) Reset()         { *m = VideoMessage{} }
+func (m *VideoMessage) String() string { return proto.CompactTextString(m) }
+func (*VideoMessage) ProtoMessage()    {}
+func (*VideoMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{4}
+}
+
+func (m *VideoMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoMessage.Unmarshal(m, b)
+}
+func (m *VideoMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoMessage.Marshal(b, m, deterministic)
+}
+func (m *VideoMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoMessage.Merge(m, src)
+}
+func (m *VideoMessage) XXX_Size() int {
+	return xxx_messageInfo_VideoMessage.Size(m)
+}
+func (m *VideoMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoMessage proto.InternalMessageInfo
+
+func (m *VideoMessage) GetFile() *FileMessage {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *VideoMessage) GetThumbnail() []byte {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+func (m *VideoMessage) GetThumbnailType() ImageType {
+	if m != nil {
+		return m.ThumbnailType
+	}
+	return ImageType_UNKNOWN_IMAGE_TYPE
+}
+
+func (m *VideoMessage) GetDurationMs() uint64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *VideoMessage) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *VideoMessage) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type FileChunk struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// sha256 of the whole file the chunk belongs to
+	FileHash []byte `protobuf:"bytes,3,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	Index    uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// Informative only, the number of chunks is taken from the message
+	ChunksCount uint32 `protobuf:"varint,5,opt,name=chunks_count,json=chunksCount,proto3" json:"chunks_count,omitempty"`
+	Payload     []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// ID of the message describing the file
+	MessageId            string   `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileChunk) Reset()         { *m = FileChunk{} }
+func (m *FileChunk) String() string { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()    {}
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{5}
+}
+
+func (m *FileChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChunk.Unmarshal(m, b)
+}
+func (m *FileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChunk.Marshal(b, m, deterministic)
+}
+func (m *FileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChunk.Merge(m, src)
+}
+func (m *FileChunk) XXX_Size() int {
+	return xxx_messageInfo_FileChunk.Size(m)
+}
+func (m *FileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChunk proto.InternalMessageInfo
+
+func (m *FileChunk) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *FileChunk) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *FileChunk) GetFileHash() []byte {
+	if m != nil {
+		return m.FileHash
+	}
+	return nil
+}
+
+func (m *FileChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *FileChunk) GetChunksCount() uint32 {
+	if m != nil {
+		return m.ChunksCount
+	}
+	return 0
+}
+
+func (m *FileChunk) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FileChunk) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
 type EditMessage struct {
 	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	// Text of the message
@@ -280,7 +552,7 @@ func (m *EditMessage) Reset()         { *m = EditMessage{} }
 func (m *EditMessage) String() string { return proto.CompactTextString(m) }
 func (*EditMessage) ProtoMessage()    {}
 func (*EditMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{3}
+	return fileDescriptor_263952f55fd35689, []int{6}
 }
 
 func (m *EditMessage) XXX_Unmarshal(b []byte) error {
@@ -367,7 +639,7 @@ func (m *DeleteMessage) Reset()         { *m = DeleteMessage{} }
 func (m *DeleteMessage) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessage) ProtoMessage()    {}
 func (*DeleteMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{4}
+	return fileDescriptor_263952f55fd35689, []int{7}
 }
 
 func (m *DeleteMessage) XXX_Unmarshal(b []byte) error {
@@ -435,7 +707,7 @@ func (m *DeleteForMeMessage) Reset()         { *m = DeleteForMeMessage{} }
 func (m *DeleteForMeMessage) String() string { return proto.CompactTextString(m) }
 func (*DeleteForMeMessage) ProtoMessage()    {}
 func (*DeleteForMeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{5}
+	return fileDescriptor_263952f55fd35689, []int{8}
 }
 
 func (m *DeleteForMeMessage) XXX_Unmarshal(b []byte) error {
@@ -488,7 +760,7 @@ func (m *DiscordMessage) Reset()         { *m = DiscordMessage{} }
 func (m *DiscordMessage) String() string { return proto.CompactTextString(m) }
 func (*DiscordMessage) ProtoMessage()    {}
 func (*DiscordMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{6}
+	return fileDescriptor_263952f55fd35689, []int{9}
 }
 
 func (m *DiscordMessage) XXX_Unmarshal(b []byte) error {
@@ -582,7 +854,7 @@ func (m *DiscordMessageAuthor) Reset()         { *m = DiscordMessageAuthor{} }
 func (m *DiscordMessageAuthor) String() string { return proto.CompactTextString(m) }
 func (*DiscordMessageAuthor) ProtoMessage()    {}
 func (*DiscordMessageAuthor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{7}
+	return fileDescriptor_263952f55fd35689, []int{10}
 }
 
 func (m *DiscordMessageAuthor) XXX_Unmarshal(b []byte) error {
@@ -665,7 +937,7 @@ func (m *DiscordMessageReference) Reset()         { *m = DiscordMessageReference
 func (m *DiscordMessageReference) String() string { return proto.CompactTextString(m) }
 func (*DiscordMessageReference) ProtoMessage()    {}
 func (*DiscordMessageReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{8}
+	return fileDescriptor_263952f55fd35689, []int{11}
 }
 
 func (m *DiscordMessageReference) XXX_Unmarshal(b []byte) error {
@@ -725,7 +997,7 @@ func (m *DiscordMessageAttachment) Reset()         { *m = DiscordMessageAttachme
 func (m *DiscordMessageAttachment) String() string { return proto.CompactTextString(m) }
 func (*DiscordMessageAttachment) ProtoMessage()    {}
 func (*DiscordMessageAttachment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{9}
+	return fileDescriptor_263952f55fd35689, []int{12}
 }
 
 func (m *DiscordMessageAttachment) XXX_Unmarshal(b []byte) error {
@@ -829,6 +1101,8 @@ type ChatMessage struct {
 	//	*ChatMessage_Image
 	//	*ChatMessage_Audio
 	//	*ChatMessage_Community
+	//	*ChatMessage_File
+	//	*ChatMessage_Video
 	//	*ChatMessage_DiscordMessage
 	Payload isChatMessage_Payload `protobuf_oneof:"payload"`
 	// Grant for community chat messages
@@ -847,7 +1121,7 @@ func (m *ChatMessage) Reset()         { *m = ChatMessage{} }
 func (m *ChatMessage) String() string { return proto.CompactTextString(m) }
 func (*ChatMessage) ProtoMessage()    {}
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{10}
+	return fileDescriptor_263952f55fd35689, []int{13}
 }
 
 func (m *ChatMessage) XXX_Unmarshal(b []byte) error {
@@ -944,6 +1218,14 @@ type ChatMessage_Community struct {
 	Community []byte `protobuf:"bytes,12,opt,name=community,proto3,oneof"`
 }
 
+type ChatMessage_File struct {
+	File *FileMessage `protobuf:"bytes,18,opt,name=file,proto3,oneof"`
+}
+
+type ChatMessage_Video struct {
+	Video *VideoMessage `protobuf:"bytes,19,opt,name=video,proto3,oneof"`
+}
+
 type ChatMessage_DiscordMessage struct {
 	DiscordMessage *DiscordMessage `protobuf:"bytes,99,opt,name=discord_message,json=discordMessage,proto3,oneof"`
 }
@@ -956,6 +1238,10 @@ func (*ChatMessage_Audio) isChatMessage_Payload() {}
 
 func (*ChatMessage_Community) isChatMessage_Payload() {}
 
+func (*ChatMessage_File) isChatMessage_Payload() {}
+
+func (*ChatMessage_Video) isChatMessage_Payload() {}
+
 func (*ChatMessage_DiscordMessage) isChatMessage_Payload() {}
 
 func (m *ChatMessage) GetPayload() isChatMessage_Payload {
@@ -993,6 +1279,20 @@ func (m *ChatMessage) GetCommunity() []byte {
 	return nil
 }
 
+func (m *ChatMessage) GetFile() *FileMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetVideo() *VideoMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Video); ok {
+		return x.Video
+	}
+	return nil
+}
+
 func (m *ChatMessage) GetDiscordMessage() *DiscordMessage {
 	if x, ok := m.GetPayload().(*ChatMessage_DiscordMessage); ok {
 		return x.DiscordMessage
@@ -1042,10 +1342,132 @@ func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 		(*ChatMessage_Image)(nil),
 		(*ChatMessage_Audio)(nil),
 		(*ChatMessage_Community)(nil),
+		(*ChatMessage_File)(nil),
+		(*ChatMessage_Video)(nil),
 		(*ChatMessage_DiscordMessage)(nil),
 	}
 }
 
+// A pending attachment of an unsent message, kept as part of a chat draft
+type ChatDraftAttachment struct {
+	// Types that are valid to be assigned to Payload:
+	//	*ChatDraftAttachment_Image
+	//	*ChatDraftAttachment_Audio
+	Payload              isChatDraftAttachment_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ChatDraftAttachment) Reset()         { *m = ChatDraftAttachment{} }
+func (m *ChatDraftAttachment) String() string { return proto.CompactTextString(m) }
+func (*ChatDraftAttachment) ProtoMessage()    {}
+func (*ChatDraftAttachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{14}
+}
+
+func (m *ChatDraftAttachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatDraftAttachment.Unmarshal(m, b)
+}
+func (m *ChatDraftAttachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatDraftAttachment.Marshal(b, m, deterministic)
+}
+func (m *ChatDraftAttachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatDraftAttachment.Merge(m, src)
+}
+func (m *ChatDraftAttachment) XXX_Size() int {
+	return xxx_messageInfo_ChatDraftAttachment.Size(m)
+}
+func (m *ChatDraftAttachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatDraftAttachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatDraftAttachment proto.InternalMessageInfo
+
+type isChatDraftAttachment_Payload interface {
+	isChatDraftAttachment_Payload()
+}
+
+type ChatDraftAttachment_Image struct {
+	Image *ImageMessage `protobuf:"bytes,1,opt,name=image,proto3,oneof"`
+}
+
+type ChatDraftAttachment_Audio struct {
+	Audio *AudioMessage `protobuf:"bytes,2,opt,name=audio,proto3,oneof"`
+}
+
+func (*ChatDraftAttachment_Image) isChatDraftAttachment_Payload() {}
+
+func (*ChatDraftAttachment_Audio) isChatDraftAttachment_Payload() {}
+
+func (m *ChatDraftAttachment) GetPayload() isChatDraftAttachment_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ChatDraftAttachment) GetImage() *ImageMessage {
+	if x, ok := m.GetPayload().(*ChatDraftAttachment_Image); ok {
+		return x.Image
+	}
+	return nil
+}
+
+func (m *ChatDraftAttachment) GetAudio() *AudioMessage {
+	if x, ok := m.GetPayload().(*ChatDraftAttachment_Audio); ok {
+		return x.Audio
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ChatDraftAttachment) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ChatDraftAttachment_Image)(nil),
+		(*ChatDraftAttachment_Audio)(nil),
+	}
+}
+
+type ChatDraftAttachments struct {
+	Attachments          []*ChatDraftAttachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ChatDraftAttachments) Reset()         { *m = ChatDraftAttachments{} }
+func (m *ChatDraftAttachments) String() string { return proto.CompactTextString(m) }
+func (*ChatDraftAttachments) ProtoMessage()    {}
+func (*ChatDraftAttachments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{15}
+}
+
+func (m *ChatDraftAttachments) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatDraftAttachments.Unmarshal(m, b)
+}
+func (m *ChatDraftAttachments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatDraftAttachments.Marshal(b, m, deterministic)
+}
+func (m *ChatDraftAttachments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatDraftAttachments.Merge(m, src)
+}
+func (m *ChatDraftAttachments) XXX_Size() int {
+	return xxx_messageInfo_ChatDraftAttachments.Size(m)
+}
+func (m *ChatDraftAttachments) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatDraftAttachments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatDraftAttachments proto.InternalMessageInfo
+
+func (m *ChatDraftAttachments) GetAttachments() []*ChatDraftAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
 type ContactRequestSignature struct {
 	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	Timestamp            uint64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -1058,7 +1480,7 @@ func (m *ContactRequestSignature) Reset()         { *m = ContactRequestSignature
 func (m *ContactRequestSignature) String() string { return proto.CompactTextString(m) }
 func (*ContactRequestSignature) ProtoMessage()    {}
 func (*ContactRequestSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{11}
+	return fileDescriptor_263952f55fd35689, []int{16}
 }
 
 func (m *ContactRequestSignature) XXX_Unmarshal(b []byte) error {
@@ -1099,6 +1521,9 @@ func init() {
 	proto.RegisterType((*StickerMessage)(nil), "protobuf.StickerMessage")
 	proto.RegisterType((*ImageMessage)(nil), "protobuf.ImageMessage")
 	proto.RegisterType((*AudioMessage)(nil), "protobuf.AudioMessage")
+	proto.RegisterType((*FileMessage)(nil), "protobuf.FileMessage")
+	proto.RegisterType((*VideoMessage)(nil), "protobuf.VideoMessage")
+	proto.RegisterType((*FileChunk)(nil), "protobuf.FileChunk")
 	proto.RegisterType((*EditMessage)(nil), "protobuf.EditMessage")
 	proto.RegisterType((*DeleteMessage)(nil), "protobuf.DeleteMessage")
 	proto.RegisterType((*DeleteForMeMessage)(nil), "protobuf.DeleteForMeMessage")
@@ -1107,6 +1532,8 @@ func init() {
 	proto.RegisterType((*DiscordMessageReference)(nil), "protobuf.DiscordMessageReference")
 	proto.RegisterType((*DiscordMessageAttachment)(nil), "protobuf.DiscordMessageAttachment")
 	proto.RegisterType((*ChatMessage)(nil), "protobuf.ChatMessage")
+	proto.RegisterType((*ChatDraftAttachment)(nil), "protobuf.ChatDraftAttachment")
+	proto.RegisterType((*ChatDraftAttachments)(nil), "protobuf.ChatDraftAttachments")
 	proto.RegisterType((*ContactRequestSignature)(nil), "protobuf.ContactRequestSignature")
 }
 
@@ -1115,83 +1542,104 @@ func init() {
 }
 
 var fileDescriptor_263952f55fd35689 = []byte{
-	// 1245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0xb6, 0xee, 0xe2, 0xa1, 0x2c, 0xf3, 0x9f, 0x5c, 0xcc, 0xe4, 0x4f, 0x13, 0x85, 0x08, 0x10,
-	0xad, 0x54, 0x20, 0x4d, 0x8b, 0x00, 0x5d, 0x14, 0x8c, 0xc4, 0x38, 0x6c, 0x2a, 0xc9, 0x19, 0x51,
-	0x69, 0xdd, 0x0d, 0x31, 0x21, 0xc7, 0x16, 0x61, 0x89, 0x54, 0xc9, 0x51, 0x50, 0x77, 0xdf, 0x65,
-	0xdf, 0xa1, 0x2f, 0xd0, 0x6d, 0xdf, 0xa4, 0xaf, 0xd0, 0x27, 0xe8, 0x03, 0x14, 0x33, 0xe4, 0x88,
-	0xa4, 0x1a, 0x39, 0x41, 0x56, 0x9c, 0x73, 0x78, 0xae, 0xdf, 0xb9, 0xcc, 0x00, 0xf2, 0x16, 0x84,
-	0xb9, 0x2b, 0x9a, 0x24, 0xe4, 0x82, 0x0e, 0xd6, 0x71, 0xc4, 0x22, 0xd4, 0x16, 0x9f, 0xb7, 0x9b,
-	0xf3, 0xbb, 0x2a, 0x0d, 0x37, 0xab, 0x24, 0x65, 0x1b, 0xcf, 0xa0, 0x3b, 0x63, 0x81, 0x77, 0x49,
-	0xe3, 0x71, 0x2a, 0x8e, 0x10, 0xd4, 0x17, 0x24, 0x59, 0xe8, 0x95, 0x5e, 0xa5, 0xaf, 0x60, 0x71,
-	0xe6, 0xbc, 0x35, 0xf1, 0x2e, 0xf5, 0x6a, 0xaf, 0xd2, 0x6f, 0x60, 0x71, 0x36, 0x5e, 0x43, 0xc7,
-	0x5e, 0x91, 0x0b, 0x2a, 0xf5, 0x74, 0x68, 0xad, 0xc9, 0xd5, 0x32, 0x22, 0xbe, 0x50, 0xed, 0x60,
-	0x49, 0xa2, 0xc7, 0x50, 0x67, 0x57, 0x6b, 0x2a, 0xb4, 0xbb, 0x4f, 0x6e, 0x0c, 0x64, 0x24, 0x03,
-	0xa1, 0xef, 0x5c, 0xad, 0x29, 0x16, 0x02, 0xc6, 0x9f, 0x15, 0xe8, 0x98, 0x1b, 0x3f, 0x88, 0x3e,
-	0x6c, 0xf3, 0x69, 0xc9, 0x66, 0x2f, 0xb7, 0x59, 0xd4, 0x4f, 0x89, 0xdc, 0x01, 0x7a, 0x00, 0xaa,
-	0xbf, 0x89, 0x09, 0x0b, 0xa2, 0xd0, 0x5d, 0x25, 0x7a, 0xad, 0x57, 0xe9, 0xd7, 0x31, 0x48, 0xd6,
-	0x38, 0x31, 0xbe, 0x04, 0x65, 0xab, 0x83, 0x6e, 0x03, 0x9a, 0x4f, 0x5e, 0x4d, 0xa6, 0xdf, 0x4f,
-	0x5c, 0x73, 0x3e, 0xb2, 0xa7, 0xae, 0x73, 0x76, 0x6a, 0x69, 0x07, 0xa8, 0x05, 0x35, 0xd3, 0x1c,
-	0x6a, 0x15, 0x71, 0x18, 0x63, 0xad, 0x6a, 0xfc, 0x5a, 0x05, 0xd5, 0xf2, 0x03, 0x26, 0xe3, 0xbe,
-	0x09, 0x0d, 0x6f, 0x19, 0x79, 0x97, 0x22, 0xea, 0x3a, 0x4e, 0x09, 0x8e, 0x22, 0xa3, 0x3f, 0x33,
-	0x11, 0xb3, 0x82, 0xc5, 0x19, 0x1d, 0x43, 0x4b, 0x14, 0x2b, 0xf0, 0x45, 0x34, 0x0a, 0x6e, 0x72,
-	0xd2, 0xf6, 0xd1, 0x67, 0x00, 0x59, 0x01, 0xf9, 0xbf, 0xba, 0xf8, 0xa7, 0x64, 0x1c, 0xdb, 0xe7,
-	0x1e, 0x2e, 0x62, 0x12, 0x32, 0xbd, 0x21, 0x70, 0x49, 0x09, 0xf4, 0x0c, 0x3a, 0x52, 0x49, 0xa0,
-	0xd3, 0x14, 0xe8, 0xdc, 0xca, 0xd1, 0xc9, 0x02, 0x14, 0x90, 0xa8, 0xab, 0x9c, 0x40, 0x23, 0xe8,
-	0x78, 0x51, 0xc8, 0x68, 0xc8, 0x52, 0xcd, 0x96, 0xd0, 0x7c, 0x98, 0x6b, 0x0e, 0x17, 0x44, 0xa6,
-	0x37, 0x18, 0xa6, 0x92, 0xa9, 0x15, 0x2f, 0x27, 0x8c, 0x3f, 0x2a, 0x70, 0x38, 0xa2, 0x4b, 0xca,
-	0xe8, 0xf5, 0x48, 0x14, 0xb2, 0xae, 0x5e, 0x93, 0x75, 0x6d, 0x6f, 0xd6, 0xf5, 0xeb, 0xb2, 0x6e,
-	0x7c, 0x6c, 0xd6, 0x86, 0x0d, 0x28, 0x0d, 0xf7, 0x45, 0x14, 0x8f, 0x3f, 0x10, 0x73, 0x39, 0xb4,
-	0xea, 0x4e, 0x68, 0xc6, 0x5f, 0x55, 0xe8, 0x8e, 0x82, 0xc4, 0x8b, 0x62, 0x5f, 0xda, 0xe9, 0x42,
-	0x35, 0xf0, 0xb3, 0x39, 0xaa, 0x06, 0xbe, 0xa8, 0xbf, 0xec, 0x59, 0x25, 0xeb, 0xc8, 0x7b, 0xa0,
-	0xb0, 0x60, 0x45, 0x13, 0x46, 0x56, 0x6b, 0x99, 0xef, 0x96, 0x81, 0xfa, 0x70, 0xb4, 0x25, 0x78,
-	0x7f, 0x51, 0xd9, 0x09, 0xbb, 0x6c, 0x3e, 0x29, 0x59, 0x21, 0x44, 0xfa, 0x0a, 0x96, 0x24, 0xfa,
-	0x0a, 0x9a, 0x64, 0xc3, 0x16, 0x51, 0x2c, 0xba, 0x41, 0x7d, 0x72, 0x3f, 0xc7, 0xa5, 0x1c, 0xaf,
-	0x29, 0xa4, 0x70, 0x26, 0x8d, 0xbe, 0x01, 0x25, 0xa6, 0xe7, 0x34, 0xa6, 0xa1, 0x97, 0xb6, 0x83,
-	0x5a, 0x6c, 0x87, 0xb2, 0x2a, 0x96, 0x82, 0x38, 0xd7, 0x41, 0x23, 0x50, 0x09, 0x63, 0xc4, 0x5b,
-	0xac, 0x68, 0xc8, 0x12, 0xbd, 0xdd, 0xab, 0xf5, 0xd5, 0x27, 0xc6, 0x5e, 0xef, 0x5b, 0x51, 0x5c,
-	0x54, 0x33, 0xfe, 0xae, 0xc0, 0xcd, 0xf7, 0xc5, 0xf9, 0x3e, 0x74, 0x43, 0xb2, 0xda, 0xa2, 0xcb,
-	0xcf, 0xe8, 0x11, 0x1c, 0xfa, 0x41, 0xe2, 0xc5, 0xc1, 0x2a, 0x08, 0x09, 0x8b, 0xe2, 0x0c, 0xe1,
-	0x32, 0x13, 0xdd, 0x85, 0x76, 0x18, 0x78, 0x97, 0x42, 0x3b, 0x85, 0x77, 0x4b, 0xf3, 0xfa, 0x90,
-	0x77, 0x84, 0x91, 0x78, 0x1e, 0x2f, 0x33, 0x64, 0x73, 0x06, 0x1a, 0x00, 0x4a, 0x09, 0xb1, 0xc9,
-	0x4e, 0xb3, 0x55, 0xd5, 0x14, 0xcd, 0xf9, 0x9e, 0x3f, 0xdc, 0xd3, 0x32, 0xf2, 0xc8, 0x92, 0x1b,
-	0x6b, 0xa5, 0x9e, 0x24, 0x6d, 0x44, 0x70, 0xbc, 0x07, 0x54, 0x1e, 0xc4, 0xb6, 0xd1, 0xb2, 0x8c,
-	0x0b, 0x43, 0x71, 0x0f, 0x14, 0x6f, 0x41, 0xc2, 0x90, 0x2e, 0xed, 0x6d, 0x5f, 0x6e, 0x19, 0xbc,
-	0x31, 0x2e, 0x36, 0xc1, 0xd2, 0xb7, 0xe5, 0x38, 0x49, 0xd2, 0xf8, 0xa7, 0x02, 0xfa, 0xbe, 0x1a,
-	0xfc, 0x07, 0xdd, 0x52, 0x08, 0xbb, 0xcd, 0x8f, 0x34, 0xa8, 0x6d, 0xe2, 0x65, 0xe6, 0x80, 0x1f,
-	0x79, 0xa6, 0xe7, 0xc1, 0x92, 0x4e, 0x0a, 0x98, 0x4a, 0x9a, 0x57, 0x85, 0x9f, 0x67, 0xc1, 0x2f,
-	0xf4, 0xf9, 0x15, 0xa3, 0x89, 0xc0, 0xb5, 0x8e, 0xcb, 0x4c, 0xd4, 0x83, 0xe2, 0x6a, 0x11, 0xa0,
-	0x2a, 0xa5, 0x6d, 0x53, 0xbc, 0x1d, 0x5a, 0xe5, 0xdb, 0xa1, 0x88, 0x73, 0x7b, 0x07, 0xe7, 0xdf,
-	0x14, 0x50, 0x0b, 0xcb, 0x6c, 0xcf, 0xb4, 0x97, 0xe6, 0xb2, 0x2a, 0xfe, 0x14, 0xe6, 0x52, 0x6e,
-	0xf2, 0x5a, 0x61, 0x93, 0x3f, 0x00, 0x35, 0xa6, 0xc9, 0x3a, 0x0a, 0x13, 0xea, 0xb2, 0x28, 0x4b,
-	0x1a, 0x24, 0xcb, 0x89, 0xd0, 0x1d, 0x68, 0xd3, 0x30, 0x71, 0x45, 0x9b, 0x65, 0x33, 0x4a, 0xc3,
-	0x44, 0x20, 0x52, 0xd8, 0x87, 0xcd, 0xd2, 0x3e, 0xdc, 0x5d, 0x6d, 0xad, 0x4f, 0x5e, 0xe8, 0xed,
-	0x4f, 0x59, 0xe8, 0xe8, 0x29, 0xb4, 0x92, 0xf4, 0x79, 0xa0, 0x2b, 0x62, 0x05, 0xe8, 0xb9, 0x81,
-	0xf2, 0xbb, 0xe1, 0xe5, 0x01, 0x96, 0xa2, 0x68, 0x00, 0x8d, 0x80, 0xb7, 0xbd, 0x0e, 0x42, 0xe7,
-	0xf6, 0xce, 0x8d, 0x9f, 0x6b, 0xa4, 0x62, 0x5c, 0x9e, 0xf0, 0x5b, 0x57, 0x57, 0x77, 0xe5, 0x8b,
-	0xb7, 0x39, 0x97, 0x17, 0x62, 0xe8, 0x3e, 0x28, 0x5e, 0xb4, 0x5a, 0x6d, 0xc2, 0x80, 0x5d, 0xe9,
-	0x1d, 0x5e, 0xfa, 0x97, 0x07, 0x38, 0x67, 0xa1, 0x21, 0x1c, 0xf9, 0x69, 0x63, 0xcb, 0x47, 0x90,
-	0xee, 0xed, 0x46, 0x5f, 0xee, 0xfc, 0x97, 0x07, 0xb8, 0xeb, 0x97, 0xb7, 0xf7, 0xf6, 0xae, 0x39,
-	0x2c, 0xde, 0x35, 0x0f, 0xa1, 0xe3, 0x07, 0xc9, 0x7a, 0x49, 0xae, 0xd2, 0x42, 0x76, 0xd3, 0xb6,
-	0xcc, 0x78, 0xa2, 0x98, 0xe7, 0x70, 0x3f, 0xe1, 0xb0, 0x73, 0x1c, 0x89, 0xc7, 0xdc, 0x98, 0xfe,
-	0xb4, 0xa1, 0x09, 0x73, 0x93, 0xe0, 0x22, 0x24, 0x6c, 0x13, 0x53, 0xfd, 0x68, 0x77, 0x9b, 0x0e,
-	0x53, 0x51, 0x9c, 0x4a, 0xce, 0xa4, 0x20, 0xfe, 0x3f, 0x37, 0xb4, 0xe7, 0x27, 0x0a, 0xc1, 0x88,
-	0xa9, 0x47, 0x83, 0x77, 0xd4, 0xbf, 0xc6, 0x97, 0xf6, 0xb1, 0xbe, 0x1e, 0x48, 0x63, 0xfb, 0xfc,
-	0x3d, 0x86, 0x23, 0xe9, 0x46, 0xa2, 0xfa, 0xbf, 0x5e, 0xa5, 0xdf, 0xc6, 0xdd, 0x8c, 0x9d, 0x21,
-	0x67, 0xfc, 0x5e, 0x05, 0x75, 0x58, 0x9a, 0xd3, 0x9b, 0xf2, 0x1d, 0x35, 0x9c, 0x4e, 0x1c, 0x6b,
-	0xe2, 0xc8, 0x97, 0x54, 0x17, 0xc0, 0xb1, 0x7e, 0x70, 0xdc, 0xd3, 0xef, 0x4c, 0x7b, 0xa2, 0x55,
-	0x90, 0x0a, 0xad, 0x99, 0x63, 0x0f, 0x5f, 0x59, 0x58, 0xab, 0x22, 0x80, 0xe6, 0xcc, 0x31, 0x9d,
-	0xf9, 0x4c, 0xab, 0x21, 0x05, 0x1a, 0xd6, 0x78, 0xfa, 0xad, 0xad, 0xd5, 0xd1, 0x31, 0xdc, 0x70,
-	0xb0, 0x39, 0x99, 0x99, 0x43, 0xc7, 0x9e, 0x72, 0x8b, 0xe3, 0xb1, 0x39, 0x19, 0x69, 0x0d, 0xd4,
-	0x87, 0x47, 0xb3, 0xb3, 0x99, 0x63, 0x8d, 0xdd, 0xb1, 0x35, 0x9b, 0x99, 0x27, 0xd6, 0xd6, 0xdb,
-	0x29, 0xb6, 0xdf, 0x98, 0x8e, 0xe5, 0x9e, 0xe0, 0xe9, 0xfc, 0x54, 0x6b, 0x72, 0x6b, 0xf6, 0xd8,
-	0x3c, 0xb1, 0xb4, 0x16, 0x3f, 0x8a, 0xb7, 0x9d, 0xd6, 0x46, 0x87, 0xa0, 0x70, 0x63, 0xf3, 0x89,
-	0xed, 0x9c, 0x69, 0x0a, 0x7f, 0xfd, 0xed, 0x98, 0x3b, 0x31, 0x4f, 0x35, 0x40, 0x37, 0xe0, 0x88,
-	0xdb, 0x35, 0x87, 0x8e, 0x8b, 0xad, 0xd7, 0x73, 0x6b, 0xe6, 0x68, 0x2a, 0x67, 0x8e, 0xec, 0xd9,
-	0x70, 0x8a, 0x47, 0x52, 0x5a, 0xeb, 0xa0, 0x3b, 0x70, 0xcb, 0x1e, 0x59, 0x13, 0xc7, 0x76, 0xce,
-	0xdc, 0x37, 0x16, 0xb6, 0x5f, 0xd8, 0x43, 0x93, 0xc7, 0xac, 0x1d, 0x3e, 0x57, 0xb6, 0xab, 0xcb,
-	0x98, 0xc3, 0xf1, 0x3e, 0xc4, 0xef, 0x81, 0x92, 0x17, 0x32, 0x7d, 0x00, 0xe7, 0x8c, 0xeb, 0x57,
-	0xd4, 0xf3, 0xc3, 0x1f, 0xd5, 0xc1, 0xe7, 0x5f, 0xcb, 0xaa, 0xbf, 0x6d, 0x8a, 0xd3, 0x17, 0xff,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x6e, 0xb0, 0x0a, 0x1b, 0x0c, 0x00, 0x00,
+	// 1571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6e, 0xdb, 0xdc,
+	0x15, 0x36, 0xf5, 0xe6, 0xa1, 0x24, 0xb3, 0xd7, 0x4e, 0xcc, 0x24, 0x4e, 0xa2, 0x10, 0x01, 0xa2,
+	0xa2, 0x80, 0x0a, 0xb8, 0x69, 0x11, 0xb4, 0x83, 0x80, 0x91, 0x68, 0x9b, 0x4d, 0x24, 0x39, 0x97,
+	0x94, 0x53, 0x77, 0x42, 0x30, 0xe4, 0xb5, 0x45, 0x58, 0x22, 0x55, 0x92, 0x4a, 0xe3, 0x8c, 0x3a,
+	0xe9, 0x42, 0xba, 0x80, 0x4e, 0xbb, 0x84, 0x02, 0x5d, 0x40, 0xd1, 0x79, 0x07, 0x5d, 0x41, 0x17,
+	0x50, 0xdc, 0xcb, 0xb7, 0x6a, 0x39, 0xf9, 0xf3, 0x8f, 0x7c, 0xcf, 0xf1, 0x79, 0x3f, 0x3e, 0x1d,
+	0x02, 0xb2, 0xe7, 0x56, 0x64, 0x2e, 0x49, 0x18, 0x5a, 0x57, 0x64, 0xb0, 0x0a, 0xfc, 0xc8, 0x47,
+	0x2d, 0xf6, 0xe7, 0xe3, 0xfa, 0xf2, 0xa1, 0x40, 0xbc, 0xf5, 0x32, 0x8c, 0xd9, 0xf2, 0x2b, 0xe8,
+	0xea, 0x91, 0x6b, 0x5f, 0x93, 0x60, 0x1c, 0x8b, 0x23, 0x04, 0xb5, 0xb9, 0x15, 0xce, 0x25, 0xae,
+	0xc7, 0xf5, 0x79, 0xcc, 0xde, 0x94, 0xb7, 0xb2, 0xec, 0x6b, 0xa9, 0xd2, 0xe3, 0xfa, 0x75, 0xcc,
+	0xde, 0xf2, 0x7b, 0x68, 0x6b, 0x4b, 0xeb, 0x8a, 0xa4, 0x7a, 0x12, 0x34, 0x57, 0xd6, 0xcd, 0xc2,
+	0xb7, 0x1c, 0xa6, 0xda, 0xc6, 0x29, 0x89, 0x5e, 0x40, 0x2d, 0xba, 0x59, 0x11, 0xa6, 0xdd, 0x3d,
+	0xda, 0x1b, 0xa4, 0x91, 0x0c, 0x98, 0xbe, 0x71, 0xb3, 0x22, 0x98, 0x09, 0xc8, 0x7f, 0xe3, 0xa0,
+	0xad, 0xac, 0x1d, 0xd7, 0xff, 0xba, 0xcd, 0x97, 0x25, 0x9b, 0xbd, 0xdc, 0x66, 0x51, 0x3f, 0x26,
+	0x72, 0x07, 0xe8, 0x29, 0x08, 0xce, 0x3a, 0xb0, 0x22, 0xd7, 0xf7, 0xcc, 0x65, 0x28, 0x55, 0x7b,
+	0x5c, 0xbf, 0x86, 0x21, 0x65, 0x8d, 0x43, 0xf9, 0x97, 0xc0, 0x67, 0x3a, 0xe8, 0x3e, 0xa0, 0xd9,
+	0xe4, 0xed, 0x64, 0xfa, 0x61, 0x62, 0x2a, 0xb3, 0x91, 0x36, 0x35, 0x8d, 0x8b, 0x33, 0x55, 0xdc,
+	0x41, 0x4d, 0xa8, 0x2a, 0xca, 0x50, 0xe4, 0xd8, 0x63, 0x8c, 0xc5, 0x8a, 0xfc, 0x77, 0x0e, 0x84,
+	0x63, 0x77, 0x41, 0x0a, 0x35, 0xf4, 0xac, 0x25, 0x49, 0x6b, 0x48, 0xdf, 0xe8, 0x11, 0xf0, 0x4b,
+	0x77, 0x49, 0xcc, 0x2c, 0x6c, 0x1e, 0xb7, 0x28, 0x83, 0xb9, 0x42, 0x50, 0x0b, 0xdd, 0x2f, 0x24,
+	0x89, 0x88, 0xbd, 0xb3, 0x46, 0xd4, 0x58, 0xe6, 0x71, 0x23, 0x9e, 0x41, 0xdb, 0x9e, 0xaf, 0xbd,
+	0xeb, 0xd0, 0xb4, 0xfd, 0xb5, 0x17, 0x49, 0xf5, 0x1e, 0xd7, 0xef, 0x60, 0x21, 0xe6, 0x0d, 0x29,
+	0xab, 0x58, 0xb3, 0x46, 0xb9, 0x66, 0xa9, 0xb2, 0x49, 0x4d, 0x91, 0x50, 0x6a, 0xf6, 0xaa, 0xfd,
+	0x76, 0xa2, 0x7c, 0xca, 0x58, 0xf2, 0xbf, 0x39, 0x68, 0x9f, 0xbb, 0x0e, 0xc9, 0x3a, 0xf0, 0x53,
+	0xa8, 0x5d, 0xba, 0x8b, 0x38, 0x13, 0xe1, 0xe8, 0x5e, 0x5e, 0xe7, 0x42, 0xba, 0x98, 0x89, 0xa0,
+	0x43, 0xe0, 0xa3, 0xf9, 0x7a, 0xf9, 0xd1, 0xb3, 0xdc, 0x05, 0x4b, 0xb0, 0x8d, 0x73, 0x06, 0xfa,
+	0x35, 0x74, 0x33, 0x22, 0xae, 0x41, 0x75, 0xfb, 0x38, 0x74, 0x32, 0x51, 0xe3, 0x96, 0xb6, 0xd5,
+	0x36, 0xdb, 0x86, 0xf6, 0xa1, 0xfe, 0x47, 0xd7, 0x89, 0xe6, 0x49, 0x3d, 0x62, 0x02, 0xdd, 0x87,
+	0xc6, 0x9c, 0xb8, 0x57, 0xf3, 0x88, 0x15, 0xa2, 0x83, 0x13, 0x4a, 0xfe, 0x07, 0x07, 0x3c, 0x0d,
+	0x7f, 0x48, 0x13, 0xa7, 0xba, 0xf6, 0xc2, 0xb7, 0xaf, 0x59, 0x8a, 0x35, 0x1c, 0x13, 0xe8, 0x00,
+	0x9a, 0x6c, 0x89, 0x5c, 0x27, 0xe9, 0x55, 0x83, 0x92, 0x9a, 0x43, 0xdb, 0x48, 0xb3, 0x65, 0x35,
+	0x64, 0x29, 0xb4, 0x71, 0x8b, 0x32, 0x68, 0x01, 0xa9, 0x2d, 0xd7, 0x73, 0xc8, 0x67, 0x16, 0x62,
+	0x07, 0xc7, 0xc4, 0x8f, 0x6b, 0xda, 0x63, 0x80, 0x64, 0x91, 0x69, 0x2c, 0x4d, 0x16, 0x0b, 0x9f,
+	0x70, 0x34, 0x47, 0xfe, 0x73, 0x05, 0x04, 0xd5, 0x71, 0xa3, 0xb4, 0x5f, 0xb7, 0x67, 0x83, 0xa0,
+	0x16, 0x91, 0xcf, 0x51, 0x92, 0x0a, 0x7b, 0x17, 0x33, 0xac, 0x96, 0x32, 0x2c, 0x7b, 0xac, 0x6d,
+	0x78, 0xa4, 0x1e, 0xae, 0x02, 0x2b, 0x49, 0xa3, 0x8d, 0x63, 0x02, 0xbd, 0x82, 0x76, 0xaa, 0xc4,
+	0x9a, 0xdb, 0x60, 0xcd, 0x2d, 0xcc, 0x4b, 0x12, 0x20, 0x6b, 0xaf, 0xb0, 0xcc, 0x09, 0x34, 0x82,
+	0xb6, 0xed, 0x7b, 0x11, 0xf1, 0xa2, 0x58, 0xb3, 0xc9, 0x34, 0x9f, 0xe5, 0x9a, 0xc3, 0xb9, 0x95,
+	0xa6, 0x37, 0x18, 0xc6, 0x92, 0xb1, 0x15, 0x3b, 0x27, 0xe4, 0xbf, 0x72, 0xd0, 0x19, 0x91, 0x05,
+	0x89, 0xc8, 0xdd, 0x95, 0xd8, 0xda, 0xd7, 0x72, 0xd6, 0xd5, 0xad, 0x59, 0xd7, 0xee, 0xca, 0xba,
+	0xfe, 0xad, 0x59, 0xcb, 0x1a, 0xa0, 0x38, 0xdc, 0x63, 0x3f, 0x18, 0x7f, 0x25, 0xe6, 0x72, 0x68,
+	0x95, 0xcd, 0x11, 0xf8, 0x67, 0x05, 0xba, 0x23, 0x37, 0xb4, 0xfd, 0xc0, 0x49, 0xed, 0x74, 0xa1,
+	0xe2, 0x3a, 0x09, 0xfa, 0x54, 0x5c, 0x87, 0xf5, 0x3f, 0x87, 0x9d, 0x18, 0x0b, 0xe9, 0xba, 0xba,
+	0x4b, 0x12, 0x46, 0xd6, 0x72, 0x95, 0xe6, 0x9b, 0x31, 0x50, 0x1f, 0x76, 0x33, 0x82, 0xce, 0x17,
+	0x49, 0x27, 0x61, 0x93, 0x4d, 0x47, 0x37, 0x69, 0x04, 0x4b, 0x9f, 0xc7, 0x29, 0x89, 0x7e, 0x05,
+	0x0d, 0x6b, 0x1d, 0xcd, 0xfd, 0x80, 0x4d, 0x83, 0x70, 0xf4, 0x24, 0xaf, 0x4b, 0x39, 0x5e, 0x85,
+	0x49, 0xe1, 0x44, 0x1a, 0xbd, 0x06, 0x3e, 0x20, 0x97, 0x24, 0x20, 0x9e, 0x1d, 0x8f, 0x83, 0x50,
+	0x1c, 0x87, 0xb2, 0x2a, 0x4e, 0x05, 0x71, 0xae, 0x83, 0x46, 0x20, 0x58, 0x51, 0x64, 0xd9, 0xf3,
+	0x25, 0xf1, 0xa2, 0x50, 0x6a, 0xf5, 0xaa, 0x7d, 0xe1, 0x48, 0xde, 0xea, 0x3d, 0x13, 0xc5, 0x45,
+	0x35, 0xf9, 0x3f, 0x1c, 0xec, 0xdf, 0x16, 0xe7, 0x6d, 0xd5, 0x65, 0x68, 0x5f, 0x29, 0xa0, 0xfd,
+	0x73, 0xe8, 0x38, 0x6e, 0x68, 0x07, 0xee, 0xd2, 0xf5, 0xac, 0xc8, 0x0f, 0x92, 0x0a, 0x97, 0x99,
+	0xe8, 0x21, 0xb4, 0x3c, 0xd7, 0xbe, 0x66, 0xda, 0x71, 0x79, 0x33, 0x9a, 0xf6, 0xc7, 0xfa, 0x64,
+	0x45, 0x56, 0x30, 0x0b, 0x16, 0x49, 0x65, 0x73, 0x06, 0x1a, 0x00, 0x8a, 0x09, 0x06, 0x9a, 0x67,
+	0x25, 0xec, 0xb8, 0xe5, 0x3f, 0xd4, 0xd3, 0xc2, 0xb7, 0xad, 0x05, 0x35, 0x16, 0x83, 0x48, 0x46,
+	0xcb, 0x3e, 0x1c, 0x6c, 0x29, 0x2a, 0x0d, 0x22, 0x1b, 0xb4, 0x24, 0xe3, 0xc2, 0x52, 0x1c, 0x02,
+	0x6f, 0xcf, 0x2d, 0xcf, 0x23, 0x0b, 0x2d, 0x9b, 0xcb, 0x8c, 0x41, 0x07, 0xe3, 0x6a, 0xed, 0x2e,
+	0x1c, 0x2d, 0x5d, 0xa7, 0x94, 0x94, 0xff, 0xcb, 0x81, 0xb4, 0xad, 0x07, 0xff, 0x57, 0xdd, 0x52,
+	0x08, 0x9b, 0xc3, 0x8f, 0x44, 0xa8, 0xae, 0x83, 0x45, 0xe2, 0x80, 0x3e, 0x69, 0xa6, 0x14, 0x8f,
+	0x27, 0x85, 0x9a, 0xa6, 0x34, 0xed, 0x0a, 0x7d, 0xeb, 0xee, 0x17, 0xf2, 0xe6, 0x26, 0x22, 0x21,
+	0xab, 0x6b, 0x0d, 0x97, 0x99, 0xa8, 0x07, 0x45, 0x68, 0x61, 0x45, 0xe5, 0x4b, 0x68, 0x53, 0x84,
+	0xeb, 0x66, 0x19, 0xae, 0x8b, 0x75, 0x6e, 0x6d, 0xd4, 0xf9, 0x2f, 0x00, 0x42, 0x01, 0xcc, 0xb6,
+	0x6c, 0x7b, 0x69, 0x2f, 0x2b, 0xec, 0x3f, 0x85, 0xbd, 0x4c, 0x91, 0xbc, 0x5a, 0x40, 0xf2, 0xa7,
+	0x20, 0x04, 0x24, 0x5c, 0xf9, 0x5e, 0x48, 0xcc, 0xc8, 0x4f, 0x92, 0x86, 0x94, 0x65, 0xf8, 0xe8,
+	0x01, 0xb4, 0x88, 0x17, 0x9a, 0x6c, 0xcc, 0x92, 0x1d, 0x25, 0x5e, 0xc8, 0x2a, 0x52, 0xc0, 0xc3,
+	0x46, 0x09, 0x0f, 0x37, 0xa1, 0xad, 0xf9, 0xdd, 0x80, 0xde, 0xfa, 0x1e, 0x40, 0x47, 0x2f, 0xa1,
+	0x19, 0xc6, 0x87, 0xa9, 0xc4, 0x33, 0x08, 0x90, 0x72, 0x03, 0xe5, 0x8b, 0xf5, 0x74, 0x07, 0xa7,
+	0xa2, 0x68, 0x00, 0x75, 0x97, 0x8e, 0xbd, 0x04, 0x4c, 0xe7, 0xfe, 0xc6, 0x71, 0x91, 0x6b, 0xc4,
+	0x62, 0x54, 0xde, 0xa2, 0xf7, 0x9e, 0x24, 0x6c, 0xca, 0x17, 0xef, 0x48, 0x2a, 0xcf, 0xc4, 0xd0,
+	0x13, 0xe0, 0x6d, 0x7f, 0xb9, 0x5c, 0x7b, 0x6e, 0x74, 0x23, 0xb5, 0x69, 0xeb, 0x4f, 0x77, 0x70,
+	0xce, 0x42, 0x3f, 0x4b, 0xce, 0x25, 0x74, 0xc7, 0xb9, 0x74, 0xba, 0x93, 0x1c, 0x4c, 0x03, 0xa8,
+	0x7f, 0xa2, 0xb7, 0x96, 0xb4, 0xb7, 0xe9, 0xbc, 0x78, 0x82, 0x51, 0xe7, 0x4c, 0x0c, 0x0d, 0x61,
+	0xd7, 0x89, 0xb7, 0x26, 0xbd, 0xed, 0x25, 0x7b, 0xb3, 0x34, 0xe5, 0xb5, 0x3a, 0xdd, 0xc1, 0x5d,
+	0xa7, 0xfc, 0xd3, 0x90, 0xfd, 0x90, 0x75, 0x8a, 0x3f, 0x64, 0xcf, 0xa0, 0xed, 0xb8, 0xe1, 0x6a,
+	0x61, 0xdd, 0xc4, 0x53, 0xd2, 0x8d, 0x67, 0x3e, 0xe1, 0xb1, 0x49, 0xb9, 0x84, 0x27, 0x21, 0xed,
+	0x29, 0x6d, 0x92, 0x65, 0x47, 0x66, 0x40, 0xfe, 0xb0, 0x26, 0x61, 0x64, 0x86, 0xee, 0x95, 0x67,
+	0x45, 0xeb, 0x80, 0x48, 0xbb, 0x9b, 0x50, 0x3d, 0x8c, 0x45, 0x71, 0x2c, 0xa9, 0xa7, 0x82, 0xf8,
+	0x11, 0x35, 0xb4, 0xe5, 0x9f, 0xc8, 0x03, 0x39, 0x20, 0x36, 0x71, 0x3f, 0x11, 0xe7, 0x0e, 0x5f,
+	0xe2, 0xb7, 0xfa, 0x7a, 0x9a, 0x1a, 0xdb, 0xe6, 0xef, 0x05, 0xec, 0xa6, 0x6e, 0xd2, 0xaa, 0xfe,
+	0xa4, 0xc7, 0xf5, 0x5b, 0xb8, 0x9b, 0xb0, 0x93, 0xca, 0xc9, 0xff, 0xaa, 0x80, 0x30, 0x2c, 0x81,
+	0xc0, 0x7e, 0xfa, 0x79, 0x30, 0x9c, 0x4e, 0x0c, 0x75, 0x62, 0xa4, 0x1f, 0x08, 0x5d, 0x00, 0x43,
+	0xfd, 0x9d, 0x61, 0x9e, 0xbd, 0x53, 0xb4, 0x89, 0xc8, 0x21, 0x01, 0x9a, 0xba, 0xa1, 0x0d, 0xdf,
+	0xaa, 0x58, 0xac, 0x20, 0x80, 0x86, 0x6e, 0x28, 0xc6, 0x4c, 0x17, 0xab, 0x88, 0x87, 0xba, 0x3a,
+	0x9e, 0xfe, 0x56, 0x13, 0x6b, 0xe8, 0x00, 0xf6, 0x0c, 0xac, 0x4c, 0x74, 0x65, 0x68, 0x68, 0x53,
+	0x6a, 0x71, 0x3c, 0x56, 0x26, 0x23, 0xb1, 0x8e, 0xfa, 0xf0, 0x5c, 0xbf, 0xd0, 0x0d, 0x75, 0x6c,
+	0x8e, 0x55, 0x5d, 0x57, 0x4e, 0xd4, 0xcc, 0xdb, 0x19, 0xd6, 0xce, 0x15, 0x43, 0x35, 0x4f, 0xf0,
+	0x74, 0x76, 0x26, 0x36, 0xa8, 0x35, 0x6d, 0xac, 0x9c, 0xa8, 0x62, 0x93, 0x3e, 0xd9, 0x27, 0x8b,
+	0xd8, 0x42, 0x1d, 0xe0, 0xa9, 0xb1, 0xd9, 0x44, 0x33, 0x2e, 0x44, 0x9e, 0x7e, 0xd4, 0x6c, 0x98,
+	0x3b, 0x51, 0xce, 0x44, 0x40, 0x7b, 0xb0, 0x4b, 0xed, 0x2a, 0x43, 0xc3, 0xc4, 0xea, 0xfb, 0x99,
+	0xaa, 0x1b, 0xa2, 0x40, 0x99, 0x23, 0x4d, 0x1f, 0x4e, 0xf1, 0x28, 0x95, 0x16, 0xdb, 0xe8, 0x01,
+	0xdc, 0xd3, 0x46, 0xea, 0xc4, 0xd0, 0x8c, 0x0b, 0xf3, 0x5c, 0xc5, 0xda, 0xb1, 0x36, 0x54, 0x68,
+	0xcc, 0x62, 0x07, 0xb5, 0xa0, 0x76, 0xac, 0xbd, 0x53, 0xc5, 0x2e, 0x0d, 0xe0, 0x5c, 0x1b, 0xa9,
+	0x53, 0x71, 0x17, 0xf5, 0xe0, 0x70, 0xc3, 0xa3, 0xae, 0xea, 0x3a, 0x4d, 0x12, 0xab, 0xba, 0x6a,
+	0x88, 0xe2, 0x1b, 0x3e, 0x83, 0x53, 0xf9, 0x4f, 0x1c, 0xec, 0x51, 0x7c, 0x18, 0x05, 0xd6, 0x65,
+	0x54, 0xf8, 0x55, 0xc8, 0x16, 0x9b, 0xfb, 0x81, 0x8b, 0x5d, 0xf9, 0xa6, 0xc5, 0x2e, 0x86, 0xf0,
+	0x01, 0xf6, 0x6f, 0x89, 0x20, 0x44, 0xaf, 0xcb, 0x57, 0x05, 0xc7, 0xae, 0x8a, 0xc7, 0x65, 0x58,
+	0xdb, 0x50, 0x2a, 0x1f, 0x14, 0x33, 0x38, 0xd8, 0x36, 0x84, 0x87, 0xc0, 0xe7, 0xb3, 0x1d, 0x7f,
+	0xea, 0xe6, 0x8c, 0xbb, 0x7f, 0x12, 0xde, 0x74, 0x7e, 0x2f, 0x0c, 0x7e, 0xfe, 0x9b, 0x34, 0x8c,
+	0x8f, 0x0d, 0xf6, 0xfa, 0xc5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x75, 0x7d, 0x4b, 0x2e, 0x05,
+	0x10, 0x00, 0x00,
 }
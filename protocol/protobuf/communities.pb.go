@@ -186,9 +186,12 @@ func (m *CommunityMember) GetRoles() []CommunityMember_Roles {
 
 type CommunityPermissions struct {
 	EnsOnly bool `protobuf:"varint,1,opt,name=ens_only,json=ensOnly,proto3" json:"ens_only,omitempty"`
-	// https://gitlab.matrix.org/matrix-org/olm/blob/master/docs/megolm.md is a candidate for the algorithm to be used in case we want to have private communityal chats, lighter than pairwise encryption using the DR, less secure, but more efficient for large number of participants
+	// private chats can only be read by their members and by the members of the community
+	// having one of roles. Their messages are encrypted with a hash ratchet key that is
+	// only distributed to those, and rotated whenever someone loses access
 	Private              bool                        `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
 	Access               CommunityPermissions_Access `protobuf:"varint,3,opt,name=access,proto3,enum=protobuf.CommunityPermissions_Access" json:"access,omitempty"`
+	Roles                []CommunityMember_Roles     `protobuf:"varint,4,rep,packed,name=roles,proto3,enum=protobuf.CommunityMember_Roles" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -240,23 +243,34 @@ func (m *CommunityPermissions) GetAccess() CommunityPermissions_Access {
 	return CommunityPermissions_UNKNOWN_ACCESS
 }
 
+func (m *CommunityPermissions) GetRoles() []CommunityMember_Roles {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type CommunityDescription struct {
-	Clock                  uint64                        `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Members                map[string]*CommunityMember   `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Permissions            *CommunityPermissions         `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	Identity               *ChatIdentity                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
-	Chats                  map[string]*CommunityChat     `protobuf:"bytes,6,rep,name=chats,proto3" json:"chats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	BanList                []string                      `protobuf:"bytes,7,rep,name=ban_list,json=banList,proto3" json:"ban_list,omitempty"`
-	Categories             map[string]*CommunityCategory `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ArchiveMagnetlinkClock uint64                        `protobuf:"varint,9,opt,name=archive_magnetlink_clock,json=archiveMagnetlinkClock,proto3" json:"archive_magnetlink_clock,omitempty"`
-	AdminSettings          *CommunityAdminSettings       `protobuf:"bytes,10,opt,name=admin_settings,json=adminSettings,proto3" json:"admin_settings,omitempty"`
-	IntroMessage           string                        `protobuf:"bytes,11,opt,name=intro_message,json=introMessage,proto3" json:"intro_message,omitempty"`
-	OutroMessage           string                        `protobuf:"bytes,12,opt,name=outro_message,json=outroMessage,proto3" json:"outro_message,omitempty"`
-	Encrypted              bool                          `protobuf:"varint,13,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
-	Tags                   []string                      `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                      `json:"-"`
-	XXX_unrecognized       []byte                        `json:"-"`
-	XXX_sizecache          int32                         `json:"-"`
+	Clock                  uint64                           `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Members                map[string]*CommunityMember      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Permissions            *CommunityPermissions            `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Identity               *ChatIdentity                    `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Chats                  map[string]*CommunityChat        `protobuf:"bytes,6,rep,name=chats,proto3" json:"chats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BanList                []string                         `protobuf:"bytes,7,rep,name=ban_list,json=banList,proto3" json:"ban_list,omitempty"`
+	Categories             map[string]*CommunityCategory    `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ArchiveMagnetlinkClock uint64                           `protobuf:"varint,9,opt,name=archive_magnetlink_clock,json=archiveMagnetlinkClock,proto3" json:"archive_magnetlink_clock,omitempty"`
+	AdminSettings          *CommunityAdminSettings          `protobuf:"bytes,10,opt,name=admin_settings,json=adminSettings,proto3" json:"admin_settings,omitempty"`
+	IntroMessage           string                           `protobuf:"bytes,11,opt,name=intro_message,json=introMessage,proto3" json:"intro_message,omitempty"`
+	OutroMessage           string                           `protobuf:"bytes,12,opt,name=outro_message,json=outroMessage,proto3" json:"outro_message,omitempty"`
+	Encrypted              bool                             `protobuf:"varint,13,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	Tags                   []string                         `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	CustomEmojis           map[string]*CommunityCustomEmoji `protobuf:"bytes,15,rep,name=custom_emojis,json=customEmojis,proto3" json:"custom_emojis,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// shard is set by the owner to relay the community traffic on a given
+	// Waku v2 shard, instead of the one derived from the community id
+	Shard                *CommunityShard `protobuf:"bytes,16,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CommunityDescription) Reset()         { *m = CommunityDescription{} }
@@ -375,6 +389,108 @@ func (m *CommunityDescription) GetTags() []string {
 	return nil
 }
 
+func (m *CommunityDescription) GetCustomEmojis() map[string]*CommunityCustomEmoji {
+	if m != nil {
+		return m.CustomEmojis
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetShard() *CommunityShard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type CommunityShard struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityShard) Reset()         { *m = CommunityShard{} }
+func (m *CommunityShard) String() string { return proto.CompactTextString(m) }
+func (*CommunityShard) ProtoMessage()    {}
+func (*CommunityShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{4}
+}
+
+func (m *CommunityShard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityShard.Unmarshal(m, b)
+}
+func (m *CommunityShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityShard.Marshal(b, m, deterministic)
+}
+func (m *CommunityShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityShard.Merge(m, src)
+}
+func (m *CommunityShard) XXX_Size() int {
+	return xxx_messageInfo_CommunityShard.Size(m)
+}
+func (m *CommunityShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityShard proto.InternalMessageInfo
+
+func (m *CommunityShard) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type CommunityCustomEmoji struct {
+	// name the shortcode of the emoji, without colons
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// payload the image of the emoji
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityCustomEmoji) Reset()         { *m = CommunityCustomEmoji{} }
+func (m *CommunityCustomEmoji) String() string { return proto.CompactTextString(m) }
+func (*CommunityCustomEmoji) ProtoMessage()    {}
+func (*CommunityCustomEmoji) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{5}
+}
+
+func (m *CommunityCustomEmoji) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityCustomEmoji.Unmarshal(m, b)
+}
+func (m *CommunityCustomEmoji) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityCustomEmoji.Marshal(b, m, deterministic)
+}
+func (m *CommunityCustomEmoji) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityCustomEmoji.Merge(m, src)
+}
+func (m *CommunityCustomEmoji) XXX_Size() int {
+	return xxx_messageInfo_CommunityCustomEmoji.Size(m)
+}
+func (m *CommunityCustomEmoji) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityCustomEmoji.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityCustomEmoji proto.InternalMessageInfo
+
+func (m *CommunityCustomEmoji) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityCustomEmoji) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type CommunityAdminSettings struct {
 	PinMessageAllMembersEnabled bool     `protobuf:"varint,1,opt,name=pin_message_all_members_enabled,json=pinMessageAllMembersEnabled,proto3" json:"pin_message_all_members_enabled,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
@@ -386,7 +502,7 @@ func (m *CommunityAdminSettings) Reset()         { *m = CommunityAdminSettings{}
 func (m *CommunityAdminSettings) String() string { return proto.CompactTextString(m) }
 func (*CommunityAdminSettings) ProtoMessage()    {}
 func (*CommunityAdminSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{4}
+	return fileDescriptor_f937943d74c1cd8b, []int{6}
 }
 
 func (m *CommunityAdminSettings) XXX_Unmarshal(b []byte) error {
@@ -429,7 +545,7 @@ func (m *CommunityChat) Reset()         { *m = CommunityChat{} }
 func (m *CommunityChat) String() string { return proto.CompactTextString(m) }
 func (*CommunityChat) ProtoMessage()    {}
 func (*CommunityChat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{5}
+	return fileDescriptor_f937943d74c1cd8b, []int{7}
 }
 
 func (m *CommunityChat) XXX_Unmarshal(b []byte) error {
@@ -498,7 +614,7 @@ func (m *CommunityCategory) Reset()         { *m = CommunityCategory{} }
 func (m *CommunityCategory) String() string { return proto.CompactTextString(m) }
 func (*CommunityCategory) ProtoMessage()    {}
 func (*CommunityCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{6}
+	return fileDescriptor_f937943d74c1cd8b, []int{8}
 }
 
 func (m *CommunityCategory) XXX_Unmarshal(b []byte) error {
@@ -554,7 +670,7 @@ func (m *CommunityInvitation) Reset()         { *m = CommunityInvitation{} }
 func (m *CommunityInvitation) String() string { return proto.CompactTextString(m) }
 func (*CommunityInvitation) ProtoMessage()    {}
 func (*CommunityInvitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{7}
+	return fileDescriptor_f937943d74c1cd8b, []int{9}
 }
 
 func (m *CommunityInvitation) XXX_Unmarshal(b []byte) error {
@@ -618,7 +734,7 @@ func (m *CommunityRequestToJoin) Reset()         { *m = CommunityRequestToJoin{}
 func (m *CommunityRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoin) ProtoMessage()    {}
 func (*CommunityRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{8}
+	return fileDescriptor_f937943d74c1cd8b, []int{10}
 }
 
 func (m *CommunityRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -689,7 +805,7 @@ func (m *CommunityCancelRequestToJoin) Reset()         { *m = CommunityCancelReq
 func (m *CommunityCancelRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityCancelRequestToJoin) ProtoMessage()    {}
 func (*CommunityCancelRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{9}
+	return fileDescriptor_f937943d74c1cd8b, []int{11}
 }
 
 func (m *CommunityCancelRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -761,7 +877,7 @@ func (m *CommunityRequestToJoinResponse) Reset()         { *m = CommunityRequest
 func (m *CommunityRequestToJoinResponse) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoinResponse) ProtoMessage()    {}
 func (*CommunityRequestToJoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{10}
+	return fileDescriptor_f937943d74c1cd8b, []int{12}
 }
 
 func (m *CommunityRequestToJoinResponse) XXX_Unmarshal(b []byte) error {
@@ -836,7 +952,7 @@ func (m *CommunityRequestToLeave) Reset()         { *m = CommunityRequestToLeave
 func (m *CommunityRequestToLeave) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToLeave) ProtoMessage()    {}
 func (*CommunityRequestToLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{11}
+	return fileDescriptor_f937943d74c1cd8b, []int{13}
 }
 
 func (m *CommunityRequestToLeave) XXX_Unmarshal(b []byte) error {
@@ -883,7 +999,7 @@ func (m *CommunityMessageArchiveMagnetlink) Reset()         { *m = CommunityMess
 func (m *CommunityMessageArchiveMagnetlink) String() string { return proto.CompactTextString(m) }
 func (*CommunityMessageArchiveMagnetlink) ProtoMessage()    {}
 func (*CommunityMessageArchiveMagnetlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{12}
+	return fileDescriptor_f937943d74c1cd8b, []int{14}
 }
 
 func (m *CommunityMessageArchiveMagnetlink) XXX_Unmarshal(b []byte) error {
@@ -935,7 +1051,7 @@ func (m *WakuMessage) Reset()         { *m = WakuMessage{} }
 func (m *WakuMessage) String() string { return proto.CompactTextString(m) }
 func (*WakuMessage) ProtoMessage()    {}
 func (*WakuMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{13}
+	return fileDescriptor_f937943d74c1cd8b, []int{15}
 }
 
 func (m *WakuMessage) XXX_Unmarshal(b []byte) error {
@@ -1019,7 +1135,7 @@ func (m *WakuMessageArchiveMetadata) Reset()         { *m = WakuMessageArchiveMe
 func (m *WakuMessageArchiveMetadata) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveMetadata) ProtoMessage()    {}
 func (*WakuMessageArchiveMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{14}
+	return fileDescriptor_f937943d74c1cd8b, []int{16}
 }
 
 func (m *WakuMessageArchiveMetadata) XXX_Unmarshal(b []byte) error {
@@ -1081,7 +1197,7 @@ func (m *WakuMessageArchive) Reset()         { *m = WakuMessageArchive{} }
 func (m *WakuMessageArchive) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchive) ProtoMessage()    {}
 func (*WakuMessageArchive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{15}
+	return fileDescriptor_f937943d74c1cd8b, []int{17}
 }
 
 func (m *WakuMessageArchive) XXX_Unmarshal(b []byte) error {
@@ -1138,7 +1254,7 @@ func (m *WakuMessageArchiveIndexMetadata) Reset()         { *m = WakuMessageArch
 func (m *WakuMessageArchiveIndexMetadata) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveIndexMetadata) ProtoMessage()    {}
 func (*WakuMessageArchiveIndexMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{16}
+	return fileDescriptor_f937943d74c1cd8b, []int{18}
 }
 
 func (m *WakuMessageArchiveIndexMetadata) XXX_Unmarshal(b []byte) error {
@@ -1205,7 +1321,7 @@ func (m *WakuMessageArchiveIndex) Reset()         { *m = WakuMessageArchiveIndex
 func (m *WakuMessageArchiveIndex) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveIndex) ProtoMessage()    {}
 func (*WakuMessageArchiveIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{17}
+	return fileDescriptor_f937943d74c1cd8b, []int{19}
 }
 
 func (m *WakuMessageArchiveIndex) XXX_Unmarshal(b []byte) error {
@@ -1242,7 +1358,10 @@ func init() {
 	proto.RegisterType((*CommunityDescription)(nil), "protobuf.CommunityDescription")
 	proto.RegisterMapType((map[string]*CommunityCategory)(nil), "protobuf.CommunityDescription.CategoriesEntry")
 	proto.RegisterMapType((map[string]*CommunityChat)(nil), "protobuf.CommunityDescription.ChatsEntry")
+	proto.RegisterMapType((map[string]*CommunityCustomEmoji)(nil), "protobuf.CommunityDescription.CustomEmojisEntry")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityDescription.MembersEntry")
+	proto.RegisterType((*CommunityShard)(nil), "protobuf.CommunityShard")
+	proto.RegisterType((*CommunityCustomEmoji)(nil), "protobuf.CommunityCustomEmoji")
 	proto.RegisterType((*CommunityAdminSettings)(nil), "protobuf.CommunityAdminSettings")
 	proto.RegisterType((*CommunityChat)(nil), "protobuf.CommunityChat")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityChat.MembersEntry")
@@ -1266,93 +1385,100 @@ func init() {
 }
 
 var fileDescriptor_f937943d74c1cd8b = []byte{
-	// 1404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xef, 0xfa, 0x23, 0xb6, 0x9f, 0xed, 0xd4, 0x99, 0x36, 0xc9, 0x36, 0xfd, 0x88, 0xbb, 0x80,
-	0x94, 0x0a, 0xe1, 0xaa, 0xa9, 0x90, 0x2a, 0xbe, 0x5a, 0x37, 0xb5, 0x8a, 0x69, 0x62, 0xb7, 0x13,
-	0x87, 0xd2, 0x1e, 0x58, 0x4d, 0x76, 0x27, 0xce, 0x28, 0xeb, 0x59, 0xb3, 0x33, 0x8e, 0x30, 0x07,
-	0xfe, 0x0e, 0xc4, 0x95, 0x0b, 0x27, 0xfe, 0x05, 0x0e, 0xdc, 0xb9, 0x73, 0xe3, 0xc8, 0x9f, 0x81,
-	0x66, 0xf6, 0xc3, 0xeb, 0xaf, 0xb4, 0x52, 0x85, 0xc4, 0xc9, 0xf3, 0xde, 0xbe, 0xf7, 0x7b, 0xdf,
-	0xf3, 0xc6, 0xb0, 0xe6, 0xf8, 0x83, 0xc1, 0x88, 0x33, 0xc9, 0xa8, 0x68, 0x0c, 0x03, 0x5f, 0xfa,
-	0xa8, 0xa8, 0x7f, 0x8e, 0x47, 0x27, 0x5b, 0x57, 0x9c, 0x53, 0x22, 0x6d, 0xe6, 0x52, 0x2e, 0x99,
-	0x1c, 0x87, 0x9f, 0xad, 0x73, 0xc8, 0x3f, 0x0d, 0x08, 0x97, 0xe8, 0x36, 0x54, 0x62, 0xe5, 0xb1,
-	0xcd, 0x5c, 0xd3, 0xa8, 0x1b, 0x3b, 0x15, 0x5c, 0x4e, 0x78, 0x6d, 0x17, 0x5d, 0x87, 0xd2, 0x80,
-	0x0e, 0x8e, 0x69, 0xa0, 0xbe, 0x67, 0xf4, 0xf7, 0x62, 0xc8, 0x68, 0xbb, 0x68, 0x13, 0x0a, 0x11,
-	0xbe, 0x99, 0xad, 0x1b, 0x3b, 0x25, 0xbc, 0xa2, 0xc8, 0xb6, 0x8b, 0xae, 0x42, 0xde, 0xf1, 0x7c,
-	0xe7, 0xcc, 0xcc, 0xd5, 0x8d, 0x9d, 0x1c, 0x0e, 0x09, 0xeb, 0x17, 0x03, 0x2e, 0xef, 0xc5, 0xd8,
-	0x07, 0x1a, 0x04, 0x7d, 0x0c, 0xf9, 0xc0, 0xf7, 0xa8, 0x30, 0x8d, 0x7a, 0x76, 0x67, 0x75, 0x77,
-	0xbb, 0x11, 0xbb, 0xde, 0x98, 0x91, 0x6c, 0x60, 0x25, 0x86, 0x43, 0x69, 0xeb, 0x15, 0xe4, 0x35,
-	0x8d, 0x6a, 0x50, 0x39, 0xea, 0x3c, 0xeb, 0x74, 0x5f, 0x76, 0x6c, 0xdc, 0xdd, 0x6f, 0xd5, 0x2e,
-	0xa1, 0x0a, 0x14, 0xd5, 0xc9, 0x6e, 0xee, 0xef, 0xd7, 0x0c, 0xb4, 0x0e, 0x6b, 0x9a, 0x3a, 0x68,
-	0x76, 0x9a, 0x4f, 0x5b, 0xf6, 0xd1, 0x61, 0x0b, 0x1f, 0xd6, 0x32, 0xe8, 0x1a, 0xac, 0x87, 0xec,
-	0xee, 0x93, 0x16, 0x6e, 0xf6, 0x5a, 0xf6, 0x5e, 0xb7, 0xd3, 0x6b, 0x75, 0x7a, 0xb5, 0xac, 0xf5,
-	0xb7, 0x01, 0x57, 0x13, 0xdb, 0xcf, 0x69, 0x30, 0x60, 0x42, 0x30, 0x9f, 0x0b, 0x74, 0x0d, 0x8a,
-	0x94, 0x0b, 0xdb, 0xe7, 0xde, 0x58, 0x67, 0xaa, 0x88, 0x0b, 0x94, 0x8b, 0x2e, 0xf7, 0xc6, 0xc8,
-	0x84, 0xc2, 0x30, 0x60, 0xe7, 0x44, 0x52, 0x9d, 0xa3, 0x22, 0x8e, 0x49, 0xf4, 0x39, 0xac, 0x10,
-	0xc7, 0xa1, 0x42, 0xe8, 0x0c, 0xad, 0xee, 0x7e, 0xb0, 0x20, 0xc0, 0x94, 0x91, 0x46, 0x53, 0x0b,
-	0xe3, 0x48, 0xc9, 0xea, 0xc1, 0x4a, 0xc8, 0x41, 0x08, 0x56, 0xe3, 0x40, 0x9b, 0x7b, 0x7b, 0xad,
-	0xc3, 0xc3, 0xda, 0x25, 0xb4, 0x06, 0xd5, 0x4e, 0xd7, 0x3e, 0x68, 0x1d, 0x3c, 0x6e, 0xe1, 0xc3,
-	0x2f, 0xdb, 0xcf, 0x6b, 0x06, 0xba, 0x02, 0x97, 0xdb, 0x9d, 0xaf, 0xdb, 0xbd, 0x66, 0xaf, 0xdd,
-	0xed, 0xd8, 0xdd, 0xce, 0xfe, 0xab, 0x5a, 0x06, 0xad, 0x02, 0x74, 0x3b, 0x36, 0x6e, 0xbd, 0x38,
-	0x6a, 0x1d, 0xaa, 0x10, 0x7f, 0x2e, 0xa4, 0x42, 0x7c, 0x42, 0x85, 0x13, 0xb0, 0xa1, 0x64, 0x3e,
-	0x9f, 0xd4, 0xcd, 0x48, 0xd5, 0x0d, 0xb5, 0xa0, 0x10, 0x96, 0x5c, 0x98, 0x99, 0x7a, 0x76, 0xa7,
-	0xbc, 0xfb, 0xe1, 0x82, 0x20, 0x52, 0x30, 0x8d, 0xb0, 0x62, 0xa2, 0xc5, 0x65, 0x30, 0xc6, 0xb1,
-	0x2e, 0x7a, 0x04, 0xe5, 0xe1, 0x24, 0x52, 0x9d, 0x8f, 0xf2, 0xee, 0xad, 0x8b, 0xf3, 0x81, 0xd3,
-	0x2a, 0x68, 0x17, 0x8a, 0x71, 0x2b, 0x9b, 0x79, 0xad, 0xbe, 0x91, 0x52, 0xd7, 0xad, 0x17, 0x7e,
-	0xc5, 0x89, 0x1c, 0x7a, 0x08, 0x79, 0xd5, 0x94, 0xc2, 0x5c, 0xd1, 0xae, 0xdf, 0x79, 0x83, 0xeb,
-	0x0a, 0x25, 0x72, 0x3c, 0xd4, 0x53, 0x65, 0x3f, 0x26, 0xdc, 0xf6, 0x98, 0x90, 0x66, 0xa1, 0x9e,
-	0xdd, 0x29, 0xe1, 0xc2, 0x31, 0xe1, 0xfb, 0x4c, 0x48, 0xd4, 0x01, 0x70, 0x88, 0xa4, 0x7d, 0x3f,
-	0x60, 0x54, 0x98, 0x45, 0x6d, 0xa0, 0xf1, 0x26, 0x03, 0x89, 0x42, 0x68, 0x25, 0x85, 0x80, 0x1e,
-	0x80, 0x49, 0x02, 0xe7, 0x94, 0x9d, 0x53, 0x7b, 0x40, 0xfa, 0x9c, 0x4a, 0x8f, 0xf1, 0x33, 0x3b,
-	0xac, 0x48, 0x49, 0x57, 0x64, 0x23, 0xfa, 0x7e, 0x90, 0x7c, 0xde, 0xd3, 0x25, 0x7a, 0x0a, 0xab,
-	0xc4, 0x1d, 0x30, 0x6e, 0x0b, 0x2a, 0x25, 0xe3, 0x7d, 0x61, 0x82, 0xce, 0x4f, 0x7d, 0x81, 0x37,
-	0x4d, 0x25, 0x78, 0x18, 0xc9, 0xe1, 0x2a, 0x49, 0x93, 0xe8, 0x3d, 0xa8, 0x32, 0x2e, 0x03, 0xdf,
-	0x1e, 0x50, 0x21, 0x48, 0x9f, 0x9a, 0x65, 0x3d, 0xd8, 0x15, 0xcd, 0x3c, 0x08, 0x79, 0x4a, 0xc8,
-	0x1f, 0xa5, 0x85, 0x2a, 0xa1, 0x90, 0x66, 0xc6, 0x42, 0x37, 0xa0, 0x44, 0xb9, 0x13, 0x8c, 0x87,
-	0x92, 0xba, 0x66, 0x55, 0x4f, 0xc5, 0x84, 0x81, 0x10, 0xe4, 0x24, 0xe9, 0x0b, 0x73, 0x55, 0x67,
-	0x54, 0x9f, 0xb7, 0x8e, 0xa0, 0x92, 0xee, 0x1c, 0x54, 0x83, 0xec, 0x19, 0x0d, 0x67, 0xad, 0x84,
-	0xd5, 0x11, 0xdd, 0x85, 0xfc, 0x39, 0xf1, 0x46, 0xe1, 0x94, 0x95, 0x77, 0xaf, 0x2d, 0xbd, 0x2d,
-	0x70, 0x28, 0xf7, 0x49, 0xe6, 0x81, 0xb1, 0xf5, 0x02, 0x60, 0x52, 0xd5, 0x05, 0xa0, 0x1f, 0x4d,
-	0x83, 0x6e, 0x2e, 0x00, 0x55, 0xfa, 0x69, 0xc8, 0xd7, 0x70, 0x79, 0xa6, 0x8e, 0x0b, 0x70, 0xef,
-	0x4d, 0xe3, 0x5e, 0x5f, 0x84, 0x1b, 0x82, 0x8c, 0x53, 0xd8, 0xd6, 0xb7, 0xb0, 0xb1, 0xb8, 0x54,
-	0xe8, 0x09, 0x6c, 0x0f, 0x19, 0x8f, 0x93, 0x6e, 0x13, 0xcf, 0xb3, 0xa3, 0xd9, 0xb2, 0x29, 0x27,
-	0xc7, 0x1e, 0x75, 0xa3, 0x7b, 0xe9, 0xfa, 0x90, 0xf1, 0xa8, 0x0c, 0x4d, 0xcf, 0x4b, 0x72, 0xaa,
-	0x45, 0xac, 0xbf, 0x32, 0x50, 0x9d, 0x0a, 0x0c, 0x7d, 0x31, 0x99, 0x6f, 0x43, 0xf7, 0xf0, 0xfb,
-	0x4b, 0x52, 0xf0, 0x76, 0x83, 0x9d, 0x79, 0xb7, 0xc1, 0xce, 0xbe, 0xe5, 0x60, 0x6f, 0x43, 0x39,
-	0x1a, 0x1d, 0xbd, 0xbb, 0x72, 0x3a, 0xf1, 0xf1, 0x34, 0xa9, 0xd5, 0xb5, 0x05, 0xc5, 0xa1, 0x2f,
-	0x98, 0x9a, 0x3a, 0x7d, 0x5b, 0xe4, 0x71, 0x42, 0xff, 0x47, 0xad, 0x66, 0xb9, 0xb0, 0x36, 0x57,
-	0xdb, 0x59, 0x47, 0x8d, 0x39, 0x47, 0x11, 0xe4, 0x38, 0x19, 0x84, 0x96, 0x4a, 0x58, 0x9f, 0xa7,
-	0x9c, 0xcf, 0x4e, 0x3b, 0x6f, 0xfd, 0x64, 0xc0, 0x95, 0xc4, 0x4c, 0x9b, 0x9f, 0x33, 0x49, 0xf4,
-	0xed, 0x7d, 0x1f, 0xd6, 0x27, 0xeb, 0xdc, 0x9d, 0xdc, 0x39, 0xd1, 0x5e, 0xbf, 0xea, 0x2c, 0xb9,
-	0xf2, 0xfb, 0xea, 0x31, 0x10, 0x2d, 0xf7, 0x90, 0x58, 0xbe, 0xd9, 0x6f, 0x02, 0x0c, 0x47, 0xc7,
-	0x1e, 0x73, 0x6c, 0x95, 0xaf, 0x9c, 0xd6, 0x29, 0x85, 0x9c, 0x67, 0x74, 0x6c, 0xfd, 0x6a, 0xa4,
-	0xba, 0x17, 0xd3, 0xef, 0x46, 0x54, 0xc8, 0x9e, 0xff, 0x95, 0xcf, 0x96, 0xed, 0x96, 0x68, 0xa9,
-	0xa6, 0xe2, 0x57, 0x4b, 0xb5, 0xa3, 0x52, 0xb0, 0xd4, 0x87, 0xd9, 0x67, 0x4b, 0x6e, 0xfe, 0xd9,
-	0x72, 0x1b, 0x2a, 0x2e, 0x13, 0x43, 0x8f, 0x8c, 0x43, 0xe8, 0xbc, 0x06, 0x28, 0x47, 0x3c, 0x05,
-	0x6f, 0xfd, 0x66, 0xc0, 0x8d, 0x54, 0xb1, 0xb8, 0x43, 0xbd, 0xff, 0xb7, 0xc3, 0xff, 0x18, 0x70,
-	0x6b, 0x71, 0x6e, 0x31, 0x15, 0x43, 0x9f, 0x0b, 0xba, 0xc4, 0xe5, 0xcf, 0xa0, 0x94, 0x98, 0xba,
-	0x60, 0x3a, 0x53, 0x5d, 0x81, 0x27, 0x0a, 0xaa, 0x13, 0xd5, 0x63, 0x44, 0x5f, 0xe3, 0x59, 0x7d,
-	0xbd, 0x24, 0xf4, 0xa4, 0x79, 0x72, 0xe9, 0xe6, 0x99, 0x0d, 0x37, 0x3f, 0x1f, 0xee, 0x4d, 0x80,
-	0x70, 0xc3, 0xd9, 0xa3, 0x80, 0x99, 0x2b, 0x3a, 0xd8, 0x52, 0xc8, 0x39, 0x0a, 0x98, 0x85, 0x61,
-	0x73, 0x3e, 0xd2, 0x7d, 0x4a, 0xce, 0x97, 0x85, 0x38, 0x6b, 0x32, 0x33, 0x67, 0xd2, 0xfa, 0x06,
-	0x6e, 0xa7, 0x26, 0x37, 0xbc, 0x1c, 0x67, 0x97, 0xe9, 0x12, 0xf4, 0x69, 0x6f, 0x33, 0xb3, 0xde,
-	0xfe, 0x6e, 0x40, 0xf9, 0x25, 0x39, 0x1b, 0xc5, 0x9b, 0xaf, 0x06, 0x59, 0xc1, 0xfa, 0xd1, 0xd4,
-	0xa9, 0xa3, 0xda, 0x85, 0x92, 0x0d, 0xa8, 0x90, 0x64, 0x30, 0xd4, 0xfa, 0x39, 0x3c, 0x61, 0x28,
-	0xa3, 0xd2, 0x1f, 0x32, 0x47, 0xa7, 0xb7, 0x82, 0x43, 0x42, 0xbf, 0x29, 0xc9, 0xd8, 0xf3, 0x49,
-	0xdc, 0x2f, 0x31, 0x19, 0x7e, 0x71, 0x5d, 0xc6, 0xfb, 0x51, 0x6a, 0x63, 0x52, 0xdd, 0x24, 0xa7,
-	0x44, 0x9c, 0xea, 0x84, 0x56, 0xb0, 0x3e, 0x23, 0x0b, 0x2a, 0xf2, 0x94, 0x05, 0xee, 0x73, 0x12,
-	0xa8, 0x3c, 0x98, 0x85, 0x70, 0x57, 0xa7, 0x79, 0xd6, 0x8f, 0xb0, 0x95, 0x0a, 0x20, 0x4e, 0x0b,
-	0x95, 0xc4, 0x25, 0x92, 0x28, 0x7b, 0xe7, 0x34, 0x10, 0xf1, 0x4d, 0x52, 0xc5, 0x31, 0xa9, 0xec,
-	0x9d, 0x04, 0xfe, 0x20, 0x0a, 0x49, 0x9f, 0xd1, 0x2a, 0x64, 0xa4, 0xaf, 0x43, 0xc9, 0xe1, 0x8c,
-	0xf4, 0x95, 0x7d, 0xc7, 0xe7, 0x92, 0x72, 0xd9, 0xd3, 0x41, 0xe6, 0xea, 0xd9, 0x9d, 0x0a, 0x9e,
-	0xe2, 0xa9, 0x7f, 0x06, 0x68, 0xde, 0x81, 0x0b, 0x0c, 0x3f, 0x82, 0xe2, 0x20, 0x72, 0x2f, 0xea,
-	0xe8, 0xd4, 0xce, 0x5a, 0x1e, 0x0a, 0x4e, 0xb4, 0xd0, 0x3d, 0x85, 0xa0, 0x65, 0xd4, 0x53, 0x54,
-	0x6d, 0xbd, 0xf5, 0x85, 0x08, 0x38, 0x11, 0xb3, 0xfe, 0x30, 0x60, 0x7b, 0x1e, 0xbb, 0xcd, 0x5d,
-	0xfa, 0xfd, 0x5b, 0xe4, 0xea, 0xdd, 0x5d, 0xde, 0x80, 0x15, 0xff, 0xe4, 0x44, 0x50, 0x19, 0x65,
-	0x37, 0xa2, 0x54, 0x15, 0x04, 0xfb, 0x81, 0x46, 0x7f, 0xb6, 0xf4, 0x79, 0xb6, 0x47, 0x72, 0x49,
-	0x8f, 0x58, 0x7f, 0x1a, 0xb0, 0xb9, 0x24, 0x0a, 0xf4, 0x0c, 0x8a, 0xd1, 0x03, 0x33, 0x7e, 0x0a,
-	0xdc, 0xbd, 0xc8, 0x47, 0xad, 0xd4, 0x88, 0x88, 0xe8, 0x55, 0x90, 0x00, 0x6c, 0x9d, 0x40, 0x75,
-	0xea, 0xd3, 0x82, 0x25, 0xfb, 0x70, 0x7a, 0xc9, 0xde, 0x79, 0xa3, 0xb1, 0x24, 0x2b, 0x93, 0xa5,
-	0xfb, 0xb8, 0xfa, 0xba, 0xdc, 0xb8, 0xfb, 0x69, 0xac, 0x79, 0xbc, 0xa2, 0x4f, 0xf7, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x03, 0x37, 0x5b, 0x30, 0x18, 0x0f, 0x00, 0x00,
+	// 1515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xc9, 0x72, 0x1b, 0x37,
+	0x13, 0xf6, 0x70, 0x91, 0xc8, 0xe6, 0x22, 0x0a, 0xb6, 0xa4, 0xb1, 0xbc, 0x48, 0x9e, 0x7f, 0x29,
+	0xb9, 0xfe, 0xfa, 0xe9, 0x58, 0x4e, 0xaa, 0x5c, 0xd9, 0x6c, 0x5a, 0x62, 0x39, 0x8c, 0x25, 0xd2,
+	0x06, 0xa9, 0x38, 0xf6, 0x21, 0x53, 0xd0, 0x0c, 0x44, 0x21, 0x1a, 0xce, 0x30, 0x03, 0x50, 0x15,
+	0xe6, 0x90, 0x43, 0x5e, 0x22, 0xb9, 0xe7, 0x92, 0x53, 0x5e, 0x21, 0x87, 0xdc, 0x73, 0xcf, 0x1b,
+	0xe4, 0x31, 0x52, 0x00, 0x66, 0xc8, 0xe1, 0x26, 0x39, 0xe5, 0x4a, 0x55, 0x4e, 0x9c, 0x06, 0xba,
+	0xbf, 0x6e, 0x7c, 0x68, 0x74, 0x37, 0x61, 0xd5, 0x09, 0x7a, 0xbd, 0x81, 0xcf, 0x04, 0xa3, 0xbc,
+	0xda, 0x0f, 0x03, 0x11, 0xa0, 0x9c, 0xfa, 0x39, 0x1e, 0x9c, 0x6c, 0x5e, 0x75, 0x4e, 0x89, 0xb0,
+	0x99, 0x4b, 0x7d, 0xc1, 0xc4, 0x50, 0x6f, 0x5b, 0xe7, 0x90, 0x7d, 0x1a, 0x12, 0x5f, 0xa0, 0x3b,
+	0x50, 0x8c, 0x8d, 0x87, 0x36, 0x73, 0x4d, 0x63, 0xdb, 0xd8, 0x29, 0xe2, 0xc2, 0x68, 0xad, 0xe1,
+	0xa2, 0x1b, 0x90, 0xef, 0xd1, 0xde, 0x31, 0x0d, 0xe5, 0x7e, 0x4a, 0xed, 0xe7, 0xf4, 0x42, 0xc3,
+	0x45, 0x1b, 0xb0, 0x1c, 0xe1, 0x9b, 0xe9, 0x6d, 0x63, 0x27, 0x8f, 0x97, 0xa4, 0xd8, 0x70, 0xd1,
+	0x35, 0xc8, 0x3a, 0x5e, 0xe0, 0x9c, 0x99, 0x99, 0x6d, 0x63, 0x27, 0x83, 0xb5, 0x60, 0xfd, 0x68,
+	0xc0, 0xca, 0x5e, 0x8c, 0x7d, 0xa8, 0x40, 0xd0, 0x7b, 0x90, 0x0d, 0x03, 0x8f, 0x72, 0xd3, 0xd8,
+	0x4e, 0xef, 0x94, 0x77, 0xb7, 0xaa, 0x71, 0xe8, 0xd5, 0x29, 0xcd, 0x2a, 0x96, 0x6a, 0x58, 0x6b,
+	0x5b, 0xaf, 0x20, 0xab, 0x64, 0x54, 0x81, 0xe2, 0x51, 0xf3, 0x59, 0xb3, 0xf5, 0xb2, 0x69, 0xe3,
+	0xd6, 0x41, 0xbd, 0x72, 0x05, 0x15, 0x21, 0x27, 0xbf, 0xec, 0xda, 0xc1, 0x41, 0xc5, 0x40, 0x6b,
+	0xb0, 0xaa, 0xa4, 0xc3, 0x5a, 0xb3, 0xf6, 0xb4, 0x6e, 0x1f, 0xb5, 0xeb, 0xb8, 0x5d, 0x49, 0xa1,
+	0xeb, 0xb0, 0xa6, 0x97, 0x5b, 0xfb, 0x75, 0x5c, 0xeb, 0xd4, 0xed, 0xbd, 0x56, 0xb3, 0x53, 0x6f,
+	0x76, 0x2a, 0x69, 0xeb, 0xfb, 0x14, 0x5c, 0x1b, 0xf9, 0x7e, 0x4e, 0xc3, 0x1e, 0xe3, 0x9c, 0x05,
+	0x3e, 0x47, 0xd7, 0x21, 0x47, 0x7d, 0x6e, 0x07, 0xbe, 0x37, 0x54, 0x4c, 0xe5, 0xf0, 0x32, 0xf5,
+	0x79, 0xcb, 0xf7, 0x86, 0xc8, 0x84, 0xe5, 0x7e, 0xc8, 0xce, 0x89, 0xa0, 0x8a, 0xa3, 0x1c, 0x8e,
+	0x45, 0xf4, 0x11, 0x2c, 0x11, 0xc7, 0xa1, 0x9c, 0x2b, 0x86, 0xca, 0xbb, 0xff, 0x99, 0x73, 0xc0,
+	0x84, 0x93, 0x6a, 0x4d, 0x29, 0xe3, 0xc8, 0x68, 0x4c, 0x4f, 0xe6, 0x2f, 0xd1, 0xd3, 0x81, 0x25,
+	0x0d, 0x84, 0x10, 0x94, 0x63, 0x7e, 0x6a, 0x7b, 0x7b, 0xf5, 0x76, 0xbb, 0x72, 0x05, 0xad, 0x42,
+	0xa9, 0xd9, 0xb2, 0x0f, 0xeb, 0x87, 0x4f, 0xea, 0xb8, 0xfd, 0x49, 0xe3, 0x79, 0xc5, 0x40, 0x57,
+	0x61, 0xa5, 0xd1, 0xfc, 0xac, 0xd1, 0xa9, 0x75, 0x1a, 0xad, 0xa6, 0xdd, 0x6a, 0x1e, 0xbc, 0xaa,
+	0xa4, 0x50, 0x19, 0xa0, 0xd5, 0xb4, 0x71, 0xfd, 0xc5, 0x51, 0xbd, 0x2d, 0x99, 0xf9, 0x2e, 0x9f,
+	0x60, 0x66, 0x9f, 0x72, 0x27, 0x64, 0x7d, 0xc1, 0x02, 0x7f, 0x7c, 0xdd, 0x46, 0xe2, 0xba, 0x51,
+	0x1d, 0x96, 0x75, 0xa6, 0x70, 0x33, 0xb5, 0x9d, 0xde, 0x29, 0xec, 0xfe, 0x6f, 0x4e, 0xf4, 0x09,
+	0x98, 0xaa, 0x3e, 0x09, 0xaf, 0xfb, 0x22, 0x1c, 0xe2, 0xd8, 0x16, 0x3d, 0x86, 0x42, 0x7f, 0x4c,
+	0x90, 0xa2, 0xb1, 0xb0, 0x7b, 0xfb, 0x62, 0x1a, 0x71, 0xd2, 0x04, 0xed, 0x42, 0x2e, 0x7e, 0x01,
+	0x66, 0x56, 0x99, 0xaf, 0x27, 0xcc, 0x55, 0xc6, 0xea, 0x5d, 0x3c, 0xd2, 0x43, 0x8f, 0x20, 0x2b,
+	0x73, 0x99, 0x9b, 0x4b, 0x2a, 0xf4, 0xbb, 0x97, 0x84, 0x2e, 0x51, 0xa2, 0xc0, 0xb5, 0x9d, 0xcc,
+	0x96, 0x63, 0xe2, 0xdb, 0x1e, 0xe3, 0xc2, 0x5c, 0xde, 0x4e, 0xef, 0xe4, 0xf1, 0xf2, 0x31, 0xf1,
+	0x0f, 0x18, 0x17, 0xa8, 0x09, 0xe0, 0x10, 0x41, 0xbb, 0x41, 0xc8, 0x28, 0x37, 0x73, 0xca, 0x41,
+	0xf5, 0x32, 0x07, 0x23, 0x03, 0xed, 0x25, 0x81, 0x80, 0x1e, 0x82, 0x49, 0x42, 0xe7, 0x94, 0x9d,
+	0x53, 0xbb, 0x47, 0xba, 0x3e, 0x15, 0x1e, 0xf3, 0xcf, 0x6c, 0x7d, 0x23, 0x79, 0x75, 0x23, 0xeb,
+	0xd1, 0xfe, 0xe1, 0x68, 0x7b, 0x4f, 0x5d, 0xd1, 0x53, 0x28, 0x13, 0xb7, 0xc7, 0x7c, 0x9b, 0x53,
+	0x21, 0x98, 0xdf, 0xe5, 0x26, 0x28, 0x7e, 0xb6, 0xe7, 0x44, 0x53, 0x93, 0x8a, 0xed, 0x48, 0x0f,
+	0x97, 0x48, 0x52, 0x44, 0xff, 0x82, 0x12, 0xf3, 0x45, 0x18, 0xd8, 0x3d, 0xca, 0x39, 0xe9, 0x52,
+	0xb3, 0xa0, 0xea, 0x41, 0x51, 0x2d, 0x1e, 0xea, 0x35, 0xa9, 0x14, 0x0c, 0x92, 0x4a, 0x45, 0xad,
+	0xa4, 0x16, 0x63, 0xa5, 0x9b, 0x90, 0xa7, 0xbe, 0x13, 0x0e, 0xfb, 0x82, 0xba, 0x66, 0x49, 0x3d,
+	0xa6, 0xf1, 0x02, 0x42, 0x90, 0x11, 0xa4, 0xcb, 0xcd, 0xb2, 0x62, 0x54, 0x7d, 0xa3, 0x23, 0x28,
+	0x39, 0x03, 0x2e, 0x82, 0x9e, 0x4d, 0x7b, 0xc1, 0x97, 0x8c, 0x9b, 0x2b, 0x8a, 0xd1, 0x77, 0x2e,
+	0x63, 0x54, 0xd9, 0xd4, 0x95, 0x89, 0xe6, 0xb4, 0xe8, 0x24, 0x96, 0x50, 0x15, 0xb2, 0xfc, 0x94,
+	0x84, 0xae, 0x59, 0x51, 0x94, 0x98, 0x73, 0xe0, 0xda, 0x72, 0x1f, 0x6b, 0xb5, 0xcd, 0x23, 0x28,
+	0x26, 0x13, 0x18, 0x55, 0x20, 0x7d, 0x46, 0x75, 0xa5, 0xc8, 0x63, 0xf9, 0x89, 0xee, 0x41, 0xf6,
+	0x9c, 0x78, 0x03, 0x5d, 0x23, 0x0a, 0xbb, 0xd7, 0x17, 0x3e, 0x66, 0xac, 0xf5, 0xde, 0x4f, 0x3d,
+	0x34, 0x36, 0x5f, 0x00, 0x8c, 0x93, 0x6b, 0x0e, 0xe8, 0xff, 0x27, 0x41, 0x37, 0xe6, 0x80, 0x4a,
+	0xfb, 0x24, 0xe4, 0x6b, 0x58, 0x99, 0x4a, 0xa7, 0x39, 0xb8, 0xf7, 0x27, 0x71, 0x6f, 0xcc, 0xc3,
+	0xd5, 0x20, 0xc3, 0x24, 0xb6, 0x0d, 0xab, 0x33, 0xc4, 0xce, 0x41, 0x7f, 0x77, 0x12, 0x7d, 0xde,
+	0x73, 0x4e, 0xc0, 0x24, 0x1c, 0x58, 0xff, 0x85, 0xf2, 0x24, 0xff, 0xb2, 0xfa, 0x30, 0xdf, 0xa5,
+	0x5f, 0x2b, 0xfc, 0x12, 0xd6, 0x82, 0xb5, 0x9f, 0xa8, 0x55, 0x09, 0x28, 0x99, 0x41, 0x3e, 0xe9,
+	0xd1, 0x28, 0x18, 0xf5, 0xad, 0xca, 0x37, 0x19, 0x7a, 0x01, 0x89, 0x5b, 0x5c, 0x2c, 0x5a, 0x5f,
+	0xc0, 0xfa, 0xfc, 0x07, 0x80, 0xf6, 0x61, 0xab, 0xcf, 0xfc, 0x38, 0x95, 0x6d, 0xe2, 0x79, 0x76,
+	0x54, 0xb1, 0x6c, 0xea, 0x93, 0x63, 0x8f, 0xba, 0x51, 0x93, 0xb8, 0xd1, 0x67, 0x7e, 0x94, 0xdc,
+	0x35, 0xcf, 0x1b, 0xa5, 0x88, 0x52, 0xb1, 0x7e, 0x4f, 0x41, 0x69, 0xe2, 0x9e, 0xd0, 0xc7, 0xe3,
+	0xaa, 0x69, 0xa8, 0x3c, 0xfe, 0xf7, 0x82, 0x1b, 0x7d, 0xb3, 0x72, 0x99, 0x7a, 0xbb, 0x72, 0x99,
+	0x7e, 0xc3, 0x72, 0xb9, 0x05, 0x85, 0xa8, 0x20, 0xa9, 0x41, 0x22, 0xa3, 0xc8, 0x8d, 0x6b, 0x94,
+	0x9c, 0x23, 0x36, 0x21, 0xd7, 0x0f, 0x38, 0x93, 0x2f, 0x4f, 0xd5, 0xe0, 0x2c, 0x1e, 0xc9, 0x7f,
+	0xd3, 0xcb, 0xb1, 0x5c, 0x58, 0x9d, 0x49, 0xd5, 0xe9, 0x40, 0x8d, 0x99, 0x40, 0xe3, 0xfc, 0x48,
+	0x25, 0xf2, 0x23, 0x19, 0x7c, 0x7a, 0x32, 0x78, 0xeb, 0x07, 0x03, 0xae, 0x8e, 0xdc, 0x34, 0xfc,
+	0x73, 0x26, 0x88, 0xea, 0x89, 0x0f, 0x60, 0x6d, 0x3c, 0x5b, 0xb9, 0xe3, 0xba, 0x13, 0x0d, 0x59,
+	0xd7, 0x9c, 0x05, 0x8d, 0xb4, 0x2b, 0x27, 0xb3, 0x28, 0x0d, 0xb5, 0xb0, 0x78, 0xcc, 0xba, 0x05,
+	0xd0, 0x1f, 0x1c, 0x7b, 0xcc, 0xb1, 0x25, 0x5f, 0x19, 0x65, 0x93, 0xd7, 0x2b, 0xcf, 0xe8, 0xd0,
+	0xfa, 0xc9, 0x48, 0x64, 0x2f, 0xa6, 0x5f, 0x0d, 0x28, 0x17, 0x9d, 0xe0, 0xd3, 0x80, 0x2d, 0xea,
+	0xd8, 0xd1, 0x84, 0x93, 0x38, 0xbf, 0x9c, 0x70, 0x9a, 0x92, 0x82, 0x85, 0x31, 0x4c, 0xcf, 0x90,
+	0x99, 0xd9, 0x19, 0xf2, 0x0e, 0x14, 0x5d, 0xc6, 0xfb, 0x1e, 0x19, 0x6a, 0xe8, 0xac, 0x02, 0x28,
+	0x44, 0x6b, 0x12, 0xde, 0xfa, 0xd9, 0x80, 0x9b, 0x89, 0xcb, 0xf2, 0x1d, 0xea, 0xfd, 0xb3, 0x03,
+	0xfe, 0xc3, 0x80, 0xdb, 0xf3, 0xb9, 0xc5, 0x94, 0xf7, 0x03, 0x9f, 0xd3, 0x05, 0x21, 0x7f, 0x08,
+	0xf9, 0x91, 0xab, 0x0b, 0x5e, 0x67, 0x22, 0x2b, 0xf0, 0xd8, 0x40, 0x66, 0xa2, 0x9c, 0x0c, 0x55,
+	0x73, 0x4c, 0xab, 0xf2, 0x32, 0x92, 0xc7, 0xc9, 0x93, 0x49, 0x26, 0xcf, 0xf4, 0x71, 0xb3, 0xb3,
+	0xc7, 0xbd, 0x05, 0xa0, 0xe7, 0x06, 0x7b, 0x10, 0x32, 0x73, 0x49, 0x1d, 0x36, 0xaf, 0x57, 0x8e,
+	0x42, 0x66, 0x61, 0xd8, 0x98, 0x3d, 0xe9, 0x01, 0x25, 0xe7, 0x8b, 0x8e, 0x38, 0xed, 0x32, 0x35,
+	0xe3, 0xd2, 0xfa, 0x1c, 0xee, 0x24, 0x5e, 0xae, 0x2e, 0x8e, 0xd3, 0x23, 0xca, 0x02, 0xf4, 0xc9,
+	0x68, 0x53, 0xd3, 0xd1, 0xfe, 0x62, 0x40, 0xe1, 0x25, 0x39, 0x1b, 0xc4, 0xf3, 0x44, 0x05, 0xd2,
+	0x9c, 0x75, 0xa3, 0x57, 0x27, 0x3f, 0xe5, 0x84, 0x21, 0x58, 0x8f, 0x72, 0x41, 0x7a, 0x7d, 0x65,
+	0x9f, 0xc1, 0xe3, 0x05, 0xe9, 0x54, 0x04, 0x7d, 0xe6, 0x28, 0x7a, 0x8b, 0x58, 0x0b, 0xc9, 0x0e,
+	0x91, 0x99, 0xe8, 0x10, 0x7a, 0xc7, 0x75, 0x99, 0xdf, 0x8d, 0xa8, 0x8d, 0x45, 0x59, 0x49, 0x4e,
+	0x09, 0x3f, 0x55, 0x84, 0x16, 0xb1, 0xfa, 0x46, 0x16, 0x14, 0xc5, 0x29, 0x0b, 0xdd, 0xe7, 0x24,
+	0x94, 0x3c, 0x98, 0xcb, 0x7a, 0x02, 0x4a, 0xae, 0x59, 0xdf, 0xc2, 0x66, 0xe2, 0x00, 0x31, 0x2d,
+	0x54, 0x10, 0x97, 0x08, 0x22, 0xfd, 0x9d, 0xd3, 0x90, 0xc7, 0x95, 0xa4, 0x84, 0x63, 0x51, 0xfa,
+	0x3b, 0x09, 0x83, 0x5e, 0x74, 0x24, 0xf5, 0x8d, 0xca, 0x90, 0x12, 0x81, 0x3a, 0x4a, 0x06, 0xa7,
+	0x44, 0x20, 0xfd, 0x3b, 0x81, 0x2f, 0xa8, 0x2f, 0x3a, 0xea, 0x90, 0xf2, 0x6f, 0x45, 0x11, 0x4f,
+	0xac, 0xc9, 0xbf, 0x69, 0x68, 0x36, 0x80, 0x0b, 0x1c, 0x3f, 0x86, 0x5c, 0x2f, 0x0a, 0x2f, 0xca,
+	0xe8, 0x44, 0xcf, 0x5a, 0x7c, 0x14, 0x3c, 0xb2, 0x42, 0xf7, 0x25, 0x82, 0xd2, 0x91, 0x03, 0xbe,
+	0xec, 0x7a, 0x6b, 0x73, 0x11, 0xf0, 0x48, 0xcd, 0xfa, 0xd5, 0x80, 0xad, 0x59, 0xec, 0x86, 0xec,
+	0xfd, 0x6f, 0xc0, 0xd5, 0xdb, 0x87, 0xbc, 0x0e, 0x4b, 0xc1, 0xc9, 0x09, 0xa7, 0x22, 0x62, 0x37,
+	0x92, 0xe4, 0x2d, 0x70, 0xf6, 0x0d, 0x8d, 0xfe, 0xf9, 0xaa, 0xef, 0xe9, 0x1c, 0xc9, 0x8c, 0x72,
+	0xc4, 0xfa, 0xcd, 0x80, 0x8d, 0x05, 0xa7, 0x40, 0xcf, 0x20, 0x17, 0x8d, 0xed, 0xf1, 0x28, 0x70,
+	0xef, 0xa2, 0x18, 0x95, 0x51, 0x35, 0x12, 0xa2, 0xa9, 0x60, 0x04, 0xb0, 0x79, 0x02, 0xa5, 0x89,
+	0xad, 0x39, 0x4d, 0xf6, 0xd1, 0x64, 0x93, 0xbd, 0x7b, 0xa9, 0xb3, 0x11, 0x2b, 0xe3, 0xa6, 0xfb,
+	0xa4, 0xf4, 0xba, 0x50, 0xbd, 0xf7, 0x41, 0x6c, 0x79, 0xbc, 0xa4, 0xbe, 0x1e, 0xfc, 0x19, 0x00,
+	0x00, 0xff, 0xff, 0x9c, 0xbe, 0x69, 0xc9, 0xa5, 0x10, 0x00, 0x00,
 }
@@ -75,7 +75,12 @@ type EmojiReaction struct {
 	// whether this is a rectraction of a previously sent emoji
 	Retracted bool `protobuf:"varint,6,opt,name=retracted,proto3" json:"retracted,omitempty"`
 	// Grant for organisation chat messages
-	Grant                []byte   `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	Grant []byte `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	// emoji the unicode emoji sequence the user wishes to react with, type is
+	// still set for emojis that have one, for backward compatibility
+	Emoji string `protobuf:"bytes,8,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// custom_emoji_id the ID of the community custom emoji the user wishes to react with
+	CustomEmojiId        string   `protobuf:"bytes,9,opt,name=custom_emoji_id,json=customEmojiId,proto3" json:"custom_emoji_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -155,6 +160,20 @@ func (m *EmojiReaction) GetGrant() []byte {
 	return nil
 }
 
+func (m *EmojiReaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *EmojiReaction) GetCustomEmojiId() string {
+	if m != nil {
+		return m.CustomEmojiId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.EmojiReaction_Type", EmojiReaction_Type_name, EmojiReaction_Type_value)
 	proto.RegisterType((*EmojiReaction)(nil), "protobuf.EmojiReaction")
@@ -165,26 +184,28 @@ func init() {
 }
 
 var fileDescriptor_0a088c907bbc7ed6 = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xcd, 0x6e, 0xaa, 0x40,
-	0x14, 0xc7, 0x2f, 0x0a, 0x28, 0x07, 0xbd, 0x77, 0x32, 0xf1, 0xa6, 0xa4, 0xb5, 0x29, 0x71, 0xc5,
-	0x8a, 0x36, 0xed, 0xa6, 0x49, 0x57, 0x58, 0x89, 0xd2, 0x2a, 0x98, 0x11, 0x6a, 0xec, 0x86, 0x20,
-	0x4c, 0xad, 0x6d, 0x11, 0x82, 0xe3, 0xc2, 0xa7, 0xee, 0x2b, 0x34, 0x0c, 0x1a, 0xd3, 0xd5, 0xcc,
-	0xef, 0xff, 0x91, 0x73, 0x0e, 0x74, 0x68, 0x9a, 0x7d, 0xac, 0xc3, 0x82, 0x46, 0x31, 0x5b, 0x67,
-	0x1b, 0x33, 0x2f, 0x32, 0x96, 0xe1, 0x26, 0x7f, 0x96, 0xbb, 0xb7, 0x73, 0x95, 0x6e, 0x76, 0xe9,
-	0xb6, 0x92, 0x7b, 0xdf, 0x35, 0x68, 0xdb, 0x65, 0x9e, 0x1c, 0xe2, 0xb8, 0x03, 0x52, 0xfc, 0x95,
-	0xc5, 0x9f, 0x9a, 0xa0, 0x0b, 0x86, 0x48, 0x2a, 0xc0, 0x67, 0xd0, 0x88, 0xdf, 0x23, 0x16, 0xae,
-	0x13, 0xad, 0xa6, 0x0b, 0x86, 0x42, 0xe4, 0x12, 0x9d, 0x04, 0x5f, 0x02, 0xa4, 0x74, 0xbb, 0x8d,
-	0x56, 0xb4, 0xf4, 0xea, 0xdc, 0x53, 0x0e, 0x8a, 0x93, 0xe0, 0x7b, 0x68, 0x1d, 0x6d, 0xb6, 0xcf,
-	0xa9, 0x26, 0xea, 0x82, 0xf1, 0xf7, 0xf6, 0xbf, 0x79, 0xdc, 0xc6, 0x9c, 0x54, 0xae, 0xbf, 0xcf,
-	0x29, 0x51, 0xd3, 0x13, 0xe0, 0x1b, 0x10, 0x79, 0x43, 0xe2, 0x8d, 0xee, 0xa9, 0xf1, 0x6b, 0x5d,
-	0x93, 0x17, 0x79, 0x12, 0x77, 0x41, 0x29, 0x28, 0x2b, 0xa2, 0x98, 0xd1, 0x44, 0x93, 0x75, 0xc1,
-	0x68, 0x92, 0x93, 0x50, 0xde, 0xb5, 0x2a, 0xa2, 0x0d, 0xd3, 0x1a, 0xba, 0x60, 0xb4, 0x48, 0x05,
-	0xbd, 0x1c, 0x44, 0x3e, 0xed, 0x0a, 0x2e, 0x02, 0xf7, 0xd9, 0xf5, 0xe6, 0x6e, 0x68, 0x4f, 0xbc,
-	0x27, 0x27, 0x24, 0xb6, 0xf5, 0xe8, 0x3b, 0x9e, 0x1b, 0xfa, 0x8b, 0xa9, 0x8d, 0xfe, 0xe0, 0x26,
-	0x88, 0x63, 0xef, 0xc5, 0x46, 0x02, 0x6e, 0x83, 0xe2, 0x8f, 0x82, 0x49, 0x7f, 0x16, 0x06, 0x53,
-	0x54, 0xc3, 0xff, 0x40, 0x3d, 0xe0, 0xc0, 0x9b, 0xbb, 0xa8, 0x8e, 0x15, 0x90, 0xc6, 0x56, 0x30,
-	0x1c, 0x21, 0x11, 0x37, 0xa0, 0x3e, 0xb3, 0x06, 0x48, 0x2a, 0x35, 0xcb, 0x1d, 0x92, 0x05, 0x92,
-	0xfb, 0xed, 0x57, 0xd5, 0xbc, 0x7e, 0x38, 0x5e, 0xb3, 0x94, 0xf9, 0xef, 0xee, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x7e, 0x57, 0x12, 0xd9, 0xb6, 0x01, 0x00, 0x00,
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcf, 0x8f, 0x9a, 0x40,
+	0x1c, 0xc5, 0x8b, 0xfc, 0x10, 0xbe, 0x48, 0x25, 0x13, 0x9b, 0x92, 0xd6, 0xa6, 0xc4, 0x43, 0xc3,
+	0x89, 0x36, 0xed, 0xa5, 0x49, 0x4f, 0x58, 0x89, 0xd2, 0x2a, 0x98, 0x11, 0x6a, 0xec, 0x85, 0x20,
+	0xcc, 0xba, 0xec, 0x2e, 0x42, 0x60, 0x3c, 0xf8, 0x47, 0xed, 0xff, 0xb8, 0x61, 0xd0, 0x98, 0x3d,
+	0xc1, 0xfb, 0xbc, 0xef, 0x4b, 0xde, 0x3c, 0x18, 0x91, 0xa2, 0x7c, 0xc8, 0xe3, 0x9a, 0x24, 0x29,
+	0xcd, 0xcb, 0xa3, 0x5d, 0xd5, 0x25, 0x2d, 0x91, 0xcc, 0x3e, 0xfb, 0xd3, 0xdd, 0x07, 0x95, 0x1c,
+	0x4f, 0x45, 0xd3, 0xe1, 0xc9, 0x33, 0x0f, 0x9a, 0xdb, 0xde, 0xe3, 0xcb, 0x39, 0x1a, 0x81, 0x98,
+	0x3e, 0x95, 0xe9, 0xa3, 0xc1, 0x99, 0x9c, 0x25, 0xe0, 0x4e, 0xa0, 0xf7, 0xd0, 0x4f, 0xef, 0x13,
+	0x1a, 0xe7, 0x99, 0xd1, 0x33, 0x39, 0x4b, 0xc1, 0x52, 0x2b, 0xbd, 0x0c, 0x7d, 0x02, 0x28, 0x48,
+	0xd3, 0x24, 0x07, 0xd2, 0x7a, 0x3c, 0xf3, 0x94, 0x0b, 0xf1, 0x32, 0xf4, 0x13, 0x06, 0x57, 0x9b,
+	0x9e, 0x2b, 0x62, 0x08, 0x26, 0x67, 0xbd, 0xfd, 0xfe, 0xce, 0xbe, 0xb6, 0xb1, 0x57, 0x9d, 0x1b,
+	0x9e, 0x2b, 0x82, 0xd5, 0xe2, 0x26, 0xd0, 0x37, 0x10, 0x58, 0x42, 0x64, 0x89, 0xf1, 0x2d, 0xf1,
+	0xaa, 0xae, 0xcd, 0x82, 0xec, 0x12, 0x8d, 0x41, 0xa9, 0x09, 0xad, 0x93, 0x94, 0x92, 0xcc, 0x90,
+	0x4c, 0xce, 0x92, 0xf1, 0x0d, 0xb4, 0xef, 0x3a, 0xd4, 0xc9, 0x91, 0x1a, 0x7d, 0x93, 0xb3, 0x06,
+	0xb8, 0x13, 0x2d, 0x65, 0x73, 0x19, 0x32, 0x6b, 0xde, 0x09, 0xf4, 0x05, 0x86, 0xe9, 0xa9, 0xa1,
+	0x65, 0x11, 0x77, 0x5b, 0xe6, 0x99, 0xa1, 0x30, 0x5f, 0xeb, 0x30, 0xab, 0xe0, 0x65, 0x93, 0x0a,
+	0x04, 0xd6, 0xf5, 0x33, 0x7c, 0x8c, 0xfc, 0xbf, 0x7e, 0xb0, 0xf5, 0x63, 0x77, 0x15, 0xfc, 0xf1,
+	0x62, 0xec, 0x3a, 0xbf, 0x43, 0x2f, 0xf0, 0xe3, 0x70, 0xb7, 0x76, 0xf5, 0x37, 0x48, 0x06, 0x61,
+	0x19, 0xfc, 0x73, 0x75, 0x0e, 0x69, 0xa0, 0x84, 0x8b, 0x68, 0x35, 0xdd, 0xc4, 0xd1, 0x5a, 0xef,
+	0xa1, 0x21, 0xa8, 0x17, 0x39, 0x0b, 0xb6, 0xbe, 0xce, 0x23, 0x05, 0xc4, 0xa5, 0x13, 0xcd, 0x17,
+	0xba, 0x80, 0xfa, 0xc0, 0x6f, 0x9c, 0x99, 0x2e, 0xb6, 0xcc, 0xf1, 0xe7, 0x78, 0xa7, 0x4b, 0x53,
+	0xed, 0xbf, 0x6a, 0x7f, 0xfd, 0x75, 0xdd, 0x62, 0x2f, 0xb1, 0xbf, 0x1f, 0x2f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x6a, 0xff, 0xae, 0x95, 0xf4, 0x01, 0x00, 0x00,
 }
@@ -45,7 +45,7 @@ func (x SyncTrustedUser_TrustStatus) String() string {
 }
 
 func (SyncTrustedUser_TrustStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{25, 0}
+	return fileDescriptor_d61ab7221f0b5518, []int{28, 0}
 }
 
 type SyncVerificationRequest_VerificationStatus int32
@@ -79,7 +79,7 @@ func (x SyncVerificationRequest_VerificationStatus) String() string {
 }
 
 func (SyncVerificationRequest_VerificationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{26, 0}
+	return fileDescriptor_d61ab7221f0b5518, []int{29, 0}
 }
 
 type SyncContactRequestDecision_DecisionStatus int32
@@ -104,7 +104,7 @@ func (x SyncContactRequestDecision_DecisionStatus) String() string {
 }
 
 func (SyncContactRequestDecision_DecisionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{27, 0}
+	return fileDescriptor_d61ab7221f0b5518, []int{30, 0}
 }
 
 // `FetchingBackedUpDataDetails` is used to describe how many messages a single backup data structure consists of
@@ -662,6 +662,105 @@ func (m *PairInstallation) GetName() string {
 	return ""
 }
 
+// InstallationRevocation is broadcast by one of our installations when another one
+// is lost or stolen, so that neither us nor our contacts encrypt to it anymore
+type InstallationRevocation struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	InstallationId       string   `protobuf:"bytes,2,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstallationRevocation) Reset()         { *m = InstallationRevocation{} }
+func (m *InstallationRevocation) String() string { return proto.CompactTextString(m) }
+func (*InstallationRevocation) ProtoMessage()    {}
+func (*InstallationRevocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{5}
+}
+
+func (m *InstallationRevocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstallationRevocation.Unmarshal(m, b)
+}
+func (m *InstallationRevocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstallationRevocation.Marshal(b, m, deterministic)
+}
+func (m *InstallationRevocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallationRevocation.Merge(m, src)
+}
+func (m *InstallationRevocation) XXX_Size() int {
+	return xxx_messageInfo_InstallationRevocation.Size(m)
+}
+func (m *InstallationRevocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallationRevocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstallationRevocation proto.InternalMessageInfo
+
+func (m *InstallationRevocation) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *InstallationRevocation) GetInstallationId() string {
+	if m != nil {
+		return m.InstallationId
+	}
+	return ""
+}
+
+// SessionReset is sent when the double ratchet sessions with a contact got out of sync,
+// asking them to negotiate new sessions and to resend the messages that couldn't be decrypted
+type SessionReset struct {
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// The installation of the sender whose sessions should be dropped
+	InstallationId       string   `protobuf:"bytes,2,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionReset) Reset()         { *m = SessionReset{} }
+func (m *SessionReset) String() string { return proto.CompactTextString(m) }
+func (*SessionReset) ProtoMessage()    {}
+func (*SessionReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{6}
+}
+
+func (m *SessionReset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionReset.Unmarshal(m, b)
+}
+func (m *SessionReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionReset.Marshal(b, m, deterministic)
+}
+func (m *SessionReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionReset.Merge(m, src)
+}
+func (m *SessionReset) XXX_Size() int {
+	return xxx_messageInfo_SessionReset.Size(m)
+}
+func (m *SessionReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionReset proto.InternalMessageInfo
+
+func (m *SessionReset) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SessionReset) GetInstallationId() string {
+	if m != nil {
+		return m.InstallationId
+	}
+	return ""
+}
+
 type SyncInstallationContact struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -679,7 +778,7 @@ func (m *SyncInstallationContact) Reset()         { *m = SyncInstallationContact
 func (m *SyncInstallationContact) String() string { return proto.CompactTextString(m) }
 func (*SyncInstallationContact) ProtoMessage()    {}
 func (*SyncInstallationContact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{5}
+	return fileDescriptor_d61ab7221f0b5518, []int{7}
 }
 
 func (m *SyncInstallationContact) XXX_Unmarshal(b []byte) error {
@@ -773,7 +872,7 @@ func (m *SyncInstallationContactV2) Reset()         { *m = SyncInstallationConta
 func (m *SyncInstallationContactV2) String() string { return proto.CompactTextString(m) }
 func (*SyncInstallationContactV2) ProtoMessage()    {}
 func (*SyncInstallationContactV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{6}
+	return fileDescriptor_d61ab7221f0b5518, []int{8}
 }
 
 func (m *SyncInstallationContactV2) XXX_Unmarshal(b []byte) error {
@@ -905,7 +1004,7 @@ func (m *SyncInstallationAccount) Reset()         { *m = SyncInstallationAccount
 func (m *SyncInstallationAccount) String() string { return proto.CompactTextString(m) }
 func (*SyncInstallationAccount) ProtoMessage()    {}
 func (*SyncInstallationAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{7}
+	return fileDescriptor_d61ab7221f0b5518, []int{9}
 }
 
 func (m *SyncInstallationAccount) XXX_Unmarshal(b []byte) error {
@@ -959,7 +1058,7 @@ func (m *SyncInstallationPublicChat) Reset()         { *m = SyncInstallationPubl
 func (m *SyncInstallationPublicChat) String() string { return proto.CompactTextString(m) }
 func (*SyncInstallationPublicChat) ProtoMessage()    {}
 func (*SyncInstallationPublicChat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{8}
+	return fileDescriptor_d61ab7221f0b5518, []int{10}
 }
 
 func (m *SyncInstallationPublicChat) XXX_Unmarshal(b []byte) error {
@@ -1016,7 +1115,7 @@ func (m *SyncCommunity) Reset()         { *m = SyncCommunity{} }
 func (m *SyncCommunity) String() string { return proto.CompactTextString(m) }
 func (*SyncCommunity) ProtoMessage()    {}
 func (*SyncCommunity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{9}
+	return fileDescriptor_d61ab7221f0b5518, []int{11}
 }
 
 func (m *SyncCommunity) XXX_Unmarshal(b []byte) error {
@@ -1138,7 +1237,7 @@ func (m *SyncCommunityRequestsToJoin) Reset()         { *m = SyncCommunityReques
 func (m *SyncCommunityRequestsToJoin) String() string { return proto.CompactTextString(m) }
 func (*SyncCommunityRequestsToJoin) ProtoMessage()    {}
 func (*SyncCommunityRequestsToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{10}
+	return fileDescriptor_d61ab7221f0b5518, []int{12}
 }
 
 func (m *SyncCommunityRequestsToJoin) XXX_Unmarshal(b []byte) error {
@@ -1222,7 +1321,7 @@ func (m *SyncInstallation) Reset()         { *m = SyncInstallation{} }
 func (m *SyncInstallation) String() string { return proto.CompactTextString(m) }
 func (*SyncInstallation) ProtoMessage()    {}
 func (*SyncInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{11}
+	return fileDescriptor_d61ab7221f0b5518, []int{13}
 }
 
 func (m *SyncInstallation) XXX_Unmarshal(b []byte) error {
@@ -1283,7 +1382,7 @@ func (m *SyncChatRemoved) Reset()         { *m = SyncChatRemoved{} }
 func (m *SyncChatRemoved) String() string { return proto.CompactTextString(m) }
 func (*SyncChatRemoved) ProtoMessage()    {}
 func (*SyncChatRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{12}
+	return fileDescriptor_d61ab7221f0b5518, []int{14}
 }
 
 func (m *SyncChatRemoved) XXX_Unmarshal(b []byte) error {
@@ -1330,7 +1429,7 @@ func (m *SyncChatMessagesRead) Reset()         { *m = SyncChatMessagesRead{} }
 func (m *SyncChatMessagesRead) String() string { return proto.CompactTextString(m) }
 func (*SyncChatMessagesRead) ProtoMessage()    {}
 func (*SyncChatMessagesRead) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{13}
+	return fileDescriptor_d61ab7221f0b5518, []int{15}
 }
 
 func (m *SyncChatMessagesRead) XXX_Unmarshal(b []byte) error {
@@ -1377,7 +1476,7 @@ func (m *SyncActivityCenterRead) Reset()         { *m = SyncActivityCenterRead{}
 func (m *SyncActivityCenterRead) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityCenterRead) ProtoMessage()    {}
 func (*SyncActivityCenterRead) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{14}
+	return fileDescriptor_d61ab7221f0b5518, []int{16}
 }
 
 func (m *SyncActivityCenterRead) XXX_Unmarshal(b []byte) error {
@@ -1424,7 +1523,7 @@ func (m *SyncActivityCenterAccepted) Reset()         { *m = SyncActivityCenterAc
 func (m *SyncActivityCenterAccepted) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityCenterAccepted) ProtoMessage()    {}
 func (*SyncActivityCenterAccepted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{15}
+	return fileDescriptor_d61ab7221f0b5518, []int{17}
 }
 
 func (m *SyncActivityCenterAccepted) XXX_Unmarshal(b []byte) error {
@@ -1471,7 +1570,7 @@ func (m *SyncActivityCenterDismissed) Reset()         { *m = SyncActivityCenterD
 func (m *SyncActivityCenterDismissed) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityCenterDismissed) ProtoMessage()    {}
 func (*SyncActivityCenterDismissed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{16}
+	return fileDescriptor_d61ab7221f0b5518, []int{18}
 }
 
 func (m *SyncActivityCenterDismissed) XXX_Unmarshal(b []byte) error {
@@ -1522,7 +1621,7 @@ func (m *SyncBookmark) Reset()         { *m = SyncBookmark{} }
 func (m *SyncBookmark) String() string { return proto.CompactTextString(m) }
 func (*SyncBookmark) ProtoMessage()    {}
 func (*SyncBookmark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{17}
+	return fileDescriptor_d61ab7221f0b5518, []int{19}
 }
 
 func (m *SyncBookmark) XXX_Unmarshal(b []byte) error {
@@ -1597,7 +1696,7 @@ func (m *SyncClearHistory) Reset()         { *m = SyncClearHistory{} }
 func (m *SyncClearHistory) String() string { return proto.CompactTextString(m) }
 func (*SyncClearHistory) ProtoMessage()    {}
 func (*SyncClearHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{18}
+	return fileDescriptor_d61ab7221f0b5518, []int{20}
 }
 
 func (m *SyncClearHistory) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +1748,7 @@ func (m *SyncProfilePicture) Reset()         { *m = SyncProfilePicture{} }
 func (m *SyncProfilePicture) String() string { return proto.CompactTextString(m) }
 func (*SyncProfilePicture) ProtoMessage()    {}
 func (*SyncProfilePicture) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{19}
+	return fileDescriptor_d61ab7221f0b5518, []int{21}
 }
 
 func (m *SyncProfilePicture) XXX_Unmarshal(b []byte) error {
@@ -1731,7 +1830,7 @@ func (m *SyncProfilePictures) Reset()         { *m = SyncProfilePictures{} }
 func (m *SyncProfilePictures) String() string { return proto.CompactTextString(m) }
 func (*SyncProfilePictures) ProtoMessage()    {}
 func (*SyncProfilePictures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{20}
+	return fileDescriptor_d61ab7221f0b5518, []int{22}
 }
 
 func (m *SyncProfilePictures) XXX_Unmarshal(b []byte) error {
@@ -1788,7 +1887,7 @@ func (m *SyncWalletAccount) Reset()         { *m = SyncWalletAccount{} }
 func (m *SyncWalletAccount) String() string { return proto.CompactTextString(m) }
 func (*SyncWalletAccount) ProtoMessage()    {}
 func (*SyncWalletAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{21}
+	return fileDescriptor_d61ab7221f0b5518, []int{23}
 }
 
 func (m *SyncWalletAccount) XXX_Unmarshal(b []byte) error {
@@ -1904,7 +2003,7 @@ func (m *SyncWalletAccounts) Reset()         { *m = SyncWalletAccounts{} }
 func (m *SyncWalletAccounts) String() string { return proto.CompactTextString(m) }
 func (*SyncWalletAccounts) ProtoMessage()    {}
 func (*SyncWalletAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{22}
+	return fileDescriptor_d61ab7221f0b5518, []int{24}
 }
 
 func (m *SyncWalletAccounts) XXX_Unmarshal(b []byte) error {
@@ -1948,7 +2047,7 @@ func (m *SyncSavedAddress) Reset()         { *m = SyncSavedAddress{} }
 func (m *SyncSavedAddress) String() string { return proto.CompactTextString(m) }
 func (*SyncSavedAddress) ProtoMessage()    {}
 func (*SyncSavedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{23}
+	return fileDescriptor_d61ab7221f0b5518, []int{25}
 }
 
 func (m *SyncSavedAddress) XXX_Unmarshal(b []byte) error {
@@ -2011,6 +2110,85 @@ func (m *SyncSavedAddress) GetUpdateClock() uint64 {
 	return 0
 }
 
+type SyncChatDraft struct {
+	Clock                uint64                 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId               string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Text                 string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ResponseTo           string                 `protobuf:"bytes,4,opt,name=response_to,json=responseTo,proto3" json:"response_to,omitempty"`
+	Attachments          []*ChatDraftAttachment `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Removed              bool                   `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SyncChatDraft) Reset()         { *m = SyncChatDraft{} }
+func (m *SyncChatDraft) String() string { return proto.CompactTextString(m) }
+func (*SyncChatDraft) ProtoMessage()    {}
+func (*SyncChatDraft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{26}
+}
+
+func (m *SyncChatDraft) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncChatDraft.Unmarshal(m, b)
+}
+func (m *SyncChatDraft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncChatDraft.Marshal(b, m, deterministic)
+}
+func (m *SyncChatDraft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncChatDraft.Merge(m, src)
+}
+func (m *SyncChatDraft) XXX_Size() int {
+	return xxx_messageInfo_SyncChatDraft.Size(m)
+}
+func (m *SyncChatDraft) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncChatDraft.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncChatDraft proto.InternalMessageInfo
+
+func (m *SyncChatDraft) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncChatDraft) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncChatDraft) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SyncChatDraft) GetResponseTo() string {
+	if m != nil {
+		return m.ResponseTo
+	}
+	return ""
+}
+
+func (m *SyncChatDraft) GetAttachments() []*ChatDraftAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *SyncChatDraft) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
 type SyncCommunitySettings struct {
 	Clock                        uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	CommunityId                  string   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
@@ -2024,7 +2202,7 @@ func (m *SyncCommunitySettings) Reset()         { *m = SyncCommunitySettings{} }
 func (m *SyncCommunitySettings) String() string { return proto.CompactTextString(m) }
 func (*SyncCommunitySettings) ProtoMessage()    {}
 func (*SyncCommunitySettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{24}
+	return fileDescriptor_d61ab7221f0b5518, []int{27}
 }
 
 func (m *SyncCommunitySettings) XXX_Unmarshal(b []byte) error {
@@ -2079,7 +2257,7 @@ func (m *SyncTrustedUser) Reset()         { *m = SyncTrustedUser{} }
 func (m *SyncTrustedUser) String() string { return proto.CompactTextString(m) }
 func (*SyncTrustedUser) ProtoMessage()    {}
 func (*SyncTrustedUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{25}
+	return fileDescriptor_d61ab7221f0b5518, []int{28}
 }
 
 func (m *SyncTrustedUser) XXX_Unmarshal(b []byte) error {
@@ -2140,7 +2318,7 @@ func (m *SyncVerificationRequest) Reset()         { *m = SyncVerificationRequest
 func (m *SyncVerificationRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncVerificationRequest) ProtoMessage()    {}
 func (*SyncVerificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{26}
+	return fileDescriptor_d61ab7221f0b5518, []int{29}
 }
 
 func (m *SyncVerificationRequest) XXX_Unmarshal(b []byte) error {
@@ -2237,7 +2415,7 @@ func (m *SyncContactRequestDecision) Reset()         { *m = SyncContactRequestDe
 func (m *SyncContactRequestDecision) String() string { return proto.CompactTextString(m) }
 func (*SyncContactRequestDecision) ProtoMessage()    {}
 func (*SyncContactRequestDecision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{27}
+	return fileDescriptor_d61ab7221f0b5518, []int{30}
 }
 
 func (m *SyncContactRequestDecision) XXX_Unmarshal(b []byte) error {
@@ -2294,7 +2472,7 @@ func (m *BackedUpProfile) Reset()         { *m = BackedUpProfile{} }
 func (m *BackedUpProfile) String() string { return proto.CompactTextString(m) }
 func (*BackedUpProfile) ProtoMessage()    {}
 func (*BackedUpProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{28}
+	return fileDescriptor_d61ab7221f0b5518, []int{31}
 }
 
 func (m *BackedUpProfile) XXX_Unmarshal(b []byte) error {
@@ -2355,7 +2533,7 @@ func (m *RawMessage) Reset()         { *m = RawMessage{} }
 func (m *RawMessage) String() string { return proto.CompactTextString(m) }
 func (*RawMessage) ProtoMessage()    {}
 func (*RawMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{29}
+	return fileDescriptor_d61ab7221f0b5518, []int{32}
 }
 
 func (m *RawMessage) XXX_Unmarshal(b []byte) error {
@@ -2405,7 +2583,7 @@ func (m *SyncRawMessage) Reset()         { *m = SyncRawMessage{} }
 func (m *SyncRawMessage) String() string { return proto.CompactTextString(m) }
 func (*SyncRawMessage) ProtoMessage()    {}
 func (*SyncRawMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d61ab7221f0b5518, []int{30}
+	return fileDescriptor_d61ab7221f0b5518, []int{33}
 }
 
 func (m *SyncRawMessage) XXX_Unmarshal(b []byte) error {
@@ -2466,6 +2644,8 @@ func init() {
 	proto.RegisterType((*LocalPairingPayload)(nil), "protobuf.LocalPairingPayload")
 	proto.RegisterType((*LocalPairingPayload_Key)(nil), "protobuf.LocalPairingPayload.Key")
 	proto.RegisterType((*PairInstallation)(nil), "protobuf.PairInstallation")
+	proto.RegisterType((*InstallationRevocation)(nil), "protobuf.InstallationRevocation")
+	proto.RegisterType((*SessionReset)(nil), "protobuf.SessionReset")
 	proto.RegisterType((*SyncInstallationContact)(nil), "protobuf.SyncInstallationContact")
 	proto.RegisterType((*SyncInstallationContactV2)(nil), "protobuf.SyncInstallationContactV2")
 	proto.RegisterType((*SyncInstallationAccount)(nil), "protobuf.SyncInstallationAccount")
@@ -2485,6 +2665,7 @@ func init() {
 	proto.RegisterType((*SyncWalletAccount)(nil), "protobuf.SyncWalletAccount")
 	proto.RegisterType((*SyncWalletAccounts)(nil), "protobuf.SyncWalletAccounts")
 	proto.RegisterType((*SyncSavedAddress)(nil), "protobuf.SyncSavedAddress")
+	proto.RegisterType((*SyncChatDraft)(nil), "protobuf.SyncChatDraft")
 	proto.RegisterType((*SyncCommunitySettings)(nil), "protobuf.SyncCommunitySettings")
 	proto.RegisterType((*SyncTrustedUser)(nil), "protobuf.SyncTrustedUser")
 	proto.RegisterType((*SyncVerificationRequest)(nil), "protobuf.SyncVerificationRequest")
@@ -2499,150 +2680,156 @@ func init() {
 }
 
 var fileDescriptor_d61ab7221f0b5518 = []byte{
-	// 2306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5f, 0x73, 0x1b, 0x49,
-	0x11, 0xbf, 0x95, 0x14, 0x4b, 0x6a, 0xc9, 0xb2, 0x33, 0xc9, 0x25, 0x8a, 0x93, 0x54, 0x9c, 0x0d,
-	0xa9, 0x0b, 0x55, 0xc1, 0x77, 0x95, 0x00, 0x07, 0x97, 0xbb, 0xe2, 0x14, 0xd9, 0x5c, 0x9c, 0x3f,
-	0x8e, 0x6b, 0x6c, 0x27, 0x40, 0x51, 0xb5, 0x35, 0xde, 0x1d, 0x5b, 0x83, 0x57, 0xbb, 0xcb, 0xce,
-	0xc8, 0x61, 0xf9, 0x00, 0x7c, 0x00, 0x5e, 0x78, 0xbd, 0x77, 0xde, 0xa8, 0x3a, 0x9e, 0xf8, 0x00,
-	0xbc, 0xf1, 0xc0, 0x0b, 0x55, 0x50, 0x7c, 0x00, 0x3e, 0x05, 0x35, 0x3d, 0xb3, 0xda, 0x5d, 0x59,
-	0x32, 0x4e, 0xf1, 0x74, 0x4f, 0x9e, 0xee, 0xe9, 0xee, 0xed, 0xe9, 0xee, 0xe9, 0xfe, 0x8d, 0x0c,
-	0xcb, 0x09, 0x13, 0xa9, 0x88, 0x8e, 0x37, 0x92, 0x34, 0x56, 0x31, 0x69, 0xe1, 0x9f, 0xc3, 0xc9,
-	0xd1, 0xda, 0x15, 0x99, 0x45, 0xbe, 0x27, 0xb9, 0x52, 0x22, 0x3a, 0x96, 0x66, 0x7b, 0xcd, 0x65,
-	0x49, 0x12, 0x0a, 0x9f, 0x29, 0x11, 0x47, 0xde, 0x98, 0x2b, 0x16, 0x30, 0xc5, 0xbc, 0x31, 0x97,
-	0x92, 0x1d, 0x73, 0x23, 0xe3, 0x32, 0xb8, 0xf9, 0x53, 0xae, 0xfc, 0x91, 0x88, 0x8e, 0x9f, 0x32,
-	0xff, 0x84, 0x07, 0x07, 0xc9, 0x26, 0x53, 0x6c, 0x93, 0x2b, 0x26, 0x42, 0x49, 0xee, 0x40, 0x07,
-	0x95, 0xa2, 0xc9, 0xf8, 0x90, 0xa7, 0x7d, 0x67, 0xdd, 0x79, 0xb0, 0x4c, 0x41, 0xb3, 0x76, 0x90,
-	0x43, 0xee, 0x42, 0x57, 0xc5, 0x8a, 0x85, 0xb9, 0x44, 0x0d, 0x25, 0x3a, 0xc8, 0x33, 0x22, 0xee,
-	0x3f, 0x1a, 0xb0, 0xa4, 0x6d, 0x4f, 0x12, 0x72, 0x15, 0x2e, 0xf9, 0x61, 0xec, 0x9f, 0xa0, 0xa1,
-	0x06, 0x35, 0x04, 0xe9, 0x41, 0x4d, 0x04, 0xa8, 0xd9, 0xa6, 0x35, 0x11, 0x90, 0x9f, 0x40, 0xcb,
-	0x8f, 0x23, 0xc5, 0x7c, 0x25, 0xfb, 0xf5, 0xf5, 0xfa, 0x83, 0xce, 0xa3, 0x7b, 0x1b, 0xf9, 0x49,
-	0x37, 0xf6, 0xb2, 0xc8, 0xdf, 0x8e, 0xa4, 0x62, 0x61, 0x88, 0x07, 0x1b, 0x1a, 0xc9, 0x37, 0x8f,
-	0xe8, 0x54, 0x89, 0xfc, 0x18, 0x3a, 0x7e, 0x3c, 0x1e, 0x4f, 0x22, 0xa1, 0x04, 0x97, 0xfd, 0x06,
-	0xda, 0xb8, 0x5e, 0xb5, 0x31, 0xb4, 0x02, 0x19, 0x2d, 0xcb, 0x92, 0xd7, 0xb0, 0x92, 0x9b, 0xb1,
-	0x31, 0xe8, 0x5f, 0x5a, 0x77, 0x1e, 0x74, 0x1e, 0xdd, 0x2f, 0xd4, 0xcf, 0x09, 0x18, 0x9d, 0xd5,
-	0x26, 0x07, 0x40, 0x4a, 0xf6, 0x73, 0x9b, 0x4b, 0xef, 0x63, 0x73, 0x8e, 0x01, 0xf2, 0x18, 0x9a,
-	0x49, 0x1a, 0x1f, 0x89, 0x90, 0xf7, 0x9b, 0x68, 0xeb, 0x46, 0x61, 0x2b, 0xb7, 0xb1, 0x6b, 0x04,
-	0x68, 0x2e, 0x49, 0x5e, 0x41, 0xcf, 0x2e, 0x73, 0x3f, 0x5a, 0xef, 0xe3, 0xc7, 0x8c, 0x32, 0xf9,
-	0x18, 0x9a, 0xb6, 0xe2, 0xfa, 0x6d, 0xb4, 0xf3, 0x61, 0x35, 0xc4, 0x7b, 0x66, 0x93, 0xe6, 0x52,
-	0x3a, 0xb8, 0x79, 0x89, 0xe6, 0x0e, 0xc0, 0x7b, 0x05, 0x77, 0x46, 0xdb, 0xfd, 0x4b, 0x03, 0xba,
-	0xaf, 0x26, 0xa1, 0x12, 0x03, 0xdf, 0x8f, 0x27, 0x91, 0x22, 0x04, 0x1a, 0x11, 0x1b, 0x73, 0xac,
-	0xaf, 0x36, 0xc5, 0x35, 0xb9, 0x05, 0x6d, 0x25, 0xc6, 0x5c, 0x2a, 0x36, 0x4e, 0xb0, 0xca, 0xea,
-	0xb4, 0x60, 0xe8, 0x5d, 0x11, 0xf0, 0x48, 0x09, 0x3f, 0x8e, 0xfa, 0x75, 0x54, 0x2b, 0x18, 0xe4,
-	0x4b, 0x00, 0x3f, 0x0e, 0xe3, 0xd4, 0x1b, 0x31, 0x39, 0xb2, 0x85, 0x74, 0xb7, 0x70, 0xb6, 0xfc,
-	0xed, 0x8d, 0x61, 0x1c, 0xc6, 0x93, 0xf4, 0x19, 0x93, 0x23, 0xda, 0x46, 0x25, 0xbd, 0x24, 0x7d,
-	0x68, 0x22, 0xb1, 0x1d, 0x60, 0x21, 0xd5, 0x69, 0x4e, 0x92, 0x8f, 0x60, 0xe5, 0x84, 0x67, 0x3e,
-	0x4b, 0x03, 0xcf, 0x5e, 0x6b, 0x2c, 0x8b, 0x36, 0xed, 0x59, 0xf6, 0xae, 0xe1, 0x92, 0xeb, 0xd0,
-	0x3c, 0xe1, 0x99, 0x37, 0x11, 0x01, 0xe6, 0xba, 0x4d, 0x97, 0x4e, 0x78, 0x76, 0x20, 0x02, 0xf2,
-	0x39, 0x2c, 0x89, 0x31, 0x3b, 0xe6, 0x3a, 0x8f, 0xda, 0xb3, 0xef, 0x2c, 0xf0, 0x6c, 0x1b, 0xcf,
-	0xa3, 0xb2, 0x6d, 0x2d, 0x4c, 0xad, 0xce, 0x9a, 0x0b, 0x50, 0xb8, 0xac, 0xaf, 0xa6, 0x88, 0x02,
-	0xfe, 0x9b, 0xbe, 0xb3, 0x5e, 0x7f, 0x50, 0xa7, 0x86, 0x58, 0xfb, 0xa7, 0x03, 0xcb, 0x15, 0xed,
-	0xb2, 0x33, 0x4e, 0xc5, 0x99, 0x3c, 0xf4, 0xb5, 0x52, 0xe8, 0xfb, 0xd0, 0x4c, 0x58, 0x16, 0xc6,
-	0x2c, 0xc0, 0xd0, 0x76, 0x69, 0x4e, 0xea, 0xcf, 0xbd, 0x13, 0x81, 0xd2, 0x31, 0xd5, 0x41, 0x31,
-	0x04, 0xb9, 0x06, 0x4b, 0x23, 0x2e, 0x8e, 0x47, 0xca, 0xc6, 0xca, 0x52, 0x64, 0x0d, 0x5a, 0xba,
-	0xf0, 0xa4, 0xf8, 0x2d, 0xc7, 0x18, 0xd5, 0xe9, 0x94, 0x26, 0xf7, 0x60, 0x39, 0xc5, 0x95, 0xa7,
-	0x58, 0x7a, 0xcc, 0x15, 0xc6, 0xa8, 0x4e, 0xbb, 0x86, 0xb9, 0x8f, 0xbc, 0xa2, 0xf1, 0xb4, 0x4a,
-	0x8d, 0xc7, 0xfd, 0xbb, 0x03, 0x57, 0x5e, 0xc6, 0x3e, 0x0b, 0x6d, 0xa4, 0x77, 0xad, 0x73, 0x3f,
-	0x80, 0xc6, 0x09, 0xcf, 0x24, 0x86, 0xa2, 0x92, 0xef, 0x39, 0xc2, 0x1b, 0x2f, 0x78, 0x46, 0x51,
-	0x9c, 0x7c, 0x06, 0xdd, 0xb1, 0x0e, 0x3b, 0x33, 0x61, 0xc7, 0x48, 0x74, 0x1e, 0x5d, 0x9b, 0x9f,
-	0x14, 0x5a, 0x91, 0xd5, 0x27, 0x4c, 0x98, 0x94, 0xef, 0xe2, 0x34, 0xb0, 0x55, 0x38, 0xa5, 0xd7,
-	0xbe, 0x07, 0xf5, 0x17, 0x3c, 0x9b, 0x5b, 0xdb, 0x04, 0x1a, 0xba, 0x19, 0xe3, 0xa7, 0xba, 0x14,
-	0xd7, 0xee, 0xef, 0x1c, 0x58, 0xd5, 0x3e, 0x96, 0xbb, 0xe4, 0x82, 0xce, 0xfb, 0x11, 0xac, 0x88,
-	0x92, 0x94, 0x37, 0x6d, 0xc3, 0xbd, 0x32, 0x7b, 0x3b, 0xc0, 0x39, 0xc0, 0x4f, 0x85, 0xcf, 0x3d,
-	0x95, 0x25, 0xdc, 0x7a, 0x08, 0x86, 0xb5, 0x9f, 0x25, 0x7c, 0xea, 0x5c, 0xa3, 0x70, 0xce, 0xfd,
-	0x8f, 0x03, 0xd7, 0x17, 0xb4, 0xeb, 0x0b, 0x4e, 0x82, 0x7b, 0xb0, 0x6c, 0x7b, 0x8e, 0x87, 0x45,
-	0x6b, 0x3f, 0xdc, 0xb5, 0x4c, 0x53, 0x91, 0x37, 0xa0, 0xc5, 0x23, 0xe9, 0x95, 0x3e, 0xdf, 0xe4,
-	0x91, 0xdc, 0xd1, 0xe1, 0xb9, 0x0b, 0xdd, 0x90, 0x49, 0xe5, 0x4d, 0x92, 0x80, 0x29, 0x6e, 0x6e,
-	0x60, 0x83, 0x76, 0x34, 0xef, 0xc0, 0xb0, 0xf4, 0xc9, 0x64, 0x26, 0x15, 0x1f, 0x7b, 0x8a, 0x1d,
-	0xeb, 0xc6, 0x5c, 0xd7, 0x27, 0x33, 0xac, 0x7d, 0x76, 0x2c, 0xc9, 0x7d, 0xe8, 0x85, 0x3a, 0xed,
-	0x5e, 0x24, 0xfc, 0x13, 0xfc, 0x88, 0xb9, 0x84, 0xcb, 0xc8, 0xdd, 0xb1, 0x4c, 0xf7, 0xdf, 0x75,
-	0xb8, 0xb1, 0x70, 0x36, 0x91, 0x4f, 0xe0, 0x6a, 0xd9, 0x11, 0x0f, 0x75, 0xc3, 0xcc, 0x9e, 0x9e,
-	0x94, 0x1c, 0x7a, 0x69, 0x76, 0xbe, 0xc5, 0xa1, 0xd0, 0xb9, 0x65, 0x41, 0xc0, 0x03, 0x9c, 0x0a,
-	0x2d, 0x6a, 0x08, 0xdd, 0x0b, 0x0e, 0x75, 0x92, 0x79, 0x80, 0x4d, 0xbf, 0x45, 0x73, 0x52, 0xcb,
-	0x8f, 0x27, 0xda, 0xa7, 0x8e, 0x91, 0x47, 0x42, 0xcb, 0xa7, 0x7c, 0x1c, 0x9f, 0xf2, 0xa0, 0xdf,
-	0x35, 0xf2, 0x96, 0x24, 0xeb, 0xd0, 0x1d, 0x31, 0xe9, 0xa1, 0x59, 0x6f, 0x22, 0xfb, 0xcb, 0xb8,
-	0x0d, 0x23, 0x26, 0x07, 0x9a, 0x75, 0xa0, 0x27, 0xd3, 0x95, 0x53, 0x9e, 0x8a, 0xa3, 0x1c, 0xfc,
-	0x48, 0xc5, 0xd4, 0x44, 0xf6, 0x7b, 0xd8, 0x19, 0x48, 0x79, 0x6b, 0x0f, 0x77, 0x10, 0xc6, 0xa4,
-	0x13, 0xa9, 0x72, 0xc9, 0x15, 0x94, 0xec, 0x20, 0xcf, 0x88, 0xb8, 0xef, 0xce, 0x16, 0x73, 0x3e,
-	0x75, 0xe6, 0x17, 0xf3, 0x99, 0x8c, 0xd5, 0xe6, 0x64, 0x6c, 0x36, 0x2d, 0xf5, 0x33, 0x69, 0x71,
-	0x9f, 0xc2, 0xda, 0xec, 0x87, 0x77, 0x27, 0x87, 0xa1, 0xf0, 0x87, 0x23, 0x76, 0xc1, 0x8b, 0xe4,
-	0x7e, 0x53, 0x87, 0xe5, 0x0a, 0xea, 0xf9, 0x9f, 0x7a, 0x5d, 0xac, 0xba, 0x3b, 0xd0, 0x49, 0x52,
-	0x71, 0xca, 0x14, 0xf7, 0x4e, 0x78, 0x66, 0x9b, 0x38, 0x58, 0x96, 0x6e, 0x4a, 0xeb, 0xba, 0x31,
-	0x48, 0x3f, 0x15, 0x89, 0xf6, 0x0b, 0x8b, 0xae, 0x4b, 0xcb, 0x2c, 0xdd, 0xd3, 0x7f, 0x15, 0x8b,
-	0xc8, 0x96, 0x5c, 0x8b, 0x5a, 0x4a, 0x77, 0x3c, 0x93, 0x08, 0x1e, 0x60, 0x4f, 0x6f, 0xd1, 0x29,
-	0x5d, 0x54, 0x44, 0xb3, 0x5c, 0x11, 0xaf, 0x61, 0x35, 0xe5, 0xbf, 0x9e, 0x70, 0xa9, 0xa4, 0xa7,
-	0x62, 0x4f, 0xdb, 0xb1, 0x83, 0xef, 0xfe, 0x22, 0x6c, 0x67, 0xc5, 0xf7, 0xe3, 0xe7, 0xb1, 0x88,
-	0x68, 0x2f, 0xad, 0xd0, 0xe4, 0x09, 0xb4, 0x72, 0x44, 0x61, 0x11, 0xcc, 0x9d, 0x05, 0x86, 0x2c,
-	0x94, 0x91, 0x74, 0xaa, 0xa0, 0x81, 0x03, 0x8f, 0xfc, 0x34, 0x4b, 0xd4, 0xb4, 0xa2, 0x0b, 0x86,
-	0xde, 0x95, 0x09, 0xf7, 0x15, 0x2b, 0xea, 0xba, 0x60, 0xe8, 0xbe, 0x6b, 0x45, 0x75, 0x75, 0xe2,
-	0xac, 0xe9, 0x62, 0xe4, 0x7a, 0x05, 0xfb, 0x05, 0xcf, 0xa4, 0xfb, 0x37, 0x07, 0x6e, 0x9e, 0x73,
-	0x22, 0x9b, 0x2f, 0x67, 0x9a, 0xaf, 0xdb, 0x00, 0x09, 0xd6, 0x06, 0xa6, 0xcb, 0xe4, 0xbf, 0x6d,
-	0x38, 0x3a, 0x5b, 0xd3, 0xa4, 0xd7, 0xcb, 0x49, 0x3f, 0xa7, 0x6b, 0x5c, 0x87, 0xa6, 0x3f, 0x62,
-	0x4a, 0x0f, 0x86, 0x4b, 0x66, 0xda, 0x6b, 0x72, 0x3b, 0xd0, 0x75, 0x9b, 0xa3, 0xd2, 0x4c, 0xef,
-	0x2e, 0x99, 0xc4, 0x4f, 0x79, 0xdb, 0x98, 0x44, 0x7d, 0x9b, 0x4c, 0x93, 0x68, 0x50, 0x43, 0xb8,
-	0xbf, 0xaf, 0xc1, 0xea, 0x6c, 0x39, 0x93, 0x2f, 0x4a, 0x88, 0xff, 0xcc, 0xd0, 0x5d, 0xd0, 0x55,
-	0x4b, 0x78, 0xff, 0x2b, 0xe8, 0xda, 0x53, 0x6b, 0xef, 0x64, 0xbf, 0x36, 0x8b, 0x86, 0x16, 0xdf,
-	0x1f, 0xda, 0x49, 0xa6, 0x6b, 0x49, 0x9e, 0x40, 0x33, 0x1f, 0xde, 0x75, 0xac, 0x87, 0x73, 0xdc,
-	0xc8, 0xe7, 0x78, 0xae, 0xf1, 0x7f, 0xbc, 0x3a, 0xdc, 0x4f, 0x61, 0x05, 0x77, 0xb5, 0x43, 0xb6,
-	0xc9, 0x5d, 0xec, 0x5e, 0x7f, 0x0e, 0x57, 0x73, 0xc5, 0x57, 0xe6, 0x5d, 0x27, 0x29, 0x67, 0x17,
-	0xd5, 0xfe, 0x12, 0xae, 0x69, 0xed, 0x81, 0xaf, 0xc4, 0xa9, 0x50, 0xd9, 0x90, 0x47, 0x8a, 0xa7,
-	0xe7, 0xe8, 0xaf, 0x42, 0x5d, 0x04, 0x26, 0xbc, 0x5d, 0xaa, 0x97, 0xee, 0xa6, 0xe9, 0x4d, 0x55,
-	0x0b, 0x03, 0xdf, 0xe7, 0x78, 0x09, 0x2e, 0x6a, 0x65, 0xcb, 0x14, 0x79, 0xd5, 0xca, 0xa6, 0x90,
-	0x63, 0x21, 0xe5, 0x7b, 0x98, 0xf9, 0xda, 0x81, 0xae, 0xb6, 0xf3, 0x34, 0x8e, 0x4f, 0xc6, 0x2c,
-	0x3d, 0x59, 0xac, 0x38, 0x49, 0x43, 0x1b, 0x06, 0xbd, 0x9c, 0x82, 0x97, 0x7a, 0x09, 0x59, 0xdd,
-	0x84, 0x36, 0x76, 0x6d, 0x4f, 0xcb, 0x9a, 0x5b, 0xd1, 0x42, 0xc6, 0x41, 0x1a, 0x96, 0x67, 0xd3,
-	0xa5, 0xea, 0x6c, 0xba, 0x0d, 0x10, 0xf0, 0x90, 0xeb, 0x19, 0xcf, 0x14, 0xde, 0x8a, 0x06, 0x6d,
-	0x5b, 0xce, 0x40, 0xb9, 0xcf, 0x4d, 0xf1, 0x0f, 0x43, 0xce, 0xd2, 0x67, 0x42, 0xaa, 0x38, 0xcd,
-	0xca, 0x77, 0xcc, 0xa9, 0xdc, 0xb1, 0xdb, 0x00, 0xbe, 0x16, 0x34, 0xb6, 0x6a, 0xc6, 0x96, 0xe5,
-	0x0c, 0x94, 0xfb, 0x57, 0x07, 0x88, 0x36, 0x66, 0x9f, 0x79, 0xbb, 0xc2, 0x57, 0x93, 0x94, 0xcf,
-	0x85, 0x89, 0x25, 0x1c, 0x5e, 0x5b, 0x80, 0xc3, 0xeb, 0xf8, 0x70, 0x3f, 0x83, 0xc3, 0x1b, 0xc8,
-	0xce, 0x71, 0xf8, 0x4d, 0x68, 0xe3, 0x3c, 0x43, 0x20, 0x7e, 0x09, 0xb7, 0x10, 0x88, 0xef, 0xcd,
-	0x05, 0xe2, 0x4b, 0x28, 0xb0, 0x00, 0x88, 0x37, 0xcb, 0x40, 0x7c, 0x04, 0x57, 0xce, 0x9e, 0x44,
-	0x2e, 0x7e, 0x6b, 0xfc, 0x08, 0x5a, 0x89, 0x15, 0xb2, 0x97, 0xfd, 0x56, 0xf5, 0x9e, 0x55, 0x2d,
-	0xd1, 0xa9, 0xb4, 0xfb, 0xc7, 0x1a, 0x5c, 0xd6, 0x02, 0x6f, 0x59, 0x18, 0x72, 0x75, 0xfe, 0x00,
-	0xef, 0x43, 0x93, 0x05, 0x41, 0xca, 0xa5, 0xcc, 0xa3, 0x66, 0x49, 0x1d, 0x9f, 0x77, 0x68, 0x00,
-	0xc3, 0xd6, 0xa2, 0x96, 0xd2, 0xb1, 0xd7, 0xb9, 0xc3, 0xa8, 0xb5, 0x28, 0xae, 0x35, 0x0f, 0x31,
-	0xb3, 0xe9, 0x9f, 0xb8, 0xd6, 0x96, 0x75, 0xee, 0x35, 0x28, 0x30, 0x4f, 0xbe, 0x9c, 0xd4, 0xd2,
-	0x09, 0x53, 0x23, 0x0b, 0xac, 0x70, 0xad, 0x67, 0xc9, 0xb4, 0x85, 0xe3, 0x03, 0xa6, 0x5b, 0xee,
-	0xe9, 0x79, 0xbe, 0xdb, 0xa5, 0x7c, 0xeb, 0xf3, 0xe8, 0x57, 0x26, 0xce, 0xa5, 0x36, 0x35, 0x04,
-	0x66, 0x55, 0x04, 0x01, 0x8f, 0xec, 0x40, 0xb2, 0xd4, 0x62, 0xa4, 0xe5, 0xbe, 0x32, 0x15, 0x56,
-	0x09, 0x96, 0x24, 0x9f, 0x42, 0xcb, 0xf6, 0xbc, 0xbc, 0x5b, 0xdf, 0xac, 0x46, 0xbf, 0x22, 0x4f,
-	0xa7, 0xc2, 0xee, 0x9f, 0x1d, 0x53, 0xfe, 0x7b, 0xec, 0x94, 0x07, 0x03, 0x1b, 0xcb, 0x52, 0x94,
-	0x9d, 0x6a, 0x94, 0xe7, 0xbd, 0x28, 0x6f, 0x41, 0xfb, 0x88, 0x9d, 0xc6, 0x93, 0x54, 0x28, 0x6e,
-	0x83, 0x5f, 0x30, 0xf4, 0x24, 0xf3, 0x47, 0x4c, 0xe0, 0x43, 0xa6, 0x81, 0xa9, 0x6c, 0x22, 0xbd,
-	0x1d, 0x9c, 0x73, 0x65, 0xef, 0x42, 0xd7, 0xa0, 0x2f, 0xaf, 0x5c, 0x99, 0x1d, 0xc3, 0x1b, 0x62,
-	0x7d, 0xfe, 0xc1, 0x81, 0x0f, 0xe7, 0xe2, 0x81, 0x05, 0x95, 0x33, 0x3b, 0x1d, 0xcd, 0x09, 0x2a,
-	0xd3, 0x71, 0x0b, 0xee, 0x8c, 0x4c, 0x03, 0xf0, 0x58, 0xea, 0x8f, 0xc4, 0x29, 0xf7, 0xe4, 0x24,
-	0x49, 0xe2, 0x54, 0x79, 0x3c, 0x62, 0x87, 0xa1, 0xc5, 0x82, 0x2d, 0x7a, 0xcb, 0x8a, 0x0d, 0x8c,
-	0xd4, 0x9e, 0x11, 0xda, 0x32, 0x32, 0xee, 0x9f, 0x1c, 0x33, 0x3a, 0xf6, 0x35, 0x52, 0xd5, 0xd8,
-	0x97, 0xa7, 0x17, 0x7c, 0x5b, 0x7d, 0x01, 0x4b, 0x16, 0xec, 0xea, 0xef, 0xf4, 0x66, 0x31, 0x54,
-	0xc9, 0xe0, 0xc6, 0x7e, 0x01, 0x83, 0xa9, 0x55, 0x72, 0x3f, 0x83, 0x4e, 0x89, 0x4d, 0x3a, 0xd0,
-	0x3c, 0xd8, 0x79, 0xb1, 0xf3, 0xfa, 0xed, 0xce, 0xea, 0x07, 0x9a, 0xd8, 0xa7, 0x07, 0x7b, 0xfb,
-	0x5b, 0x9b, 0xab, 0x0e, 0xb9, 0x0c, 0xcb, 0x07, 0x3b, 0x48, 0xbe, 0x7d, 0x4d, 0xf7, 0x9f, 0xfd,
-	0x7c, 0xb5, 0xe6, 0x7e, 0x5d, 0x37, 0x58, 0xfa, 0x4d, 0x09, 0x88, 0x5b, 0x60, 0xb3, 0xc0, 0x79,
-	0x02, 0x8d, 0xa3, 0x34, 0x1e, 0xe7, 0xa5, 0xa0, 0xd7, 0xfa, 0x40, 0x2a, 0xb6, 0x3d, 0xbb, 0xa6,
-	0x62, 0x5d, 0x1a, 0xfe, 0x48, 0x57, 0x5e, 0x74, 0x9c, 0xe3, 0x98, 0x82, 0xa1, 0x53, 0x62, 0xd1,
-	0x9f, 0x69, 0xa7, 0xf6, 0xfd, 0x33, 0xe5, 0x0d, 0xf0, 0x0d, 0x9e, 0x72, 0x99, 0xc4, 0x91, 0xcc,
-	0xaf, 0xe5, 0x94, 0xd6, 0xbd, 0x38, 0xe5, 0x49, 0x28, 0x8c, 0xb2, 0x29, 0x91, 0xb6, 0xe5, 0x0c,
-	0x14, 0xe1, 0xf3, 0x1f, 0x1c, 0x2d, 0x8c, 0xec, 0xf7, 0xab, 0x91, 0x9d, 0x73, 0xea, 0x8d, 0x37,
-	0x67, 0x9e, 0x24, 0x73, 0x9f, 0x29, 0x26, 0x87, 0xed, 0xe9, 0x00, 0xff, 0x19, 0x90, 0xb3, 0x9a,
-	0x67, 0x72, 0xb1, 0xbb, 0xb5, 0xb3, 0xb9, 0xbd, 0xf3, 0xd5, 0xaa, 0x43, 0xba, 0xd0, 0x1a, 0x0c,
-	0x87, 0x5b, 0xbb, 0x3a, 0x33, 0x35, 0x4d, 0x6d, 0x6e, 0x0d, 0x5f, 0x6e, 0xef, 0x6c, 0x6d, 0xae,
-	0xd6, 0x35, 0x35, 0x1c, 0xec, 0x0c, 0xb7, 0x5e, 0x6e, 0x6d, 0xae, 0x36, 0xdc, 0x7f, 0x39, 0x66,
-	0xb2, 0xe7, 0x60, 0xcb, 0xf8, 0xb9, 0xc9, 0x7d, 0x21, 0x17, 0xff, 0x9c, 0x70, 0x0b, 0xda, 0x36,
-	0x9e, 0xdb, 0x79, 0xa5, 0x15, 0x0c, 0xf2, 0x4b, 0x58, 0x09, 0xac, 0xbe, 0x57, 0xa9, 0xbc, 0xc7,
-	0xb3, 0x18, 0x69, 0xde, 0x27, 0x37, 0xf2, 0x85, 0x0d, 0x4f, 0x2f, 0xa8, 0xd0, 0xee, 0x43, 0xe8,
-	0x55, 0x25, 0x2a, 0x87, 0xfd, 0xa0, 0x72, 0x58, 0xc7, 0xfd, 0xc6, 0x81, 0x95, 0x99, 0x9f, 0x49,
-	0x17, 0x4f, 0x9b, 0xbb, 0xd0, 0x0d, 0x84, 0x4c, 0x42, 0x96, 0x79, 0xa5, 0x7e, 0xd4, 0xb1, 0x3c,
-	0xc4, 0xc9, 0x0f, 0x81, 0x94, 0x45, 0xbc, 0x32, 0xca, 0x5e, 0x2d, 0x09, 0x62, 0x3b, 0xa9, 0x8c,
-	0xaf, 0xc6, 0x7b, 0x8d, 0x2f, 0x09, 0x40, 0xd9, 0x3b, 0x0b, 0xf5, 0xca, 0x63, 0xdd, 0xa9, 0x8e,
-	0xf5, 0x17, 0xd0, 0xb1, 0xbf, 0xf3, 0xef, 0xeb, 0xd9, 0x53, 0xc3, 0x38, 0x7f, 0xb7, 0xf8, 0xc8,
-	0xa0, 0xf8, 0xcf, 0xc0, 0x2b, 0xfb, 0x8f, 0x01, 0x6b, 0x74, 0x43, 0x2b, 0xd0, 0xb2, 0xb6, 0xae,
-	0x85, 0x9e, 0xf6, 0xaa, 0xf4, 0xe5, 0x1f, 0x42, 0x27, 0x9d, 0x52, 0xf9, 0x14, 0xb8, 0x5a, 0xd8,
-	0x2f, 0x44, 0x69, 0x59, 0x90, 0x3c, 0x82, 0xab, 0x72, 0x72, 0x98, 0x4f, 0x92, 0xe7, 0x32, 0x8e,
-	0x9e, 0x66, 0x8a, 0xe7, 0xf3, 0x75, 0xee, 0x1e, 0x79, 0x08, 0x97, 0xf3, 0x47, 0x57, 0xa1, 0x60,
-	0x5e, 0xa2, 0x67, 0x37, 0xc8, 0x27, 0x70, 0x25, 0x8a, 0x03, 0x3e, 0x8c, 0xa3, 0x23, 0x71, 0x5c,
-	0xc8, 0x9b, 0x87, 0xe9, 0xbc, 0xad, 0xa7, 0xcb, 0xbf, 0xe8, 0x6c, 0x7c, 0xfc, 0x24, 0x77, 0xfd,
-	0x70, 0x09, 0x57, 0x8f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x26, 0xe4, 0xbd, 0xdb, 0x6c, 0x19,
-	0x00, 0x00,
+	// 2407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x19, 0x4d, 0x73, 0x1b, 0x49,
+	0x75, 0x47, 0x52, 0x2c, 0xe9, 0x49, 0x96, 0x9d, 0x4e, 0x36, 0x51, 0x9c, 0xa4, 0xe2, 0x4c, 0x48,
+	0x6d, 0xa8, 0x0a, 0xde, 0xad, 0x04, 0x58, 0xd8, 0xec, 0xd6, 0xae, 0x22, 0x9b, 0x8d, 0x93, 0xd8,
+	0x71, 0xb5, 0xed, 0x04, 0x28, 0xaa, 0xa6, 0xda, 0x33, 0x6d, 0xab, 0xf1, 0x68, 0x66, 0x98, 0x6e,
+	0x39, 0x3b, 0xfc, 0x00, 0x7e, 0x00, 0x17, 0xae, 0x7b, 0xe7, 0x46, 0xd5, 0x72, 0xe2, 0x07, 0x70,
+	0x82, 0x03, 0x17, 0xaa, 0xa0, 0xf8, 0x01, 0xfc, 0x0a, 0xaa, 0x5f, 0xf7, 0x68, 0x66, 0x6c, 0xc9,
+	0x38, 0xc0, 0x85, 0x93, 0xfb, 0xbd, 0x7e, 0xef, 0xcd, 0xeb, 0xf7, 0xfd, 0x64, 0x58, 0x4c, 0x98,
+	0x48, 0x45, 0x74, 0xb4, 0x96, 0xa4, 0xb1, 0x8a, 0x49, 0x0b, 0xff, 0x1c, 0x4c, 0x0e, 0x57, 0x88,
+	0x3f, 0x62, 0xca, 0x1b, 0x73, 0x29, 0xd9, 0x11, 0x37, 0xb7, 0x2b, 0x57, 0x64, 0x16, 0xf9, 0x9e,
+	0xe4, 0x4a, 0x89, 0xe8, 0x48, 0x5a, 0xa4, 0xcb, 0x92, 0x24, 0x14, 0x3e, 0x53, 0x22, 0x8e, 0xbc,
+	0x31, 0x57, 0x2c, 0x60, 0x8a, 0x55, 0x19, 0x5d, 0x06, 0x37, 0x7f, 0xc4, 0x95, 0x3f, 0x12, 0xd1,
+	0xd1, 0x53, 0xe6, 0x1f, 0xf3, 0x60, 0x3f, 0x59, 0x67, 0x8a, 0xad, 0x73, 0xc5, 0x44, 0x28, 0xc9,
+	0x1d, 0xe8, 0x20, 0x53, 0x34, 0x19, 0x1f, 0xf0, 0xb4, 0xef, 0xac, 0x3a, 0x0f, 0x16, 0x29, 0x68,
+	0xd4, 0x36, 0x62, 0xc8, 0x5d, 0xe8, 0xaa, 0x58, 0xb1, 0x30, 0xa7, 0xa8, 0x21, 0x45, 0x07, 0x71,
+	0x86, 0xc4, 0xfd, 0x6b, 0x03, 0x16, 0xb4, 0xec, 0x49, 0x42, 0xae, 0xc2, 0x25, 0x3f, 0x8c, 0xfd,
+	0x63, 0x14, 0xd4, 0xa0, 0x06, 0x20, 0x3d, 0xa8, 0x89, 0x00, 0x39, 0xdb, 0xb4, 0x26, 0x02, 0xf2,
+	0x39, 0xb4, 0xfc, 0x38, 0x52, 0xcc, 0x57, 0xb2, 0x5f, 0x5f, 0xad, 0x3f, 0xe8, 0x3c, 0xba, 0xb7,
+	0x96, 0xbf, 0x7e, 0x6d, 0x37, 0x8b, 0xfc, 0xcd, 0x48, 0x2a, 0x16, 0x86, 0xf8, 0xb0, 0xa1, 0xa1,
+	0x7c, 0xfd, 0x88, 0x4e, 0x99, 0xc8, 0x0f, 0xa1, 0xe3, 0xc7, 0xe3, 0xf1, 0x24, 0x12, 0x4a, 0x70,
+	0xd9, 0x6f, 0xa0, 0x8c, 0xeb, 0x55, 0x19, 0x43, 0x4b, 0x90, 0xd1, 0x32, 0x2d, 0x79, 0x05, 0x4b,
+	0xb9, 0x18, 0x6b, 0x83, 0xfe, 0xa5, 0x55, 0xe7, 0x41, 0xe7, 0xd1, 0xfd, 0x82, 0xfd, 0x1c, 0x83,
+	0xd1, 0xd3, 0xdc, 0x64, 0x1f, 0x48, 0x49, 0x7e, 0x2e, 0x73, 0xe1, 0x5d, 0x64, 0xce, 0x10, 0x40,
+	0x1e, 0x43, 0x33, 0x49, 0xe3, 0x43, 0x11, 0xf2, 0x7e, 0x13, 0x65, 0xdd, 0x28, 0x64, 0xe5, 0x32,
+	0x76, 0x0c, 0x01, 0xcd, 0x29, 0xc9, 0x16, 0xf4, 0xec, 0x31, 0xd7, 0xa3, 0xf5, 0x2e, 0x7a, 0x9c,
+	0x62, 0x26, 0x1f, 0x42, 0xd3, 0x46, 0x5c, 0xbf, 0x8d, 0x72, 0xde, 0xaf, 0x9a, 0x78, 0xd7, 0x5c,
+	0xd2, 0x9c, 0x4a, 0x1b, 0x37, 0x0f, 0xd1, 0x5c, 0x01, 0x78, 0x27, 0xe3, 0x9e, 0xe2, 0x76, 0xff,
+	0xd0, 0x80, 0xee, 0xd6, 0x24, 0x54, 0x62, 0xe0, 0xfb, 0xf1, 0x24, 0x52, 0x84, 0x40, 0x23, 0x62,
+	0x63, 0x8e, 0xf1, 0xd5, 0xa6, 0x78, 0x26, 0xb7, 0xa0, 0xad, 0xc4, 0x98, 0x4b, 0xc5, 0xc6, 0x09,
+	0x46, 0x59, 0x9d, 0x16, 0x08, 0x7d, 0x2b, 0x02, 0x1e, 0x29, 0xe1, 0xc7, 0x51, 0xbf, 0x8e, 0x6c,
+	0x05, 0x82, 0x7c, 0x01, 0xe0, 0xc7, 0x61, 0x9c, 0x7a, 0x23, 0x26, 0x47, 0x36, 0x90, 0xee, 0x16,
+	0xca, 0x96, 0xbf, 0xbd, 0x36, 0x8c, 0xc3, 0x78, 0x92, 0x3e, 0x63, 0x72, 0x44, 0xdb, 0xc8, 0xa4,
+	0x8f, 0xa4, 0x0f, 0x4d, 0x04, 0x36, 0x03, 0x0c, 0xa4, 0x3a, 0xcd, 0x41, 0xf2, 0x01, 0x2c, 0x1d,
+	0xf3, 0xcc, 0x67, 0x69, 0xe0, 0xd9, 0x54, 0xc7, 0xb0, 0x68, 0xd3, 0x9e, 0x45, 0xef, 0x18, 0x2c,
+	0xb9, 0x0e, 0xcd, 0x63, 0x9e, 0x79, 0x13, 0x11, 0xa0, 0xaf, 0xdb, 0x74, 0xe1, 0x98, 0x67, 0xfb,
+	0x22, 0x20, 0x9f, 0xc2, 0x82, 0x18, 0xb3, 0x23, 0xae, 0xfd, 0xa8, 0x35, 0xfb, 0xd6, 0x1c, 0xcd,
+	0x36, 0xf1, 0x3d, 0x2a, 0xdb, 0xd4, 0xc4, 0xd4, 0xf2, 0xac, 0xb8, 0x00, 0x85, 0xca, 0x3a, 0x35,
+	0x45, 0x14, 0xf0, 0xaf, 0xfa, 0xce, 0x6a, 0xfd, 0x41, 0x9d, 0x1a, 0x60, 0xe5, 0x6f, 0x0e, 0x2c,
+	0x56, 0xb8, 0xcb, 0xca, 0x38, 0x15, 0x65, 0x72, 0xd3, 0xd7, 0x4a, 0xa6, 0xef, 0x43, 0x33, 0x61,
+	0x59, 0x18, 0xb3, 0x00, 0x4d, 0xdb, 0xa5, 0x39, 0xa8, 0x3f, 0xf7, 0x56, 0x04, 0x4a, 0xdb, 0x54,
+	0x1b, 0xc5, 0x00, 0xe4, 0x1a, 0x2c, 0x8c, 0xb8, 0x38, 0x1a, 0x29, 0x6b, 0x2b, 0x0b, 0x91, 0x15,
+	0x68, 0xe9, 0xc0, 0x93, 0xe2, 0x97, 0x1c, 0x6d, 0x54, 0xa7, 0x53, 0x98, 0xdc, 0x83, 0xc5, 0x14,
+	0x4f, 0x9e, 0x62, 0xe9, 0x11, 0x57, 0x68, 0xa3, 0x3a, 0xed, 0x1a, 0xe4, 0x1e, 0xe2, 0x8a, 0xc2,
+	0xd3, 0x2a, 0x15, 0x1e, 0xf7, 0x2f, 0x0e, 0x5c, 0x79, 0x19, 0xfb, 0x2c, 0xb4, 0x96, 0xde, 0xb1,
+	0xca, 0x7d, 0x0f, 0x1a, 0xc7, 0x3c, 0x93, 0x68, 0x8a, 0x8a, 0xbf, 0x67, 0x10, 0xaf, 0xbd, 0xe0,
+	0x19, 0x45, 0x72, 0xf2, 0x09, 0x74, 0xc7, 0xda, 0xec, 0xcc, 0x98, 0x1d, 0x2d, 0xd1, 0x79, 0x74,
+	0x6d, 0xb6, 0x53, 0x68, 0x85, 0x56, 0xbf, 0x30, 0x61, 0x52, 0xbe, 0x8d, 0xd3, 0xc0, 0x46, 0xe1,
+	0x14, 0x5e, 0xf9, 0x0e, 0xd4, 0x5f, 0xf0, 0x6c, 0x66, 0x6c, 0x13, 0x68, 0xe8, 0x62, 0x8c, 0x9f,
+	0xea, 0x52, 0x3c, 0xbb, 0xbf, 0x72, 0x60, 0x59, 0xeb, 0x58, 0xae, 0x92, 0x73, 0x2a, 0xef, 0x07,
+	0xb0, 0x24, 0x4a, 0x54, 0xde, 0xb4, 0x0c, 0xf7, 0xca, 0xe8, 0xcd, 0x00, 0xfb, 0x00, 0x3f, 0x11,
+	0x3e, 0xf7, 0x54, 0x96, 0x70, 0xab, 0x21, 0x18, 0xd4, 0x5e, 0x96, 0xf0, 0xa9, 0x72, 0x8d, 0x42,
+	0x39, 0xf7, 0x0d, 0x5c, 0x2b, 0xeb, 0x40, 0xf9, 0x49, 0xec, 0xff, 0x2f, 0xb4, 0x71, 0xb7, 0xa0,
+	0xbb, 0xcb, 0xa5, 0x44, 0x99, 0xb2, 0xec, 0xdd, 0xff, 0x4c, 0xdc, 0x3f, 0x1d, 0xb8, 0x3e, 0xa7,
+	0xad, 0x5c, 0xb0, 0x63, 0xdd, 0x83, 0x45, 0x5b, 0x1b, 0x3d, 0x4c, 0x2e, 0x6b, 0xa0, 0xae, 0x45,
+	0x9a, 0xcc, 0xb9, 0x01, 0x2d, 0x1e, 0x49, 0xaf, 0x64, 0xa6, 0x26, 0x8f, 0xe4, 0xb6, 0x76, 0xe3,
+	0x5d, 0xe8, 0x86, 0x4c, 0x2a, 0x6f, 0x92, 0x04, 0x4c, 0x71, 0x53, 0x29, 0x1a, 0xb4, 0xa3, 0x71,
+	0xfb, 0x06, 0xa5, 0x3d, 0x20, 0x33, 0xa9, 0xf8, 0xd8, 0x53, 0xec, 0x48, 0x37, 0x90, 0xba, 0xf6,
+	0x80, 0x41, 0xed, 0xb1, 0x23, 0x49, 0xee, 0x43, 0x2f, 0xd4, 0xe1, 0xe9, 0x45, 0xc2, 0x3f, 0xc6,
+	0x8f, 0x98, 0x62, 0xb1, 0x88, 0xd8, 0x6d, 0x8b, 0x74, 0xff, 0x51, 0x87, 0x1b, 0x73, 0x7b, 0x28,
+	0xf9, 0x08, 0xae, 0x96, 0x15, 0xf1, 0x90, 0x37, 0xcc, 0xec, 0xeb, 0x49, 0x49, 0xa1, 0x97, 0xe6,
+	0xe6, 0xff, 0xd8, 0x14, 0xda, 0xb7, 0x2c, 0x08, 0x78, 0x80, 0xdd, 0xab, 0x45, 0x0d, 0xa0, 0x6b,
+	0xd6, 0x81, 0x76, 0x32, 0x0f, 0xb0, 0x39, 0xb5, 0x68, 0x0e, 0x6a, 0xfa, 0xf1, 0x44, 0xeb, 0xd4,
+	0x31, 0xf4, 0x08, 0x68, 0xfa, 0x94, 0x8f, 0xe3, 0x13, 0x1e, 0xf4, 0xbb, 0x86, 0xde, 0x82, 0x64,
+	0x15, 0xba, 0x23, 0x26, 0x3d, 0x14, 0xeb, 0x4d, 0x64, 0x7f, 0x11, 0xaf, 0x61, 0xc4, 0xe4, 0x40,
+	0xa3, 0xf6, 0x75, 0x07, 0xbd, 0x72, 0xc2, 0x53, 0x71, 0x98, 0x0f, 0x69, 0x52, 0x31, 0x35, 0x91,
+	0xfd, 0x1e, 0x56, 0x30, 0x52, 0xbe, 0xda, 0xc5, 0x1b, 0x1c, 0xb7, 0xd2, 0x89, 0x54, 0x39, 0xe5,
+	0x12, 0x52, 0x76, 0x10, 0x67, 0x48, 0xdc, 0xb7, 0x67, 0x83, 0x39, 0xef, 0x8e, 0xb3, 0x83, 0xf9,
+	0x8c, 0xc7, 0x6a, 0x33, 0x3c, 0x76, 0xda, 0x2d, 0xf5, 0x33, 0x6e, 0x71, 0x9f, 0xc2, 0xca, 0xe9,
+	0x0f, 0xef, 0x4c, 0x0e, 0x42, 0xe1, 0x0f, 0x47, 0xec, 0x82, 0x89, 0xe4, 0x7e, 0x53, 0x87, 0xc5,
+	0xca, 0x74, 0xf6, 0x6f, 0xf9, 0xba, 0x18, 0x75, 0x77, 0xa0, 0x93, 0xa4, 0xe2, 0x84, 0x29, 0xee,
+	0x1d, 0xf3, 0xcc, 0x36, 0x1b, 0xb0, 0x28, 0x5d, 0x3c, 0x57, 0x75, 0x01, 0x93, 0x7e, 0x2a, 0x12,
+	0xad, 0x17, 0x06, 0x5d, 0x97, 0x96, 0x51, 0xba, 0xf7, 0xfc, 0x3c, 0x16, 0x91, 0x0d, 0xb9, 0x16,
+	0xb5, 0x90, 0xae, 0xcc, 0xc6, 0x11, 0x3c, 0xc0, 0xde, 0xd3, 0xa2, 0x53, 0xb8, 0x88, 0x88, 0x66,
+	0x39, 0x22, 0x5e, 0xc1, 0x72, 0xca, 0x7f, 0x31, 0xe1, 0x52, 0x49, 0x4f, 0xc5, 0x9e, 0x96, 0x63,
+	0x1b, 0xf4, 0xfd, 0x79, 0x33, 0xa8, 0x25, 0xdf, 0x8b, 0x9f, 0xc7, 0x22, 0xa2, 0xbd, 0xb4, 0x02,
+	0x93, 0x27, 0xd0, 0xca, 0x27, 0x1f, 0x3b, 0x69, 0xdd, 0x99, 0x23, 0xc8, 0x8e, 0x5c, 0x92, 0x4e,
+	0x19, 0xf4, 0x80, 0xc3, 0x23, 0x3f, 0xcd, 0x12, 0x35, 0x8d, 0xe8, 0x02, 0xa1, 0x6f, 0x65, 0xc2,
+	0x7d, 0xc5, 0x8a, 0xb8, 0x2e, 0x10, 0xba, 0x84, 0x5a, 0x52, 0x1d, 0x9d, 0xd8, 0x13, 0xbb, 0x68,
+	0xb9, 0x5e, 0x81, 0x7e, 0xc1, 0x33, 0xe9, 0xfe, 0xd9, 0x81, 0x9b, 0xe7, 0xbc, 0xc8, 0xfa, 0xcb,
+	0x99, 0xfa, 0xeb, 0x36, 0x40, 0x82, 0xb1, 0x81, 0xee, 0x32, 0xfe, 0x6f, 0x1b, 0x8c, 0xf6, 0xd6,
+	0xd4, 0xe9, 0xf5, 0xb2, 0xd3, 0xcf, 0xa9, 0x1a, 0xd7, 0xa1, 0x89, 0x5b, 0x91, 0x30, 0xde, 0x6b,
+	0xd3, 0x05, 0x0d, 0x6e, 0x06, 0x3a, 0x6e, 0xf3, 0xe9, 0x39, 0xd3, 0xb7, 0x0b, 0xc6, 0xf1, 0x53,
+	0xdc, 0x26, 0x3a, 0x51, 0x67, 0x93, 0x29, 0x12, 0x0d, 0x6a, 0x00, 0xf7, 0xd7, 0x35, 0x58, 0x3e,
+	0x1d, 0xce, 0xe4, 0xb3, 0xd2, 0x66, 0x72, 0x66, 0x38, 0x98, 0x53, 0x55, 0x4b, 0x7b, 0xc9, 0x97,
+	0xd0, 0xb5, 0xaf, 0xd6, 0xda, 0xc9, 0x7e, 0xed, 0xf4, 0xd4, 0x36, 0x3f, 0x7f, 0x68, 0x27, 0x99,
+	0x9e, 0x25, 0x79, 0x02, 0xcd, 0x7c, 0xc8, 0xa8, 0x63, 0x3c, 0x9c, 0xa3, 0x46, 0x3e, 0x6f, 0xe4,
+	0x1c, 0xff, 0xc5, 0x76, 0xe4, 0x7e, 0x0c, 0x4b, 0x78, 0xab, 0x15, 0xb2, 0x45, 0xee, 0x62, 0x79,
+	0xfd, 0x29, 0x5c, 0xcd, 0x19, 0xb7, 0xcc, 0xfe, 0x29, 0x29, 0x67, 0x17, 0xe5, 0xfe, 0x02, 0xae,
+	0x69, 0xee, 0x81, 0xaf, 0xc4, 0x89, 0x50, 0xd9, 0x90, 0x47, 0x8a, 0xa7, 0xe7, 0xf0, 0x2f, 0x43,
+	0x5d, 0x04, 0xc6, 0xbc, 0x5d, 0xaa, 0x8f, 0xee, 0xba, 0xa9, 0x4d, 0x55, 0x09, 0x03, 0xdf, 0xe7,
+	0x98, 0x04, 0x17, 0x95, 0xb2, 0x61, 0x82, 0xbc, 0x2a, 0x65, 0x5d, 0xc8, 0xb1, 0x90, 0xf2, 0x1d,
+	0xc4, 0x7c, 0xed, 0x40, 0x57, 0xcb, 0x79, 0x1a, 0xc7, 0xc7, 0x63, 0x96, 0x1e, 0xcf, 0x67, 0x9c,
+	0xa4, 0xa1, 0x35, 0x83, 0x3e, 0x4e, 0x87, 0xac, 0x7a, 0x69, 0x02, 0xbc, 0x09, 0x6d, 0xac, 0xda,
+	0x9e, 0xa6, 0x35, 0x59, 0xd1, 0x42, 0xc4, 0x7e, 0x1a, 0x96, 0x7b, 0xd3, 0xa5, 0x6a, 0x6f, 0xba,
+	0x0d, 0x10, 0xf0, 0x90, 0xeb, 0x1e, 0xcf, 0x14, 0x66, 0x45, 0x83, 0xb6, 0x2d, 0x66, 0xa0, 0xdc,
+	0xe7, 0x26, 0xf8, 0x87, 0x21, 0x67, 0xe9, 0x33, 0x21, 0x55, 0x9c, 0x66, 0xe5, 0x1c, 0x73, 0x2a,
+	0x39, 0x76, 0x1b, 0xc0, 0xd7, 0x84, 0x46, 0x56, 0xcd, 0xc8, 0xb2, 0x98, 0x81, 0x72, 0xff, 0xe8,
+	0x00, 0xd1, 0xc2, 0xec, 0x3a, 0xba, 0x23, 0x7c, 0x35, 0x49, 0xf9, 0xcc, 0x71, 0xb6, 0xb4, 0x2f,
+	0xd4, 0xe6, 0xec, 0x0b, 0x75, 0xfc, 0x81, 0xe1, 0xcc, 0xbe, 0xd0, 0x40, 0x74, 0xbe, 0x2f, 0xdc,
+	0x84, 0x36, 0xf6, 0x33, 0x5c, 0x18, 0x2e, 0xe1, 0x15, 0x2e, 0x0c, 0xbb, 0x33, 0x17, 0x86, 0x05,
+	0x24, 0x98, 0xb3, 0x30, 0x34, 0xcb, 0x0b, 0xc3, 0x08, 0xae, 0x9c, 0x7d, 0x89, 0x9c, 0xbf, 0x13,
+	0xfd, 0x00, 0x5a, 0x89, 0x25, 0xb2, 0xc9, 0x7e, 0xab, 0x9a, 0x67, 0x55, 0x49, 0x74, 0x4a, 0xed,
+	0xfe, 0xb6, 0x06, 0x97, 0x35, 0xc1, 0x1b, 0x16, 0x86, 0x5c, 0x9d, 0xdf, 0xc0, 0xfb, 0xd0, 0x64,
+	0x41, 0x90, 0x72, 0x29, 0x73, 0xab, 0x59, 0x50, 0xdb, 0xe7, 0x2d, 0x0a, 0x40, 0xb3, 0xb5, 0xa8,
+	0x85, 0xb4, 0xed, 0xb5, 0xef, 0xd0, 0x6a, 0x2d, 0x8a, 0x67, 0x8d, 0xc3, 0xd9, 0xde, 0xd4, 0x4f,
+	0x3c, 0x6b, 0xc9, 0xda, 0xf7, 0x7a, 0x28, 0x30, 0xab, 0x69, 0x0e, 0x6a, 0xea, 0x84, 0xa9, 0x91,
+	0x1d, 0xac, 0xf0, 0xac, 0x7b, 0xc9, 0xb4, 0x84, 0xe3, 0xa2, 0xd5, 0x2d, 0xd7, 0xf4, 0xdc, 0xdf,
+	0xed, 0x92, 0xbf, 0xf5, 0x7b, 0xf4, 0x36, 0x8c, 0x7d, 0xa9, 0x4d, 0x0d, 0x80, 0x5e, 0x15, 0x41,
+	0xc0, 0x23, 0xdb, 0x90, 0x2c, 0x34, 0x7f, 0xd2, 0x72, 0xb7, 0x4c, 0x84, 0x55, 0x8c, 0x25, 0xc9,
+	0xc7, 0xd0, 0xb2, 0x35, 0x2f, 0xaf, 0xd6, 0x37, 0xab, 0xd6, 0xaf, 0xd0, 0xd3, 0x29, 0xb1, 0xfb,
+	0x7b, 0xc7, 0x84, 0xff, 0x2e, 0x3b, 0xe1, 0xc1, 0xc0, 0xda, 0xb2, 0x64, 0x65, 0xa7, 0x6a, 0xe5,
+	0x59, 0x9b, 0xef, 0x2d, 0x68, 0x1f, 0xb2, 0x93, 0x78, 0x92, 0x0a, 0xc5, 0xad, 0xf1, 0x0b, 0x84,
+	0xee, 0x64, 0xfe, 0x88, 0x09, 0xdc, 0x49, 0x1a, 0xe8, 0xca, 0x26, 0xc2, 0x9b, 0xc1, 0x39, 0x29,
+	0x7b, 0x17, 0xba, 0x66, 0xfa, 0xf2, 0xca, 0x91, 0xd9, 0x31, 0xb8, 0x21, 0xc6, 0xe7, 0x9f, 0x1c,
+	0x3b, 0x3e, 0x8d, 0x98, 0x5a, 0x4f, 0xd9, 0xe1, 0xbc, 0x88, 0x29, 0xa5, 0x72, 0xad, 0x92, 0xca,
+	0x3a, 0x08, 0xf8, 0x57, 0x2a, 0xaf, 0x30, 0xfa, 0xac, 0x67, 0xab, 0x94, 0xcb, 0x24, 0x8e, 0x24,
+	0xf7, 0x54, 0x6c, 0x6b, 0x0c, 0xe4, 0xa8, 0xbd, 0x98, 0x7c, 0x0e, 0x1d, 0xa6, 0x14, 0xf3, 0x47,
+	0x63, 0xae, 0x4d, 0x7d, 0x09, 0x4d, 0x7d, 0xbb, 0x30, 0xf5, 0x54, 0x9b, 0xc1, 0x94, 0x8a, 0x96,
+	0x39, 0xca, 0x6f, 0x5e, 0xa8, 0x3a, 0xf6, 0x37, 0x0e, 0xbc, 0x3f, 0x73, 0xc0, 0x99, 0xf3, 0xb0,
+	0xd3, 0xed, 0xde, 0xbc, 0xae, 0xd2, 0xee, 0x37, 0xe0, 0xce, 0xc8, 0x54, 0x34, 0x8f, 0xa5, 0xfe,
+	0x48, 0x9c, 0x70, 0x4f, 0x4e, 0x92, 0x24, 0x4e, 0x95, 0xc7, 0x23, 0x76, 0x10, 0xda, 0xe1, 0xb6,
+	0x45, 0x6f, 0x59, 0xb2, 0x81, 0xa1, 0xda, 0x35, 0x44, 0x1b, 0x86, 0xc6, 0xfd, 0x9d, 0x63, 0x7a,
+	0xe1, 0x9e, 0x1e, 0xbd, 0xf5, 0x30, 0xcf, 0xd3, 0x0b, 0x2e, 0x8b, 0x9f, 0xc1, 0x82, 0x9d, 0xde,
+	0xf5, 0x77, 0x7a, 0xa7, 0x87, 0xc2, 0x92, 0xc0, 0xb5, 0xbd, 0x62, 0xae, 0xa7, 0x96, 0xc9, 0xfd,
+	0x04, 0x3a, 0x25, 0x34, 0xe9, 0x40, 0x73, 0x7f, 0xfb, 0xc5, 0xf6, 0xab, 0x37, 0xdb, 0xcb, 0xef,
+	0x69, 0x60, 0x8f, 0xee, 0xef, 0xee, 0x6d, 0xac, 0x2f, 0x3b, 0xe4, 0x32, 0x2c, 0xee, 0x6f, 0x23,
+	0xf8, 0xe6, 0x15, 0xdd, 0x7b, 0xf6, 0x93, 0xe5, 0x9a, 0xfb, 0x75, 0xdd, 0x2c, 0x07, 0xaf, 0x4b,
+	0x9b, 0x85, 0x9d, 0xd4, 0xe6, 0x28, 0x4f, 0xa0, 0x71, 0x98, 0xc6, 0xe3, 0x3c, 0xb6, 0xf5, 0x59,
+	0x3f, 0x48, 0xc5, 0x36, 0x44, 0x6a, 0x2a, 0xd6, 0xb1, 0xee, 0x8f, 0x74, 0x2a, 0x45, 0x47, 0xf9,
+	0x60, 0x56, 0x20, 0xb4, 0x4b, 0xec, 0x38, 0x6b, 0xfa, 0x83, 0x5d, 0xe8, 0xa6, 0xb8, 0x01, 0xfe,
+	0xf8, 0x91, 0x87, 0x93, 0xad, 0x33, 0x53, 0x58, 0x37, 0x97, 0x94, 0x27, 0xa1, 0x30, 0xcc, 0x26,
+	0xe6, 0xdb, 0x16, 0x33, 0x50, 0x84, 0xcf, 0xde, 0xa0, 0x5a, 0x68, 0xd9, 0xef, 0x56, 0x2d, 0x3b,
+	0xe3, 0xd5, 0x6b, 0xaf, 0xcf, 0xec, 0x58, 0x33, 0xf7, 0x2e, 0xe3, 0xc3, 0xf6, 0x74, 0x22, 0xf9,
+	0x31, 0x90, 0xb3, 0x9c, 0x67, 0x7c, 0xb1, 0xb3, 0xb1, 0xbd, 0xbe, 0xb9, 0xfd, 0xe5, 0xb2, 0x43,
+	0xba, 0xd0, 0x1a, 0x0c, 0x87, 0x1b, 0x3b, 0xda, 0x33, 0x35, 0x0d, 0xad, 0x6f, 0x0c, 0x5f, 0x6e,
+	0x6e, 0x6f, 0xac, 0x2f, 0xd7, 0x35, 0x34, 0x1c, 0x6c, 0x0f, 0x37, 0x5e, 0x6e, 0xac, 0x2f, 0x37,
+	0xdc, 0xbf, 0x3b, 0x66, 0x54, 0xc9, 0xa7, 0x47, 0xa3, 0xe7, 0x3a, 0xf7, 0x85, 0x9c, 0xff, 0xcb,
+	0xc9, 0x2d, 0x68, 0x5b, 0x7b, 0x6e, 0xe6, 0x91, 0x56, 0x20, 0xc8, 0xcf, 0x60, 0x29, 0xb0, 0xfc,
+	0x5e, 0x25, 0xf2, 0x1e, 0x9f, 0x1e, 0xfa, 0x66, 0x7d, 0x72, 0x2d, 0x3f, 0x58, 0xf3, 0xf4, 0x82,
+	0x0a, 0xec, 0x3e, 0x84, 0x5e, 0x95, 0xa2, 0xf2, 0xd8, 0xf7, 0x2a, 0x8f, 0x75, 0xdc, 0x6f, 0x1c,
+	0x58, 0x3a, 0xf5, 0xfb, 0xf4, 0xfc, 0xf6, 0x79, 0x17, 0xba, 0x81, 0x90, 0x49, 0xc8, 0x32, 0xaf,
+	0x54, 0x60, 0x3b, 0x16, 0x87, 0x83, 0xff, 0x43, 0x20, 0x65, 0x12, 0xaf, 0xbc, 0x36, 0x2c, 0x97,
+	0x08, 0xb1, 0x3e, 0x56, 0xfa, 0x71, 0xe3, 0x9d, 0xfa, 0xb1, 0x04, 0xa0, 0xec, 0xad, 0x9d, 0x5d,
+	0xcb, 0x73, 0x8a, 0x53, 0x9d, 0x53, 0x5e, 0x40, 0xc7, 0xfe, 0x83, 0x65, 0x4f, 0x37, 0xd3, 0x1a,
+	0xda, 0xf9, 0xdb, 0xc5, 0x47, 0x06, 0xc5, 0xbf, 0x64, 0xb6, 0xec, 0x7f, 0x64, 0xac, 0xd0, 0x35,
+	0xcd, 0x40, 0xcb, 0xdc, 0x3a, 0x16, 0x7a, 0x5a, 0xab, 0xd2, 0x97, 0xbf, 0x0f, 0x9d, 0x74, 0x0a,
+	0xe5, 0x6d, 0xed, 0x6a, 0x21, 0xbf, 0x20, 0xa5, 0x65, 0x42, 0xf2, 0x08, 0xae, 0xca, 0xc9, 0x41,
+	0xde, 0x1a, 0x9f, 0xcb, 0x38, 0x7a, 0x9a, 0x29, 0x9e, 0x0f, 0x0c, 0x33, 0xef, 0xc8, 0x43, 0xb8,
+	0x9c, 0x6f, 0x91, 0x05, 0x83, 0x59, 0xad, 0xcf, 0x5e, 0x90, 0x8f, 0xe0, 0x4a, 0x14, 0x07, 0x7c,
+	0x18, 0x47, 0x87, 0xe2, 0xa8, 0xa0, 0x37, 0x9b, 0xf6, 0xac, 0xab, 0xa7, 0x8b, 0x3f, 0xed, 0xac,
+	0x7d, 0xf8, 0x24, 0x57, 0xfd, 0x60, 0x01, 0x4f, 0x8f, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xbd,
+	0xab, 0x49, 0x36, 0xf9, 0x1a, 0x00, 0x00,
 }
@@ -37,6 +37,7 @@ const (
 	SyncSetting_STICKERS_PACKS_PENDING      SyncSetting_Type = 11
 	SyncSetting_STICKERS_RECENT_STICKERS    SyncSetting_Type = 12
 	SyncSetting_DISPLAY_NAME                SyncSetting_Type = 13
+	SyncSetting_SEND_READ_RECEIPTS          SyncSetting_Type = 14
 )
 
 var SyncSetting_Type_name = map[int32]string{
@@ -54,6 +55,7 @@ var SyncSetting_Type_name = map[int32]string{
 	11: "STICKERS_PACKS_PENDING",
 	12: "STICKERS_RECENT_STICKERS",
 	13: "DISPLAY_NAME",
+	14: "SEND_READ_RECEIPTS",
 }
 
 var SyncSetting_Type_value = map[string]int32{
@@ -71,6 +73,7 @@ var SyncSetting_Type_value = map[string]int32{
 	"STICKERS_PACKS_PENDING":      11,
 	"STICKERS_RECENT_STICKERS":    12,
 	"DISPLAY_NAME":                13,
+	"SEND_READ_RECEIPTS":          14,
 }
 
 func (x SyncSetting_Type) String() string {
@@ -217,34 +220,35 @@ func init() {
 }
 
 var fileDescriptor_e2f7a0bce2873c78 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0x8e, 0xda, 0x30,
-	0x10, 0x80, 0xc9, 0x12, 0xfe, 0x26, 0xec, 0xae, 0x65, 0xaa, 0x36, 0xda, 0x56, 0xda, 0x74, 0x7b,
-	0xc9, 0x29, 0x95, 0xda, 0xaa, 0x97, 0x9e, 0x4c, 0x62, 0xc0, 0x22, 0x38, 0x91, 0xc7, 0x01, 0xd1,
-	0x8b, 0x55, 0x10, 0x5d, 0xa1, 0x22, 0x82, 0x96, 0x6c, 0x25, 0x1e, 0xae, 0x2f, 0xd1, 0x27, 0xaa,
-	0x92, 0x94, 0xfe, 0x9e, 0x92, 0xf9, 0xe6, 0x9b, 0xf1, 0xf8, 0x07, 0x06, 0xc7, 0xd3, 0x7e, 0x6d,
-	0x8e, 0x9b, 0xa2, 0xd8, 0xee, 0xef, 0x8f, 0xc1, 0xe1, 0x21, 0x2f, 0x72, 0xda, 0xad, 0x3e, 0xab,
-	0xc7, 0xcf, 0x77, 0xdf, 0x6c, 0x70, 0xf0, 0xb4, 0x5f, 0x63, 0x2d, 0xd0, 0x00, 0xec, 0xe2, 0x74,
-	0xd8, 0xb8, 0x96, 0x67, 0xf9, 0x57, 0x6f, 0x6e, 0x82, 0xb3, 0x18, 0xfc, 0x21, 0x05, 0xfa, 0x74,
-	0xd8, 0xa8, 0xca, 0xa3, 0x4f, 0xa0, 0xb5, 0xde, 0xe5, 0xeb, 0x2f, 0xee, 0x85, 0x67, 0xf9, 0xb6,
-	0xaa, 0x03, 0xfa, 0x0a, 0xfa, 0x5f, 0x3f, 0xed, 0x1e, 0x37, 0xe6, 0x58, 0x3c, 0x6c, 0xf7, 0xf7,
-	0x6e, 0xd3, 0xb3, 0xfc, 0xde, 0xa4, 0xa1, 0x9c, 0x8a, 0x62, 0x05, 0xe9, 0x4b, 0xa8, 0x43, 0xb3,
-	0x3a, 0x15, 0x9b, 0xa3, 0x6b, 0x7b, 0x96, 0xdf, 0x9f, 0x34, 0x14, 0x54, 0x70, 0x58, 0x32, 0x7a,
-	0x0b, 0xf0, 0x53, 0xc9, 0xf3, 0x9d, 0xdb, 0xf2, 0x2c, 0xbf, 0x3b, 0x69, 0xa8, 0x5e, 0x6d, 0xe4,
-	0xf9, 0xee, 0x77, 0x8f, 0xed, 0xbe, 0x78, 0xff, 0xce, 0x6d, 0x7b, 0x96, 0xdf, 0xfc, 0xd5, 0x43,
-	0x94, 0xec, 0xee, 0xfb, 0x05, 0xd8, 0xe5, 0xc0, 0xd4, 0x81, 0x4e, 0x26, 0xa7, 0x32, 0x59, 0x48,
-	0xd2, 0xa0, 0x7d, 0xe8, 0x86, 0x99, 0x52, 0x5c, 0x86, 0x4b, 0x62, 0xd1, 0x6b, 0x70, 0xc6, 0x62,
-	0x64, 0x14, 0x0f, 0xb9, 0xd4, 0x48, 0x2e, 0x28, 0x85, 0xab, 0x12, 0x8c, 0xd8, 0x3c, 0xc9, 0x94,
-	0xd0, 0x1c, 0x49, 0x93, 0xde, 0xc2, 0xf3, 0x19, 0x47, 0x64, 0x63, 0x8e, 0x66, 0xa4, 0x92, 0x99,
-	0x09, 0x13, 0xa9, 0x59, 0xa8, 0xd1, 0x24, 0x32, 0x5e, 0x12, 0xbb, 0x2c, 0x4a, 0x15, 0x1f, 0x71,
-	0xa5, 0x78, 0x64, 0x24, 0x9b, 0x71, 0xd2, 0xa2, 0x03, 0xb8, 0x4e, 0x15, 0x9f, 0x0b, 0xbe, 0x30,
-	0xa9, 0x12, 0x73, 0x16, 0x2e, 0x49, 0x9b, 0xbe, 0x00, 0x37, 0x55, 0xc9, 0x48, 0xc4, 0xdc, 0xa4,
-	0x22, 0xd4, 0x99, 0xe2, 0x68, 0x70, 0x92, 0x2c, 0x8c, 0x4e, 0x48, 0xa7, 0x5c, 0xe7, 0xbf, 0xec,
-	0x5c, 0xa0, 0x18, 0x8a, 0x58, 0xe8, 0x25, 0xe9, 0xd2, 0x67, 0x30, 0x40, 0x2e, 0x23, 0x83, 0x9a,
-	0xe9, 0x0c, 0x4d, 0x96, 0x46, 0xac, 0x9c, 0xb0, 0x57, 0xf6, 0x45, 0x2d, 0xc2, 0x29, 0x57, 0x68,
-	0x52, 0x16, 0x4e, 0xd1, 0x08, 0x89, 0x9a, 0xc5, 0x31, 0x8f, 0x08, 0xd0, 0x1b, 0x78, 0xfa, 0x4f,
-	0x36, 0xe5, 0x32, 0x12, 0x72, 0x4c, 0x9c, 0xbf, 0x2a, 0xeb, 0x53, 0x30, 0xe7, 0x98, 0xf4, 0x29,
-	0x81, 0x7e, 0x24, 0x30, 0x8d, 0xd9, 0xb2, 0xde, 0xd6, 0xe5, 0xb0, 0x03, 0xad, 0xfa, 0x12, 0x2e,
-	0x3f, 0x3a, 0xc1, 0xeb, 0x0f, 0xe7, 0x57, 0xb2, 0x6a, 0x57, 0x7f, 0x6f, 0x7f, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x8b, 0x2e, 0x81, 0x96, 0x76, 0x02, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4d, 0x6f, 0xda, 0x4c,
+	0x10, 0x80, 0x71, 0x62, 0xbe, 0xc6, 0x84, 0xac, 0x96, 0x57, 0x79, 0xad, 0xb4, 0x52, 0xdc, 0xf4,
+	0xe2, 0x93, 0x2b, 0xb5, 0x55, 0x2f, 0x3d, 0x2d, 0xf6, 0x02, 0x2b, 0xcc, 0xda, 0xda, 0x59, 0x83,
+	0xe8, 0x65, 0x55, 0x10, 0x8d, 0x50, 0x11, 0x46, 0xc1, 0xa9, 0xc4, 0x0f, 0xed, 0x9f, 0xe8, 0xaf,
+	0xa8, 0x6c, 0x97, 0x7e, 0x9e, 0xec, 0x79, 0xe6, 0x99, 0xd9, 0xd9, 0x0f, 0x18, 0x1c, 0x4f, 0xfb,
+	0xb5, 0x39, 0x6e, 0x8a, 0x62, 0xbb, 0x7f, 0x38, 0x06, 0x87, 0xc7, 0xbc, 0xc8, 0x69, 0xa7, 0xfa,
+	0xac, 0x9e, 0x3e, 0xdd, 0x7f, 0xb5, 0xc1, 0xc1, 0xd3, 0x7e, 0x8d, 0xb5, 0x40, 0x03, 0xb0, 0x8b,
+	0xd3, 0x61, 0xe3, 0x5a, 0x9e, 0xe5, 0xf7, 0x5f, 0xdf, 0x06, 0x67, 0x31, 0xf8, 0x4d, 0x0a, 0xf4,
+	0xe9, 0xb0, 0x51, 0x95, 0x47, 0xff, 0x83, 0xe6, 0x7a, 0x97, 0xaf, 0x3f, 0xbb, 0x17, 0x9e, 0xe5,
+	0xdb, 0xaa, 0x0e, 0xe8, 0x4b, 0xe8, 0x7d, 0xf9, 0xb8, 0x7b, 0xda, 0x98, 0x63, 0xf1, 0xb8, 0xdd,
+	0x3f, 0xb8, 0x97, 0x9e, 0xe5, 0x77, 0x27, 0x0d, 0xe5, 0x54, 0x14, 0x2b, 0x48, 0x5f, 0x40, 0x1d,
+	0x9a, 0xd5, 0xa9, 0xd8, 0x1c, 0x5d, 0xdb, 0xb3, 0xfc, 0xde, 0xa4, 0xa1, 0xa0, 0x82, 0xc3, 0x92,
+	0xd1, 0x3b, 0x80, 0x1f, 0x4a, 0x9e, 0xef, 0xdc, 0xa6, 0x67, 0xf9, 0x9d, 0x49, 0x43, 0x75, 0x6b,
+	0x23, 0xcf, 0x77, 0xbf, 0x7a, 0x6c, 0xf7, 0xc5, 0xbb, 0xb7, 0x6e, 0xcb, 0xb3, 0xfc, 0xcb, 0x9f,
+	0x3d, 0x44, 0xc9, 0xee, 0xbf, 0x5d, 0x80, 0x5d, 0x0e, 0x4c, 0x1d, 0x68, 0x67, 0x72, 0x2a, 0x93,
+	0x85, 0x24, 0x0d, 0xda, 0x83, 0x4e, 0x98, 0x29, 0xc5, 0x65, 0xb8, 0x24, 0x16, 0xbd, 0x06, 0x67,
+	0x2c, 0x46, 0x46, 0xf1, 0x90, 0x4b, 0x8d, 0xe4, 0x82, 0x52, 0xe8, 0x97, 0x60, 0xc4, 0xe6, 0x49,
+	0xa6, 0x84, 0xe6, 0x48, 0x2e, 0xe9, 0x1d, 0x3c, 0x9b, 0x71, 0x44, 0x36, 0xe6, 0x68, 0x46, 0x2a,
+	0x99, 0x99, 0x30, 0x91, 0x9a, 0x85, 0x1a, 0x4d, 0x22, 0xe3, 0x25, 0xb1, 0xcb, 0xa2, 0x54, 0xf1,
+	0x11, 0x57, 0x8a, 0x47, 0x46, 0xb2, 0x19, 0x27, 0x4d, 0x3a, 0x80, 0xeb, 0x54, 0xf1, 0xb9, 0xe0,
+	0x0b, 0x93, 0x2a, 0x31, 0x67, 0xe1, 0x92, 0xb4, 0xe8, 0x73, 0x70, 0x53, 0x95, 0x8c, 0x44, 0xcc,
+	0x4d, 0x2a, 0x42, 0x9d, 0x29, 0x8e, 0x06, 0x27, 0xc9, 0xc2, 0xe8, 0x84, 0xb4, 0xcb, 0x75, 0xfe,
+	0xc9, 0xce, 0x05, 0x8a, 0xa1, 0x88, 0x85, 0x5e, 0x92, 0x0e, 0xfd, 0x1f, 0x06, 0xc8, 0x65, 0x64,
+	0x50, 0x33, 0x9d, 0xa1, 0xc9, 0xd2, 0x88, 0x95, 0x13, 0x76, 0xcb, 0xbe, 0xa8, 0x45, 0x38, 0xe5,
+	0x0a, 0x4d, 0xca, 0xc2, 0x29, 0x1a, 0x21, 0x51, 0xb3, 0x38, 0xe6, 0x11, 0x01, 0x7a, 0x0b, 0x37,
+	0x7f, 0x65, 0x53, 0x2e, 0x23, 0x21, 0xc7, 0xc4, 0xf9, 0xa3, 0xb2, 0x3e, 0x05, 0x73, 0x8e, 0x49,
+	0x8f, 0x12, 0xe8, 0x45, 0x02, 0xd3, 0x98, 0x2d, 0xeb, 0x6d, 0x5d, 0xd1, 0x1b, 0xa0, 0xd5, 0x08,
+	0x8a, 0xb3, 0xa8, 0x2a, 0x10, 0xa9, 0x46, 0xd2, 0x1f, 0xb6, 0xa1, 0x59, 0x5f, 0xce, 0xd5, 0x07,
+	0x27, 0x78, 0xf5, 0xfe, 0xfc, 0x7a, 0x56, 0xad, 0xea, 0xef, 0xcd, 0xf7, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x69, 0x55, 0x31, 0x17, 0x8e, 0x02, 0x00, 0x00,
 }
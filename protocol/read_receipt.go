@@ -0,0 +1,10 @@
+package protocol
+
+// ReadReceipt records that a message we sent has been read by one of the chat members
+type ReadReceipt struct {
+	MessageID string `json:"messageId"`
+	ChatID    string `json:"chatId"`
+	// Reader is the public key of the member who read the message
+	Reader string `json:"reader"`
+	Clock  uint64 `json:"clock"`
+}
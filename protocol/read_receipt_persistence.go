@@ -0,0 +1,110 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// SaveReadReceipts stores the receipts and returns the ones that were not known yet
+func (db sqlitePersistence) SaveReadReceipts(receipts []*ReadReceipt) (saved []*ReadReceipt, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, receipt := range receipts {
+		result, err := tx.Exec(`INSERT INTO read_receipts(message_id, reader, chat_id, clock) VALUES (?, ?, ?, ?)`,
+			receipt.MessageID,
+			receipt.Reader,
+			receipt.ChatID,
+			receipt.Clock,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rowsAffected > 0 {
+			saved = append(saved, receipt)
+		}
+	}
+
+	return saved, nil
+}
+
+// ReadReceipts returns the receipts received for the message, ordered by clock
+func (db sqlitePersistence) ReadReceipts(messageID string) ([]*ReadReceipt, error) {
+	rows, err := db.db.Query(`SELECT message_id, reader, chat_id, clock FROM read_receipts WHERE message_id = ? ORDER BY clock`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var receipts []*ReadReceipt
+	for rows.Next() {
+		receipt := &ReadReceipt{}
+		if err := rows.Scan(&receipt.MessageID, &receipt.Reader, &receipt.ChatID, &receipt.Clock); err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, rows.Err()
+}
+
+// UnseenIncomingMessageIDs returns the IDs of the messages of the chat not sent by us
+// that haven't been seen yet, up to the given clock
+func (db sqlitePersistence) UnseenIncomingMessageIDs(chatID string, ourID string, clock uint64) ([]string, error) {
+	rows, err := db.db.Query(`SELECT id FROM user_messages WHERE local_chat_id = ? AND NOT(seen) AND clock_value <= ? AND source != ? AND NOT(hide)`, chatID, clock, ourID)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessageIDs(rows)
+}
+
+// UnseenIncomingMessageIDsIn filters ids down to the messages not sent by us that haven't been seen yet
+func (db sqlitePersistence) UnseenIncomingMessageIDsIn(ids []string, ourID string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(ids)+1)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	args = append(args, ourID)
+
+	inVector := strings.Repeat("?, ", len(ids)-1) + "?"
+	rows, err := db.db.Query(`SELECT id FROM user_messages WHERE id IN (`+inVector+`) AND NOT(seen) AND source != ? AND NOT(hide)`, args...) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	return scanMessageIDs(rows)
+}
+
+func scanMessageIDs(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
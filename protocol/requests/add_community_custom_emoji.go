@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrAddCommunityCustomEmojiInvalidCommunityID = errors.New("add-community-custom-emoji: invalid community id")
+var ErrAddCommunityCustomEmojiInvalidName = errors.New("add-community-custom-emoji: invalid name")
+var ErrAddCommunityCustomEmojiInvalidImage = errors.New("add-community-custom-emoji: invalid image")
+
+type AddCommunityCustomEmoji struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Name        string         `json:"name"`
+	ImagePath   string         `json:"imagePath"`
+}
+
+func (a *AddCommunityCustomEmoji) Validate() error {
+	if len(a.CommunityID) == 0 {
+		return ErrAddCommunityCustomEmojiInvalidCommunityID
+	}
+
+	if len(a.Name) == 0 {
+		return ErrAddCommunityCustomEmojiInvalidName
+	}
+
+	if len(a.ImagePath) == 0 {
+		return ErrAddCommunityCustomEmojiInvalidImage
+	}
+
+	return nil
+}
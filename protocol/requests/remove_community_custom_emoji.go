@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrRemoveCommunityCustomEmojiInvalidCommunityID = errors.New("remove-community-custom-emoji: invalid community id")
+var ErrRemoveCommunityCustomEmojiInvalidEmojiID = errors.New("remove-community-custom-emoji: invalid emoji id")
+
+type RemoveCommunityCustomEmoji struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	EmojiID     string         `json:"emojiId"`
+}
+
+func (r *RemoveCommunityCustomEmoji) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrRemoveCommunityCustomEmojiInvalidCommunityID
+	}
+
+	if len(r.EmojiID) == 0 {
+		return ErrRemoveCommunityCustomEmojiInvalidEmojiID
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrSaveChatDraftInvalidChatID = errors.New("save-chat-draft: invalid chat id")
+
+type SaveChatDraft struct {
+	ChatID     string   `json:"chatId"`
+	Text       string   `json:"text"`
+	ResponseTo string   `json:"responseTo"`
+	ImagePaths []string `json:"imagePaths"`
+	AudioPath  string   `json:"audioPath"`
+}
+
+func (s *SaveChatDraft) Validate() error {
+	if len(s.ChatID) == 0 {
+		return ErrSaveChatDraftInvalidChatID
+	}
+
+	return nil
+}
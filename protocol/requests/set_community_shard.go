@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSetCommunityShardInvalidCommunityID = errors.New("set-community-shard: invalid community id")
+
+type SetCommunityShard struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// Shard is the shard to move the community to, the one derived from its id if nil
+	Shard *uint32 `json:"shard,omitempty"`
+}
+
+func (r *SetCommunityShard) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrSetCommunityShardInvalidCommunityID
+	}
+
+	return nil
+}
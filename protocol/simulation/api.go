@@ -0,0 +1,162 @@
+package simulation
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/waku-org/go-waku/waku/v2/payload"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/wakuv2"
+	wakucommon "github.com/status-im/status-go/wakuv2/common"
+)
+
+// publicAPI is the Waku API of a node, encoding the messages as Waku v2 does
+type publicAPI struct {
+	node *Node
+}
+
+func (api *publicAPI) AddPrivateKey(ctx context.Context, privateKey types.HexBytes) (string, error) {
+	key, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return "", err
+	}
+	return api.node.AddKeyPair(key)
+}
+
+func (api *publicAPI) GenerateSymKeyFromPassword(ctx context.Context, passwd string) (string, error) {
+	return api.node.AddSymKeyFromPassword(passwd)
+}
+
+func (api *publicAPI) DeleteKeyPair(ctx context.Context, key string) (bool, error) {
+	if ok := api.node.DeleteKeyPair(key); ok {
+		return true, nil
+	}
+	return false, fmt.Errorf("key pair %s not found", key)
+}
+
+func (api *publicAPI) BloomFilter() []byte {
+	return nil
+}
+
+func (api *publicAPI) NewMessageFilter(req types.Criteria) (string, error) {
+	symKeyGiven := len(req.SymKeyID) > 0
+	asymKeyGiven := len(req.PrivateKeyID) > 0
+
+	// user must specify either a symmetric or an asymmetric key
+	if (symKeyGiven && asymKeyGiven) || (!symKeyGiven && !asymKeyGiven) {
+		return "", wakuv2.ErrSymAsym
+	}
+
+	f := &wakucommon.Filter{
+		Messages: wakucommon.NewMemoryMessageStore(),
+	}
+
+	var err error
+	if len(req.Sig) > 0 {
+		if f.Src, err = crypto.UnmarshalPubkey(req.Sig); err != nil {
+			return "", wakuv2.ErrInvalidSigningPubKey
+		}
+	}
+	if symKeyGiven {
+		if f.KeySym, err = api.node.GetSymKey(req.SymKeyID); err != nil {
+			return "", err
+		}
+	}
+	if asymKeyGiven {
+		if f.KeyAsym, err = api.node.GetPrivateKey(req.PrivateKeyID); err != nil {
+			return "", err
+		}
+	}
+	for _, topic := range req.Topics {
+		f.Topics = append(f.Topics, topic[:])
+	}
+
+	return api.node.install(f)
+}
+
+func (api *publicAPI) GetFilterMessages(id string) ([]*types.Message, error) {
+	f := api.node.filters.Get(id)
+	if f == nil {
+		return nil, fmt.Errorf("filter not found")
+	}
+
+	receivedMessages := f.Retrieve()
+	messages := make([]*types.Message, 0, len(receivedMessages))
+	for _, msg := range receivedMessages {
+		wakuMsg := wakuv2.ToWakuMessage(msg)
+		messages = append(messages, &types.Message{
+			Sig:       wakuMsg.Sig,
+			Timestamp: wakuMsg.Timestamp,
+			Topic:     types.TopicType(wakuMsg.Topic),
+			Payload:   wakuMsg.Payload,
+			Padding:   wakuMsg.Padding,
+			Hash:      wakuMsg.Hash,
+			Dst:       wakuMsg.Dst,
+		})
+	}
+	return messages, nil
+}
+
+func (api *publicAPI) Post(ctx context.Context, req types.NewMessage) ([]byte, error) {
+	symKeyGiven := len(req.SymKeyID) > 0
+	pubKeyGiven := len(req.PublicKey) > 0
+
+	// user must specify either a symmetric or an asymmetric key
+	if (symKeyGiven && pubKeyGiven) || (!symKeyGiven && !pubKeyGiven) {
+		return nil, wakuv2.ErrSymAsym
+	}
+
+	keyInfo := new(payload.KeyInfo)
+
+	var err error
+	if len(req.SigID) > 0 {
+		if keyInfo.PrivKey, err = api.node.GetPrivateKey(req.SigID); err != nil {
+			return nil, err
+		}
+	}
+
+	if symKeyGiven {
+		keyInfo.Kind = payload.Symmetric
+		if req.Topic == (types.TopicType{}) { // topics are mandatory with symmetric encryption
+			return nil, wakuv2.ErrNoTopics
+		}
+		if keyInfo.SymKey, err = api.node.GetSymKey(req.SymKeyID); err != nil {
+			return nil, err
+		}
+		if !wakucommon.ValidateDataIntegrity(keyInfo.SymKey, wakucommon.AESKeyLength) {
+			return nil, wakuv2.ErrInvalidSymmetricKey
+		}
+	}
+
+	if pubKeyGiven {
+		keyInfo.Kind = payload.Asymmetric
+		var pubK *ecdsa.PublicKey
+		if pubK, err = crypto.UnmarshalPubkey(req.PublicKey); err != nil {
+			return nil, wakuv2.ErrInvalidPublicKey
+		}
+		keyInfo.PubKey = *pubK
+	}
+
+	var version uint32 = 1 // Use wakuv1 encryption
+
+	p := &payload.Payload{
+		Data: req.Payload,
+		Key:  keyInfo,
+	}
+	encoded, err := p.Encode(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.node.Send(req.PubsubTopic, &pb.WakuMessage{
+		Payload:      encoded,
+		Version:      version,
+		ContentTopic: wakucommon.TopicType(req.Topic).ContentTopic(),
+		Timestamp:    api.node.GetCurrentTime().UnixNano(),
+		Ephemeral:    req.Ephemeral,
+	})
+}
@@ -0,0 +1,225 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/pbkdf2"
+
+	wakucommon "github.com/status-im/status-go/wakuv2/common"
+)
+
+// ErrUnreachable is returned when a request is made to a peer that is offline
+// or on the other side of a partition.
+var ErrUnreachable = errors.New("peer unreachable")
+
+// LinkConditions are the conditions of the link between two peers of the network
+type LinkConditions struct {
+	// Latency is the time a message takes to go through the link
+	Latency time.Duration
+	// Jitter is the maximum random delay added to Latency
+	Jitter time.Duration
+	// Loss is the probability, between 0 and 1, that a message is dropped
+	Loss float64
+}
+
+// peer is a member of the network relaying messages
+type peer interface {
+	ID() string
+	Online() bool
+	subscribed(pubsubTopic string) bool
+	deliver(envelope *protocol.Envelope)
+}
+
+type link struct {
+	from string
+	to   string
+}
+
+// Network is an in-memory Waku v2 relay network. Every peer is linked to every
+// other peer, each link having its own conditions.
+type Network struct {
+	logger *zap.Logger
+
+	mu         sync.Mutex
+	peers      map[string]peer
+	defaults   LinkConditions
+	links      map[link]LinkConditions
+	partitions map[string]int
+	rand       *rand.Rand
+
+	symKeysMu sync.Mutex
+	symKeys   map[string][]byte
+
+	inFlight sync.WaitGroup
+}
+
+func NewNetwork(logger *zap.Logger) *Network {
+	return &Network{
+		logger:     logger,
+		peers:      make(map[string]peer),
+		links:      make(map[link]LinkConditions),
+		partitions: make(map[string]int),
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())), // nolint: gosec
+		symKeys:    make(map[string][]byte),
+	}
+}
+
+// Seed makes the losses and jitter of the network reproducible
+func (n *Network) Seed(seed int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.rand = rand.New(rand.NewSource(seed)) // nolint: gosec
+}
+
+// SetDefaultLink sets the conditions of the links not configured with SetLink
+func (n *Network) SetDefaultLink(conditions LinkConditions) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.defaults = conditions
+}
+
+// SetLink sets the conditions of the link between the peers a and b, both ways
+func (n *Network) SetLink(a, b string, conditions LinkConditions) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.links[link{from: a, to: b}] = conditions
+	n.links[link{from: b, to: a}] = conditions
+}
+
+// Partition splits the network: the peers of each group only reach each other,
+// and the peers in none of the groups only reach each other too.
+func (n *Network) Partition(groups ...[]string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.partitions = make(map[string]int)
+	for i, group := range groups {
+		for _, id := range group {
+			n.partitions[id] = i + 1
+		}
+	}
+}
+
+// Heal removes the partitions of the network
+func (n *Network) Heal() {
+	n.Partition()
+}
+
+// Wait blocks until all the messages in flight are delivered or dropped
+func (n *Network) Wait() {
+	n.inFlight.Wait()
+}
+
+func (n *Network) join(p peer) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.peers[p.ID()] = p
+}
+
+func (n *Network) leave(id string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.peers, id)
+}
+
+func (n *Network) peer(id string) peer {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.peers[id]
+}
+
+func (n *Network) reachable(from, to string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.partitions[from] == n.partitions[to]
+}
+
+// route returns how long a message takes from a peer to another, and whether
+// it gets there at all
+func (n *Network) route(from, to string) (time.Duration, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.partitions[from] != n.partitions[to] {
+		return 0, false
+	}
+
+	conditions, ok := n.links[link{from: from, to: to}]
+	if !ok {
+		conditions = n.defaults
+	}
+	if conditions.Loss > 0 && n.rand.Float64() < conditions.Loss {
+		return 0, false
+	}
+
+	delay := conditions.Latency
+	if conditions.Jitter > 0 {
+		delay += time.Duration(n.rand.Int63n(int64(conditions.Jitter)))
+	}
+	return delay, true
+}
+
+// peerCount returns the number of online peers reachable from id
+func (n *Network) peerCount(id string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	count := 0
+	for _, p := range n.peers {
+		if p.ID() != id && p.Online() && n.partitions[p.ID()] == n.partitions[id] {
+			count++
+		}
+	}
+	return count
+}
+
+// publish relays envelope from the peer from to the peers subscribed to its pubsub topic
+func (n *Network) publish(from string, envelope *protocol.Envelope) {
+	n.mu.Lock()
+	peers := make([]peer, 0, len(n.peers))
+	for _, p := range n.peers {
+		peers = append(peers, p)
+	}
+	n.mu.Unlock()
+
+	for _, p := range peers {
+		if p.ID() == from || !p.Online() || !p.subscribed(envelope.PubsubTopic()) {
+			continue
+		}
+
+		delay, ok := n.route(from, p.ID())
+		if !ok {
+			n.logger.Debug("message dropped", zap.String("from", from), zap.String("to", p.ID()))
+			continue
+		}
+
+		to := p
+		n.inFlight.Add(1)
+		time.AfterFunc(delay, func() {
+			defer n.inFlight.Done()
+			// The peer may have gone offline, or been partitioned, in the meantime
+			if to.Online() && n.reachable(from, to.ID()) {
+				to.deliver(envelope)
+			}
+		})
+	}
+}
+
+// symKeyFromPassword derives the key of a password as Waku does. The keys are
+// shared by the peers as deriving them is expensive.
+func (n *Network) symKeyFromPassword(password string) []byte {
+	n.symKeysMu.Lock()
+	defer n.symKeysMu.Unlock()
+
+	key, ok := n.symKeys[password]
+	if !ok {
+		key = pbkdf2.Key([]byte(password), nil, 65356, wakucommon.AESKeyLength, sha256.New)
+		n.symKeys[password] = key
+	}
+	return key
+}
@@ -0,0 +1,190 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var testTopic = types.BytesToTopic([]byte("test"))
+
+const testPassword = "simulation"
+
+func newTestNodes(t *testing.T, network *Network, count int) []*Node {
+	var nodes []*Node
+	for i := 0; i < count; i++ {
+		nodes = append(nodes, NewNode(network, fmt.Sprintf("node-%d", i), zap.NewNop()))
+	}
+	return nodes
+}
+
+func subscribe(t *testing.T, n *Node) string {
+	symKeyID, err := n.AddSymKeyFromPassword(testPassword)
+	require.NoError(t, err)
+	filterID, err := n.Subscribe(&types.SubscriptionOptions{
+		SymKeyID: symKeyID,
+		Topics:   [][]byte{testTopic[:]},
+	})
+	require.NoError(t, err)
+	return filterID
+}
+
+func post(t *testing.T, n *Node, payload string) {
+	symKeyID, err := n.AddSymKeyFromPassword(testPassword)
+	require.NoError(t, err)
+	_, err = n.PublicWakuAPI().Post(context.Background(), types.NewMessage{
+		SymKeyID: symKeyID,
+		Topic:    testTopic,
+		Payload:  []byte(payload),
+	})
+	require.NoError(t, err)
+}
+
+// received returns the payloads received by the filter filterID of n
+func received(t *testing.T, n *Node, filterID string) []string {
+	messages, err := n.PublicWakuAPI().GetFilterMessages(filterID)
+	require.NoError(t, err)
+	var payloads []string
+	for _, message := range messages {
+		payloads = append(payloads, string(message.Payload))
+	}
+	return payloads
+}
+
+// flush waits for the messages being sent and relayed
+func flush(network *Network) {
+	time.Sleep(50 * time.Millisecond)
+	network.Wait()
+}
+
+func TestRelay(t *testing.T) {
+	network := NewNetwork(zap.NewNop())
+	nodes := newTestNodes(t, network, 3)
+	filters := make([]string, len(nodes))
+	for i, n := range nodes {
+		filters[i] = subscribe(t, n)
+	}
+
+	post(t, nodes[0], "hello")
+	flush(network)
+
+	for i, n := range nodes {
+		require.Equal(t, []string{"hello"}, received(t, n, filters[i]))
+	}
+	require.Equal(t, 2, nodes[0].PeerCount())
+}
+
+func TestLatency(t *testing.T) {
+	network := NewNetwork(zap.NewNop())
+	nodes := newTestNodes(t, network, 3)
+	network.SetLink(nodes[0].ID(), nodes[2].ID(), LinkConditions{Latency: 500 * time.Millisecond})
+	filter1 := subscribe(t, nodes[1])
+	filter2 := subscribe(t, nodes[2])
+
+	post(t, nodes[0], "hello")
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, []string{"hello"}, received(t, nodes[1], filter1))
+	require.Empty(t, received(t, nodes[2], filter2))
+
+	flush(network)
+	require.Equal(t, []string{"hello"}, received(t, nodes[2], filter2))
+}
+
+func TestLoss(t *testing.T) {
+	network := NewNetwork(zap.NewNop())
+	network.Seed(1)
+	network.SetDefaultLink(LinkConditions{Loss: 0.5})
+	nodes := newTestNodes(t, network, 2)
+	filter := subscribe(t, nodes[1])
+
+	for i := 0; i < 100; i++ {
+		post(t, nodes[0], fmt.Sprintf("message %d", i))
+	}
+	flush(network)
+
+	count := len(received(t, nodes[1], filter))
+	require.Greater(t, count, 20)
+	require.Less(t, count, 80)
+}
+
+func TestPartition(t *testing.T) {
+	network := NewNetwork(zap.NewNop())
+	nodes := newTestNodes(t, network, 3)
+	filter1 := subscribe(t, nodes[1])
+	filter2 := subscribe(t, nodes[2])
+
+	network.Partition([]string{nodes[0].ID(), nodes[1].ID()})
+	require.Equal(t, 1, nodes[0].PeerCount())
+
+	post(t, nodes[0], "partitioned")
+	flush(network)
+	require.Equal(t, []string{"partitioned"}, received(t, nodes[1], filter1))
+	require.Empty(t, received(t, nodes[2], filter2))
+
+	network.Heal()
+	post(t, nodes[0], "healed")
+	flush(network)
+	require.Equal(t, []string{"healed"}, received(t, nodes[1], filter1))
+	require.Equal(t, []string{"healed"}, received(t, nodes[2], filter2))
+}
+
+func TestOfflineNode(t *testing.T) {
+	network := NewNetwork(zap.NewNop())
+	nodes := newTestNodes(t, network, 2)
+	filter := subscribe(t, nodes[1])
+
+	nodes[1].SetOnline(false)
+	require.Equal(t, 0, nodes[0].PeerCount())
+	post(t, nodes[0], "missed")
+	flush(network)
+
+	nodes[1].SetOnline(true)
+	require.Empty(t, received(t, nodes[1], filter))
+}
+
+func TestStoreCatchUp(t *testing.T) {
+	network := NewNetwork(zap.NewNop())
+	store := NewStoreNode(network, StoreNodeID)
+	nodes := newTestNodes(t, network, 2)
+	filter := subscribe(t, nodes[1])
+
+	nodes[1].SetOnline(false)
+	from := time.Now().Add(-time.Second)
+	for i := 0; i < 5; i++ {
+		post(t, nodes[0], fmt.Sprintf("message %d", i))
+	}
+	flush(network)
+	require.Equal(t, 5, store.Count())
+
+	nodes[1].SetOnline(true)
+	request := types.MessagesRequest{
+		From:   uint32(from.Unix()),
+		To:     uint32(time.Now().Add(time.Second).Unix()),
+		Limit:  2,
+		Topics: [][]byte{testTopic[:]},
+	}
+	var pages int
+	for {
+		cursor, err := nodes[1].RequestStoreMessages([]byte(store.ID()), request)
+		require.NoError(t, err)
+		pages++
+		if cursor == nil {
+			break
+		}
+		request.StoreCursor = cursor
+	}
+	require.Equal(t, 3, pages)
+	require.Len(t, received(t, nodes[1], filter), 5)
+	require.True(t, nodes[1].ProcessingP2PMessages())
+
+	// Partitioned away from the store node, the node can't catch up
+	network.Partition([]string{store.ID()})
+	_, err := nodes[1].RequestStoreMessages([]byte(store.ID()), request)
+	require.Equal(t, ErrUnreachable, err)
+}
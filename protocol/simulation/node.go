@@ -0,0 +1,536 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/connection"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	wakucommon "github.com/status-im/status-go/wakuv2/common"
+)
+
+// Node is a Waku v2 node of an in-memory network, relaying the messages of
+// the pubsub topics its filters are interested in.
+type Node struct {
+	id      string
+	network *Network
+	logger  *zap.Logger
+
+	mu           sync.RWMutex
+	online       bool
+	pubsubTopics map[string]int // number of filters by pubsub topic
+	envelopes    map[gethcommon.Hash]bool
+	storeMsgIDs  map[gethcommon.Hash]bool
+	timesource   func() time.Time
+
+	keyMu       sync.RWMutex
+	privateKeys map[string]*ecdsa.PrivateKey
+	symKeys     map[string][]byte
+
+	filters        *wakucommon.Filters
+	installed      map[string]*wakucommon.Filter
+	envelopeFeed   event.Feed
+	topicBandwidth *wakucommon.TopicBandwidthTracker
+}
+
+// NewNode adds a node to the network. Its id must be unique in the network.
+func NewNode(network *Network, id string, logger *zap.Logger) *Node {
+	n := &Node{
+		id:             id,
+		network:        network,
+		logger:         logger.With(zap.String("node", id)),
+		online:         true,
+		pubsubTopics:   make(map[string]int),
+		envelopes:      make(map[gethcommon.Hash]bool),
+		storeMsgIDs:    make(map[gethcommon.Hash]bool),
+		timesource:     time.Now,
+		privateKeys:    make(map[string]*ecdsa.PrivateKey),
+		symKeys:        make(map[string][]byte),
+		filters:        wakucommon.NewFilters(),
+		installed:      make(map[string]*wakucommon.Filter),
+		topicBandwidth: wakucommon.NewTopicBandwidthTracker(),
+	}
+	network.join(n)
+	return n
+}
+
+func pubsubTopicOrDefault(topic string) string {
+	if topic == "" {
+		return relay.DefaultWakuTopic
+	}
+	return topic
+}
+
+func (n *Node) ID() string {
+	return n.id
+}
+
+func (n *Node) Online() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.online
+}
+
+// SetOnline connects or disconnects the node. It misses the messages relayed
+// while offline, and can't publish.
+func (n *Node) SetOnline(online bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.online = online
+}
+
+// Close removes the node from the network
+func (n *Node) Close() {
+	n.network.leave(n.id)
+}
+
+func (n *Node) subscribed(pubsubTopic string) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return pubsubTopic == relay.DefaultWakuTopic || n.pubsubTopics[pubsubTopic] > 0
+}
+
+func (n *Node) deliver(envelope *protocol.Envelope) {
+	msg := envelope.Message()
+	n.topicBandwidth.AddDownload(wakucommon.ProtocolRelay, envelope.PubsubTopic(), msg.ContentTopic, msg.Size())
+	n.receive(envelope, wakucommon.RelayedMessageType)
+}
+
+// receive hands a new envelope over to the filters, as Waku does
+func (n *Node) receive(envelope *protocol.Envelope, msgType wakucommon.MessageType) {
+	recvMessage := wakucommon.NewReceivedMessage(envelope, msgType)
+	hash := recvMessage.Hash()
+
+	n.mu.Lock()
+	if n.envelopes[hash] {
+		n.mu.Unlock()
+		return
+	}
+	n.envelopes[hash] = true
+	if msgType == wakucommon.StoreMessageType {
+		n.storeMsgIDs[hash] = true
+	}
+	n.mu.Unlock()
+
+	if !n.filters.NotifyWatchers(recvMessage) {
+		n.mu.Lock()
+		delete(n.storeMsgIDs, hash)
+		n.mu.Unlock()
+	}
+
+	n.envelopeFeed.Send(types.EnvelopeEvent{
+		Event: types.EventEnvelopeAvailable,
+		Hash:  types.Hash(hash),
+	})
+}
+
+// Send publishes msg on pubsubTopic, the default one if empty
+func (n *Node) Send(pubsubTopic string, msg *pb.WakuMessage) ([]byte, error) {
+	hash, _, err := msg.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := protocol.NewEnvelope(msg, msg.Timestamp, pubsubTopicOrDefault(pubsubTopic))
+	n.receive(envelope, wakucommon.RelayedMessageType)
+
+	go func() {
+		if !n.Online() {
+			n.logger.Debug("could not send message while offline", zap.String("envelopeHash", hexutil.Encode(hash)))
+			n.envelopeFeed.Send(types.EnvelopeEvent{
+				Event: types.EventEnvelopeExpired,
+				Hash:  types.BytesToHash(hash),
+			})
+			return
+		}
+
+		n.network.publish(n.id, envelope)
+		n.envelopeFeed.Send(types.EnvelopeEvent{
+			Event: types.EventEnvelopeSent,
+			Hash:  types.BytesToHash(hash),
+		})
+		n.topicBandwidth.AddUpload(wakucommon.ProtocolRelay, envelope.PubsubTopic(), msg.ContentTopic, msg.Size())
+	}()
+
+	return hash, nil
+}
+
+// CatchUp requests from the store node storeID the messages of the topics of
+// all the filters, sent between from and to, as the messenger does with its
+// active mailserver.
+func (n *Node) CatchUp(storeID string, from, to time.Time) error {
+	topics := make(map[string][][]byte)
+	n.mu.RLock()
+	for _, f := range n.installed {
+		pubsubTopic := pubsubTopicOrDefault(f.PubsubTopic)
+		topics[pubsubTopic] = append(topics[pubsubTopic], f.Topics...)
+	}
+	n.mu.RUnlock()
+
+	for pubsubTopic, contentTopics := range topics {
+		request := types.MessagesRequest{
+			From:        uint32(from.Unix()),
+			To:          uint32(to.Unix()),
+			Topics:      contentTopics,
+			PubsubTopic: pubsubTopic,
+		}
+		for {
+			cursor, err := n.RequestStoreMessages([]byte(storeID), request)
+			if err != nil {
+				return err
+			}
+			if cursor == nil {
+				break
+			}
+			request.StoreCursor = cursor
+		}
+	}
+	return nil
+}
+
+func (n *Node) PublicWakuAPI() types.PublicWakuAPI {
+	return &publicAPI{node: n}
+}
+
+func (n *Node) Version() uint {
+	return 2
+}
+
+func (n *Node) PeerCount() int {
+	if !n.Online() {
+		return 0
+	}
+	return n.network.peerCount(n.id)
+}
+
+func (n *Node) ListenAddresses() ([]string, error) {
+	return []string{n.id}, nil
+}
+
+func (n *Node) Peers() map[string]types.WakuV2Peer {
+	return nil
+}
+
+func (n *Node) StartDiscV5() error {
+	return nil
+}
+
+func (n *Node) StopDiscV5() error {
+	return nil
+}
+
+func (n *Node) AddStorePeer(address string) (string, error) {
+	return address, nil
+}
+
+func (n *Node) AddRelayPeer(address string) (string, error) {
+	return address, nil
+}
+
+func (n *Node) DialPeer(address string) error {
+	return nil
+}
+
+func (n *Node) DialPeerByID(peerID string) error {
+	return nil
+}
+
+func (n *Node) DropPeer(peerID string) error {
+	return nil
+}
+
+func (n *Node) SubscribeToConnStatusChanges() (*types.ConnStatusSubscription, error) {
+	return types.NewConnStatusSubscription(), nil
+}
+
+// DEPRECATED: Not used in Waku v2
+func (n *Node) MinPow() float64 {
+	return 0
+}
+
+// DEPRECATED: Not used in Waku v2
+func (n *Node) BloomFilter() []byte {
+	return nil
+}
+
+func (n *Node) SetTimeSource(timesource func() time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.timesource = timesource
+}
+
+func (n *Node) GetCurrentTime() time.Time {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.timesource()
+}
+
+func (n *Node) SubscribeEnvelopeEvents(eventsProxy chan<- types.EnvelopeEvent) types.Subscription {
+	events := make(chan types.EnvelopeEvent, 100) // must be buffered to prevent blocking the node
+	go func() {
+		for e := range events {
+			eventsProxy <- e
+		}
+	}()
+
+	return gethbridge.NewGethSubscriptionWrapper(n.envelopeFeed.Subscribe(events))
+}
+
+func keyPairID(key *ecdsa.PrivateKey) string {
+	return hexutil.Encode(crypto.Keccak256(crypto.FromECDSAPub(&key.PublicKey)))
+}
+
+func (n *Node) GetPrivateKey(id string) (*ecdsa.PrivateKey, error) {
+	n.keyMu.RLock()
+	defer n.keyMu.RUnlock()
+	key, ok := n.privateKeys[id]
+	if !ok {
+		return nil, fmt.Errorf("invalid id")
+	}
+	return key, nil
+}
+
+func (n *Node) AddKeyPair(key *ecdsa.PrivateKey) (string, error) {
+	id := keyPairID(key)
+	n.keyMu.Lock()
+	defer n.keyMu.Unlock()
+	n.privateKeys[id] = key
+	return id, nil
+}
+
+func (n *Node) DeleteKeyPair(keyID string) bool {
+	n.keyMu.Lock()
+	defer n.keyMu.Unlock()
+	_, ok := n.privateKeys[keyID]
+	delete(n.privateKeys, keyID)
+	return ok
+}
+
+func (n *Node) addSymKey(key []byte) (string, error) {
+	id, err := wakucommon.GenerateRandomID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate ID: %s", err)
+	}
+
+	n.keyMu.Lock()
+	defer n.keyMu.Unlock()
+	n.symKeys[id] = key
+	return id, nil
+}
+
+func (n *Node) AddSymKeyDirect(key []byte) (string, error) {
+	if len(key) != wakucommon.AESKeyLength {
+		return "", fmt.Errorf("wrong key size: %d", len(key))
+	}
+	return n.addSymKey(key)
+}
+
+func (n *Node) AddSymKeyFromPassword(password string) (string, error) {
+	return n.addSymKey(n.network.symKeyFromPassword(password))
+}
+
+func (n *Node) DeleteSymKey(id string) bool {
+	n.keyMu.Lock()
+	defer n.keyMu.Unlock()
+	_, ok := n.symKeys[id]
+	delete(n.symKeys, id)
+	return ok
+}
+
+func (n *Node) GetSymKey(id string) ([]byte, error) {
+	n.keyMu.RLock()
+	defer n.keyMu.RUnlock()
+	key, ok := n.symKeys[id]
+	if !ok {
+		return nil, fmt.Errorf("non-existent key ID")
+	}
+	return key, nil
+}
+
+func (n *Node) MaxMessageSize() uint32 {
+	return wakucommon.DefaultMaxMessageSize
+}
+
+func (n *Node) GetStats() types.StatsSummary {
+	return types.StatsSummary{}
+}
+
+func (n *Node) TakeTopicBandwidth() []types.TopicBandwidth {
+	var result []types.TopicBandwidth
+	for _, bandwidth := range n.topicBandwidth.Take() {
+		topic, err := wakucommon.ExtractTopicFromContentTopic(bandwidth.ContentTopic)
+		if err != nil {
+			continue
+		}
+		result = append(result, types.TopicBandwidth{
+			PubsubTopic: bandwidth.PubsubTopic,
+			Topic:       types.TopicType(topic),
+			Protocol:    bandwidth.Protocol,
+			Upload:      bandwidth.Upload,
+			Download:    bandwidth.Download,
+		})
+	}
+	return result
+}
+
+func (n *Node) Subscribe(opts *types.SubscriptionOptions) (string, error) {
+	f := &wakucommon.Filter{
+		Topics:      opts.Topics,
+		PubsubTopic: opts.PubsubTopic,
+		Messages:    wakucommon.NewMemoryMessageStore(),
+	}
+
+	var err error
+	if opts.SymKeyID != "" {
+		if f.KeySym, err = n.GetSymKey(opts.SymKeyID); err != nil {
+			return "", err
+		}
+	}
+	if opts.PrivateKeyID != "" {
+		if f.KeyAsym, err = n.GetPrivateKey(opts.PrivateKeyID); err != nil {
+			return "", err
+		}
+	}
+
+	return n.install(f)
+}
+
+func (n *Node) install(f *wakucommon.Filter) (string, error) {
+	id, err := n.filters.Install(f)
+	if err != nil {
+		return "", err
+	}
+
+	n.mu.Lock()
+	n.installed[id] = f
+	n.pubsubTopics[pubsubTopicOrDefault(f.PubsubTopic)]++
+	n.mu.Unlock()
+
+	return id, nil
+}
+
+func (n *Node) GetFilter(id string) types.Filter {
+	f := n.filters.Get(id)
+	if f == nil {
+		return nil
+	}
+	return gethbridge.NewWakuV2FilterWrapper(f, id)
+}
+
+func (n *Node) Unsubscribe(id string) error {
+	f := n.filters.Get(id)
+	if f == nil || !n.filters.Uninstall(id) {
+		return fmt.Errorf("failed to unsubscribe: invalid ID '%s'", id)
+	}
+
+	n.mu.Lock()
+	delete(n.installed, id)
+	n.pubsubTopics[pubsubTopicOrDefault(f.PubsubTopic)]--
+	n.mu.Unlock()
+
+	return nil
+}
+
+func (n *Node) UnsubscribeMany(ids []string) error {
+	for _, id := range ids {
+		if err := n.Unsubscribe(id); err != nil {
+			n.logger.Warn("could not remove filter", zap.String("id", id), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// DEPRECATED: Not used in Waku v2
+func (n *Node) RequestHistoricMessagesWithTimeout(peerID []byte, envelope types.Envelope, timeout time.Duration) error {
+	return errors.New("DEPRECATED")
+}
+
+// DEPRECATED: Not used in Waku v2
+func (n *Node) SendMessagesRequest(peerID []byte, r types.MessagesRequest) error {
+	return errors.New("DEPRECATED")
+}
+
+// RequestStoreMessages queries the store node peerID. The messages are
+// delivered to the filters, and the cursor of the next page is returned if
+// there is one.
+func (n *Node) RequestStoreMessages(peerID []byte, r types.MessagesRequest) (*types.StoreRequestCursor, error) {
+	store, ok := n.network.peer(string(peerID)).(*StoreNode)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a store node", peerID)
+	}
+	if !n.Online() || !store.Online() {
+		return nil, ErrUnreachable
+	}
+
+	// Both the query and its response go through the links
+	there, ok := n.network.route(n.id, store.ID())
+	if !ok {
+		return nil, ErrUnreachable
+	}
+	back, ok := n.network.route(store.ID(), n.id)
+	if !ok {
+		return nil, ErrUnreachable
+	}
+	time.Sleep(there + back)
+
+	var cursor *pb.Index
+	if r.StoreCursor != nil {
+		cursor = &pb.Index{
+			Digest:       r.StoreCursor.Digest,
+			ReceiverTime: r.StoreCursor.ReceiverTime,
+			SenderTime:   r.StoreCursor.SenderTime,
+			PubsubTopic:  r.StoreCursor.PubsubTopic,
+		}
+	}
+
+	contentTopics := make([]string, len(r.Topics))
+	for i, topic := range r.Topics {
+		contentTopics[i] = wakucommon.BytesToTopic(topic).ContentTopic()
+	}
+
+	pubsubTopic := pubsubTopicOrDefault(r.PubsubTopic)
+	envelopes, next := store.query(pubsubTopic, contentTopics, int64(r.From)*int64(time.Second), int64(r.To)*int64(time.Second), cursor, int(r.Limit))
+	for _, envelope := range envelopes {
+		msg := envelope.Message()
+		n.topicBandwidth.AddDownload(wakucommon.ProtocolStore, pubsubTopic, msg.ContentTopic, msg.Size())
+		n.receive(envelope, wakucommon.StoreMessageType)
+	}
+
+	if next == nil {
+		return nil, nil
+	}
+	return &types.StoreRequestCursor{
+		Digest:       next.Digest,
+		ReceiverTime: next.ReceiverTime,
+		SenderTime:   next.SenderTime,
+		PubsubTopic:  next.PubsubTopic,
+	}, nil
+}
+
+func (n *Node) ProcessingP2PMessages() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return len(n.storeMsgIDs) != 0
+}
+
+func (n *Node) MarkP2PMessageAsProcessed(hash gethcommon.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.storeMsgIDs, hash)
+}
+
+func (n *Node) ConnectionChanged(state connection.State) {}
@@ -0,0 +1,323 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func hasMessage(p *Peer, chatID, text string) bool {
+	messages, _, err := p.MessageByChatID(chatID, "", 1000)
+	if err != nil {
+		return false
+	}
+	for _, message := range messages {
+		if message.Text == text {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Simulation) sendText(p *Peer, chatID, text string) error {
+	message := &common.Message{}
+	message.ChatId = chatID
+	message.Text = text
+	message.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+	_, err := p.SendChatMessage(context.Background(), message)
+	return err
+}
+
+// MakeContacts makes a and b mutual contacts: a sends a contact request that b accepts
+func (s *Simulation) MakeContacts(a, b *Peer) error {
+	_, err := a.SendContactRequest(context.Background(), &requests.SendContactRequest{
+		ID:      types.Hex2Bytes(b.PublicKeyString()),
+		Message: "hello",
+	})
+	if err != nil {
+		return err
+	}
+
+	var requestID string
+	err = s.WaitUntil(b, func() bool {
+		pending, _, err := b.PendingContactRequests("", 100)
+		if err != nil {
+			return false
+		}
+		for _, request := range pending {
+			if request.From == a.PublicKeyString() {
+				requestID = request.ID
+				return true
+			}
+		}
+		return false
+	}, "contact request not received")
+	if err != nil {
+		return err
+	}
+
+	_, err = b.AcceptContactRequest(context.Background(), &requests.AcceptContactRequest{ID: types.Hex2Bytes(requestID)})
+	if err != nil {
+		return err
+	}
+
+	err = s.WaitUntil(a, func() bool {
+		return mutualContact(a, b)
+	}, "contact request not accepted")
+	if err != nil {
+		return err
+	}
+
+	// b sees a as a mutual contact once a's contact update reaches it
+	return s.WaitUntil(b, func() bool {
+		return mutualContact(b, a)
+	}, "contact request acceptance not acknowledged")
+}
+
+// mutualContact returns whether p has other as a mutual contact, which group
+// chats require of their members
+func mutualContact(p, other *Peer) bool {
+	contact := p.GetContactByID(other.PublicKeyString())
+	return contact != nil && contact.ContactRequestState == protocol.ContactRequestStateMutual &&
+		contact.Added && contact.HasAddedUs
+}
+
+// retryUntilMutualContacts calls fn until it doesn't fail because p doesn't have
+// all the members as mutual contacts yet, as contact updates lost on the way
+// are only resent later
+func (s *Simulation) retryUntilMutualContacts(p *Peer, fn func() error) error {
+	var err error
+	waitErr := s.WaitUntil(p, func() bool {
+		err = fn()
+		return !errors.Is(err, protocol.ErrGroupChatAddedContacts)
+	}, "members not mutual contacts")
+	if waitErr != nil {
+		return waitErr
+	}
+	return err
+}
+
+// CommunityJoin has owner create an open community and share it with members,
+// who all join it. It checks a message of owner in the community reaches them.
+func (s *Simulation) CommunityJoin(owner *Peer, members []*Peer) (*communities.Community, error) {
+	response, err := owner.CreateCommunity(&requests.CreateCommunity{
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+		Name:        "simulation",
+		Color:       "#ffffff",
+		Description: "simulation community",
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Communities()) != 1 || len(response.Chats()) != 1 {
+		return nil, errors.New("community not created")
+	}
+	community := response.Communities()[0]
+	chatID := response.Chats()[0].ID
+
+	users := make([]types.HexBytes, len(members))
+	for i, member := range members {
+		users[i] = types.Hex2Bytes(member.PublicKeyString())
+	}
+	_, err = owner.ShareCommunity(&requests.ShareCommunity{CommunityID: community.ID(), Users: users})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.WaitUntilAll(members, func(p *Peer) bool {
+		c, err := p.GetCommunityByID(community.ID())
+		return err == nil && c != nil
+	}, "community not received")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		_, err := member.RequestToJoinCommunity(&requests.RequestToJoinCommunity{CommunityID: community.ID()})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.WaitUntil(owner, func() bool {
+		c, err := owner.GetCommunityByID(community.ID())
+		if err != nil || c == nil {
+			return false
+		}
+		for _, member := range members {
+			if !c.HasMember(member.IdentityPublicKey()) {
+				return false
+			}
+		}
+		community = c
+		return true
+	}, "requests to join not accepted")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.WaitUntilAll(members, func(p *Peer) bool {
+		c, err := p.GetCommunityByID(community.ID())
+		return err == nil && c != nil && c.Joined() && c.HasMember(p.IdentityPublicKey())
+	}, "community not joined")
+	if err != nil {
+		return nil, err
+	}
+
+	text := "welcome to the simulation"
+	if err := s.sendText(owner, chatID, text); err != nil {
+		return nil, err
+	}
+	err = s.WaitUntilAll(members, func(p *Peer) bool {
+		return hasMessage(p, chatID, text)
+	}, "community message not received")
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// GroupChatChurn has admin create a group chat with half of members, then
+// replace one of them by one of the others at each round. After each change
+// it checks the members see the same group as admin and receive its messages.
+func (s *Simulation) GroupChatChurn(admin *Peer, members []*Peer, rounds int) error {
+	if len(members) < 2 {
+		return errors.New("at least 2 members are needed")
+	}
+
+	for _, member := range members {
+		if err := s.MakeContacts(admin, member); err != nil {
+			return err
+		}
+	}
+
+	current := append([]*Peer(nil), members[:len(members)/2]...)
+	waiting := append([]*Peer(nil), members[len(members)/2:]...)
+
+	ids := make([]string, len(current))
+	for i, member := range current {
+		ids[i] = member.PublicKeyString()
+	}
+	var response *protocol.MessengerResponse
+	err := s.retryUntilMutualContacts(admin, func() error {
+		var err error
+		response, err = admin.CreateGroupChatWithMembers(context.Background(), "simulation", ids)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if len(response.Chats()) != 1 {
+		return errors.New("group chat not created")
+	}
+	chatID := response.Chats()[0].ID
+
+	for round := 0; ; round++ {
+		expected := make(map[string]bool)
+		for _, member := range admin.Chat(chatID).Members {
+			expected[member.ID] = true
+		}
+
+		text := fmt.Sprintf("round %d", round)
+		if err := s.sendText(admin, chatID, text); err != nil {
+			return err
+		}
+
+		err := s.WaitUntilAll(current, func(p *Peer) bool {
+			chat := p.Chat(chatID)
+			if chat == nil || len(chat.Members) != len(expected) {
+				return false
+			}
+			for _, member := range chat.Members {
+				if !expected[member.ID] {
+					return false
+				}
+			}
+			return hasMessage(p, chatID, text)
+		}, fmt.Sprintf("group chat not in sync at round %d", round))
+		if err != nil {
+			return err
+		}
+
+		if round == rounds {
+			return nil
+		}
+
+		out, in := current[0], waiting[0]
+		_, err = admin.RemoveMembersFromGroupChat(context.Background(), chatID, []string{out.PublicKeyString()})
+		if err != nil {
+			return err
+		}
+		err = s.retryUntilMutualContacts(admin, func() error {
+			_, err := admin.AddMembersToGroupChat(context.Background(), chatID, []string{in.PublicKeyString()})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		current = append(current[1:], in)
+		waiting = append(waiting[1:], out)
+	}
+}
+
+// OfflineCatchUp takes receiver offline while sender sends count messages to a
+// public chat they both joined. It checks receiver gets them from the store
+// node once back online.
+func (s *Simulation) OfflineCatchUp(sender, receiver *Peer, count int) error {
+	chatID := fmt.Sprintf("simulation-%d", time.Now().UnixNano())
+	for _, p := range []*Peer{sender, receiver} {
+		if _, err := p.CreatePublicChat(&requests.CreatePublicChat{ID: chatID}); err != nil {
+			return err
+		}
+	}
+
+	receiver.SetOnline(false)
+	from := time.Now().Add(-time.Second)
+
+	stored := s.Store.Count()
+	texts := make([]string, count)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("message %d", i)
+		if err := s.sendText(sender, chatID, texts[i]); err != nil {
+			return err
+		}
+	}
+	err := s.waitFor(func() bool {
+		return s.Store.Count() >= stored+count
+	}, "messages not stored")
+	if err != nil {
+		return err
+	}
+
+	receiver.SetOnline(true)
+	if _, err := receiver.RetrieveAll(); err != nil {
+		return err
+	}
+	for _, text := range texts {
+		if hasMessage(receiver, chatID, text) {
+			return errors.New("message received while offline")
+		}
+	}
+
+	if err := receiver.Node.CatchUp(s.Store.ID(), from, time.Now().Add(time.Second)); err != nil {
+		return err
+	}
+
+	return s.WaitUntil(receiver, func() bool {
+		for _, text := range texts {
+			if !hasMessage(receiver, chatID, text) {
+				return false
+			}
+		}
+		return true
+	}, "messages not caught up")
+}
@@ -0,0 +1,81 @@
+package simulation
+
+import (
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The scenarios run on a few nodes by default, set SIMULATION_NODES to run
+// them at scale, e.g. SIMULATION_NODES=50
+func testNodes(t *testing.T) int {
+	nodes := 6
+	if value := os.Getenv("SIMULATION_NODES"); value != "" {
+		var err error
+		nodes, err = strconv.Atoi(value)
+		require.NoError(t, err)
+	}
+	return nodes
+}
+
+func newTestSimulation(t *testing.T, link LinkConditions) *Simulation {
+	if testing.Short() {
+		t.Skip("skipping the simulation in short mode")
+	}
+
+	nodes := testNodes(t)
+	s, err := New(Config{
+		Nodes:   nodes,
+		Link:    link,
+		Seed:    1,
+		Timeout: time.Minute + time.Duration(nodes)*time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+	return s
+}
+
+func TestCommunityJoin(t *testing.T) {
+	s := newTestSimulation(t, LinkConditions{Latency: 20 * time.Millisecond, Jitter: 20 * time.Millisecond})
+
+	community, err := s.CommunityJoin(s.Peers[0], s.Peers[1:])
+	require.NoError(t, err)
+	require.Equal(t, len(s.Peers), community.MembersCount())
+}
+
+func TestGroupChatChurn(t *testing.T) {
+	s := newTestSimulation(t, LinkConditions{Latency: 20 * time.Millisecond, Loss: 0.05})
+
+	require.NoError(t, s.GroupChatChurn(s.Peers[0], s.Peers[1:], 3))
+}
+
+func TestOfflineCatchUp(t *testing.T) {
+	s := newTestSimulation(t, LinkConditions{Latency: 20 * time.Millisecond})
+
+	require.NoError(t, s.OfflineCatchUp(s.Peers[0], s.Peers[1], 10))
+}
+
+func TestPartitionHeals(t *testing.T) {
+	s := newTestSimulation(t, LinkConditions{Latency: 20 * time.Millisecond})
+	sender, receiver := s.Peers[0], s.Peers[1]
+
+	// Datasync resends the messages that don't get through
+	require.NoError(t, s.MakeContacts(sender, receiver))
+	s.Network.Partition([]string{sender.Node.ID()})
+	require.NoError(t, s.sendText(sender, receiver.PublicKeyString(), "across the partition"))
+
+	time.Sleep(time.Second)
+	_, err := receiver.RetrieveAll()
+	require.NoError(t, err)
+	require.False(t, hasMessage(receiver, sender.PublicKeyString(), "across the partition"))
+
+	s.Network.Heal()
+	require.NoError(t, s.WaitUntil(receiver, func() bool {
+		return hasMessage(receiver, sender.PublicKeyString(), "across the partition")
+	}, "message not resent"))
+}
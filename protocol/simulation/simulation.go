@@ -0,0 +1,283 @@
+// Package simulation runs full messengers over an in-memory Waku v2 network,
+// whose links can be slowed down, made lossy or partitioned, to regression-test
+// the protocol at scale without a live fleet.
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/account/generator"
+	"github.com/status-im/status-go/connection"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	enstypes "github.com/status-im/status-go/eth-node/types/ens"
+	"github.com/status-im/status-go/multiaccounts"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/sqlite"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// StoreNodeID is the id of the store node of a simulation
+const StoreNodeID = "store"
+
+// Config is the configuration of a simulation
+type Config struct {
+	// Nodes is the number of messengers started with the simulation
+	Nodes int
+	// Link is the default conditions of the links of the network
+	Link LinkConditions
+	// Seed makes the losses and jitter of the network reproducible if not 0
+	Seed int64
+	// Timeout is how long a condition is waited for, 30 seconds by default
+	Timeout time.Duration
+	// Options are the extra options of all the messengers
+	Options []protocol.Option
+	Logger  *zap.Logger
+}
+
+// Peer is a messenger and the node it talks through
+type Peer struct {
+	*protocol.Messenger
+	Node *Node
+}
+
+// PublicKeyString returns the hex encoded public key of the peer
+func (p *Peer) PublicKeyString() string {
+	return common.PubkeyToHex(p.IdentityPublicKey())
+}
+
+// SetOnline connects or disconnects the peer, letting its messenger know
+func (p *Peer) SetOnline(online bool) {
+	p.Node.SetOnline(online)
+	p.ConnectionChanged(connection.State{Offline: !online})
+}
+
+// Simulation is a set of messengers talking over an in-memory network with a
+// store node.
+type Simulation struct {
+	Network *Network
+	Store   *StoreNode
+	Peers   []*Peer
+
+	config Config
+	logger *zap.Logger
+	dir    string
+}
+
+// New starts a simulation with config.Nodes messengers
+func New(config Config) (*Simulation, error) {
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+	logger := config.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	dir, err := ioutil.TempDir("", "simulation-")
+	if err != nil {
+		return nil, err
+	}
+
+	network := NewNetwork(logger)
+	network.SetDefaultLink(config.Link)
+	if config.Seed != 0 {
+		network.Seed(config.Seed)
+	}
+
+	s := &Simulation{
+		Network: network,
+		Store:   NewStoreNode(network, StoreNodeID),
+		config:  config,
+		logger:  logger,
+		dir:     dir,
+	}
+
+	for i := 0; i < config.Nodes; i++ {
+		if _, err := s.AddPeer(); err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// AddPeer starts a new messenger on the network
+func (s *Simulation) AddPeer() (*Peer, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	id := fmt.Sprintf("node-%d", len(s.Peers))
+	node := NewNode(s.Network, id, s.logger)
+
+	m, err := s.newMessenger(node, privateKey, id)
+	if err != nil {
+		node.Close()
+		return nil, err
+	}
+
+	p := &Peer{Messenger: m, Node: node}
+	s.Peers = append(s.Peers, p)
+	return p, nil
+}
+
+func (s *Simulation) newMessenger(node *Node, privateKey *ecdsa.PrivateKey, id string) (*protocol.Messenger, error) {
+	madb, err := multiaccounts.InitializeDB(filepath.Join(s.dir, id+"-accounts.sql"))
+	if err != nil {
+		return nil, err
+	}
+
+	acc := generator.NewAccount(privateKey, nil)
+	iai := acc.ToIdentifiedAccountInfo("")
+
+	options := []protocol.Option{
+		protocol.WithCustomLogger(s.logger.With(zap.String("node", id))),
+		protocol.WithDatabaseConfig(":memory:", "somekey", sqlite.ReducedKDFIterationsNumber),
+		protocol.WithMultiAccounts(madb),
+		protocol.WithAccount(iai.ToMultiAccount()),
+		protocol.WithDatasync(),
+		protocol.WithToplevelDatabaseMigrations(),
+		protocol.WithAppSettings(settings.Settings{
+			ProfilePicturesShowTo:     1,
+			ProfilePicturesVisibility: 1,
+		}, params.NodeConfig{}),
+		protocol.WithBrowserDatabase(nil),
+	}
+	options = append(options, s.config.Options...)
+
+	m, err := protocol.NewMessenger(id, privateKey, &wakuNode{waku: node}, uuid.New().String(), nil, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Init(); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Start(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Close stops all the messengers
+func (s *Simulation) Close() error {
+	var errs []error
+	for _, p := range s.Peers {
+		if err := p.Shutdown(); err != nil {
+			errs = append(errs, err)
+		}
+		p.Node.Close()
+	}
+	s.Network.Wait()
+
+	if err := os.RemoveAll(s.dir); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to close the simulation: %v", errs)
+	}
+	return nil
+}
+
+// WaitUntil processes the messages received by p until condition holds
+func (s *Simulation) WaitUntil(p *Peer, condition func() bool, description string) error {
+	deadline := time.Now().Add(s.config.Timeout)
+	for {
+		if _, err := p.RetrieveAll(); err != nil {
+			return err
+		}
+		if condition() {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s: %s", p.Node.ID(), description)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// WaitUntilAll processes the messages received by peers until condition
+// holds for all of them
+func (s *Simulation) WaitUntilAll(peers []*Peer, condition func(*Peer) bool, description string) error {
+	pending := append([]*Peer(nil), peers...)
+	deadline := time.Now().Add(s.config.Timeout)
+	for len(pending) != 0 {
+		var next []*Peer
+		for _, p := range pending {
+			if _, err := p.RetrieveAll(); err != nil {
+				return err
+			}
+			if !condition(p) {
+				next = append(next, p)
+			}
+		}
+		pending = next
+
+		if len(pending) != 0 {
+			if time.Now().After(deadline) {
+				return fmt.Errorf("%d peers, %s first: %s", len(pending), pending[0].Node.ID(), description)
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// waitFor polls condition, without processing any message
+func (s *Simulation) waitFor(condition func() bool, description string) error {
+	deadline := time.Now().Add(s.config.Timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			return errors.New(description)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
+
+// wakuNode gives messengers the Waku v2 node of the simulation
+type wakuNode struct {
+	waku types.Waku
+}
+
+func (n *wakuNode) NewENSVerifier(_ *zap.Logger) enstypes.ENSVerifier {
+	panic("not implemented")
+}
+
+func (n *wakuNode) AddPeer(_ string) error {
+	panic("not implemented")
+}
+
+func (n *wakuNode) RemovePeer(_ string) error {
+	panic("not implemented")
+}
+
+func (n *wakuNode) GetWaku(_ interface{}) (types.Waku, error) {
+	return nil, errors.New("not available")
+}
+
+func (n *wakuNode) GetWakuV2(_ interface{}) (types.Waku, error) {
+	return n.waku, nil
+}
+
+func (n *wakuNode) PeersCount() int {
+	return n.waku.PeerCount()
+}
@@ -0,0 +1,133 @@
+package simulation
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+)
+
+// maxPageSize is the number of messages returned by a store query without limit
+const maxPageSize = 100
+
+// StoreNode is a peer of the network keeping all the messages relayed to it,
+// and serving them to the nodes catching up.
+type StoreNode struct {
+	id      string
+	network *Network
+
+	mu        sync.RWMutex
+	online    bool
+	envelopes map[string][]*protocol.Envelope // by pubsub topic, oldest first
+	seen      map[string]bool
+}
+
+// NewStoreNode adds a store node to the network. Its id must be unique in the network.
+func NewStoreNode(network *Network, id string) *StoreNode {
+	s := &StoreNode{
+		id:        id,
+		network:   network,
+		online:    true,
+		envelopes: make(map[string][]*protocol.Envelope),
+		seen:      make(map[string]bool),
+	}
+	network.join(s)
+	return s
+}
+
+func (s *StoreNode) ID() string {
+	return s.id
+}
+
+func (s *StoreNode) Online() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.online
+}
+
+// SetOnline connects or disconnects the store node. It misses the messages
+// relayed while offline.
+func (s *StoreNode) SetOnline(online bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.online = online
+}
+
+// Count returns the number of messages stored
+func (s *StoreNode) Count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.seen)
+}
+
+// Store nodes relay all the pubsub topics
+func (s *StoreNode) subscribed(pubsubTopic string) bool {
+	return true
+}
+
+func (s *StoreNode) deliver(envelope *protocol.Envelope) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash := string(envelope.Hash())
+	if s.seen[hash] {
+		return
+	}
+	s.seen[hash] = true
+
+	topic := envelope.PubsubTopic()
+	envelopes := s.envelopes[topic]
+	i := sort.Search(len(envelopes), func(i int) bool {
+		return indexAfter(envelopes[i].Index(), envelope.Index())
+	})
+	envelopes = append(envelopes, nil)
+	copy(envelopes[i+1:], envelopes[i:])
+	envelopes[i] = envelope
+	s.envelopes[topic] = envelopes
+}
+
+// indexAfter returns whether a comes after b in the store
+func indexAfter(a, b *pb.Index) bool {
+	if a.SenderTime != b.SenderTime {
+		return a.SenderTime > b.SenderTime
+	}
+	return bytes.Compare(a.Digest, b.Digest) > 0
+}
+
+// query returns a page of the messages of pubsubTopic in contentTopics, sent
+// between from and to in nanoseconds. The page starts after cursor if not nil,
+// and the cursor of the next page is returned if there is one.
+func (s *StoreNode) query(pubsubTopic string, contentTopics []string, from, to int64, cursor *pb.Index, limit int) ([]*protocol.Envelope, *pb.Index) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if limit <= 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	wanted := make(map[string]bool, len(contentTopics))
+	for _, topic := range contentTopics {
+		wanted[topic] = true
+	}
+
+	var page []*protocol.Envelope
+	for _, envelope := range s.envelopes[pubsubTopic] {
+		if cursor != nil && !indexAfter(envelope.Index(), cursor) {
+			continue
+		}
+		msg := envelope.Message()
+		if len(wanted) != 0 && !wanted[msg.ContentTopic] {
+			continue
+		}
+		if (from != 0 && msg.Timestamp < from) || (to != 0 && msg.Timestamp > to) {
+			continue
+		}
+		if len(page) == limit {
+			return page, page[len(page)-1].Index()
+		}
+		page = append(page, envelope)
+	}
+	return page, nil
+}
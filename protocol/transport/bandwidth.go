@@ -0,0 +1,98 @@
+package transport
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// DailyTopicBandwidth is the traffic of a topic over a Waku protocol during a
+// day, starting at Day in seconds (UTC)
+type DailyTopicBandwidth struct {
+	Day uint32 `json:"day"`
+	types.TopicBandwidth
+}
+
+// BandwidthStats persists the traffic by day, topic and protocol
+type BandwidthStats struct {
+	db *sql.DB
+}
+
+func NewBandwidthStats(db *sql.DB) *BandwidthStats {
+	return &BandwidthStats{db: db}
+}
+
+// startOfDay returns the start of the day of t, in seconds (UTC)
+func startOfDay(t time.Time) uint32 {
+	return uint32(t.UTC().Truncate(24 * time.Hour).Unix())
+}
+
+// Add adds bandwidth to the counters of the day of t
+func (s *BandwidthStats) Add(t time.Time, bandwidth []types.TopicBandwidth) (err error) {
+	if len(bandwidth) == 0 {
+		return nil
+	}
+
+	day := startOfDay(t)
+
+	var tx *sql.Tx
+	tx, err = s.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, b := range bandwidth {
+		_, err = tx.Exec(`INSERT OR IGNORE INTO bandwidth_stats(day, topic, pubsub_topic, protocol) VALUES (?, ?, ?, ?)`,
+			day, b.Topic.String(), b.PubsubTopic, b.Protocol)
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(`UPDATE bandwidth_stats SET upload = upload + ?, download = download + ? WHERE day = ? AND topic = ? AND pubsub_topic = ? AND protocol = ?`,
+			b.Upload, b.Download, day, b.Topic.String(), b.PubsubTopic, b.Protocol)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Bandwidth returns the counters of the days between from and to, included
+func (s *BandwidthStats) Bandwidth(from, to time.Time) ([]DailyTopicBandwidth, error) {
+	rows, err := s.db.Query(`SELECT day, topic, pubsub_topic, protocol, upload, download FROM bandwidth_stats WHERE day >= ? AND day <= ? ORDER BY day`,
+		startOfDay(from), startOfDay(to))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []DailyTopicBandwidth
+	for rows.Next() {
+		var b DailyTopicBandwidth
+		var topic string
+		err := rows.Scan(&b.Day, &topic, &b.PubsubTopic, &b.Protocol, &b.Upload, &b.Download)
+		if err != nil {
+			return nil, err
+		}
+		b.Topic = types.BytesToTopic(types.FromHex(topic))
+		result = append(result, b)
+	}
+	return result, rows.Err()
+}
+
+// DeleteBefore forgets the counters of the days before t
+func (s *BandwidthStats) DeleteBefore(t time.Time) error {
+	_, err := s.db.Exec(`DELETE FROM bandwidth_stats WHERE day < ?`, startOfDay(t))
+	return err
+}
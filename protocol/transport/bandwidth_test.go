@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/sqlite"
+)
+
+func newTestBandwidthStats(t *testing.T) (*BandwidthStats, func()) {
+	dbPath, err := ioutil.TempFile("", "bandwidth.sql")
+	require.NoError(t, err)
+	db, err := sqlite.Open(dbPath.Name(), "some-key", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+
+	return NewBandwidthStats(db), func() {
+		_ = db.Close()
+		_ = os.Remove(dbPath.Name())
+	}
+}
+
+func TestBandwidthStats(t *testing.T) {
+	s, cleanup := newTestBandwidthStats(t)
+	defer cleanup()
+
+	topic1 := types.BytesToTopic([]byte{1, 1, 1, 1})
+	topic2 := types.BytesToTopic([]byte{2, 2, 2, 2})
+	yesterday := time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC)
+	today := yesterday.Add(2 * time.Hour)
+
+	require.NoError(t, s.Add(yesterday, []types.TopicBandwidth{
+		{Topic: topic1, Protocol: "relay", Upload: 10, Download: 100},
+	}))
+	require.NoError(t, s.Add(today, []types.TopicBandwidth{
+		{Topic: topic1, Protocol: "relay", Upload: 1, Download: 2},
+		{Topic: topic1, Protocol: "store", Download: 50},
+	}))
+	require.NoError(t, s.Add(today.Add(time.Hour), []types.TopicBandwidth{
+		{Topic: topic1, Protocol: "relay", Upload: 3, Download: 4},
+		{Topic: topic2, Protocol: "relay", Download: 5},
+	}))
+
+	bandwidth, err := s.Bandwidth(today, today)
+	require.NoError(t, err)
+	require.Len(t, bandwidth, 3)
+
+	totals := make(map[types.TopicType]map[string]types.TopicBandwidth)
+	for _, b := range bandwidth {
+		require.Equal(t, uint32(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC).Unix()), b.Day)
+		if totals[b.Topic] == nil {
+			totals[b.Topic] = make(map[string]types.TopicBandwidth)
+		}
+		totals[b.Topic][b.Protocol] = b.TopicBandwidth
+	}
+	require.Equal(t, uint64(4), totals[topic1]["relay"].Upload)
+	require.Equal(t, uint64(6), totals[topic1]["relay"].Download)
+	require.Equal(t, uint64(50), totals[topic1]["store"].Download)
+	require.Equal(t, uint64(5), totals[topic2]["relay"].Download)
+
+	bandwidth, err = s.Bandwidth(yesterday, today)
+	require.NoError(t, err)
+	require.Len(t, bandwidth, 4)
+
+	require.NoError(t, s.DeleteBefore(today))
+	bandwidth, err = s.Bandwidth(yesterday, today)
+	require.NoError(t, err)
+	require.Len(t, bandwidth, 3)
+}
@@ -17,6 +17,8 @@ type Filter struct {
 	Identity string `json:"identity"`
 	// Topic is the whisper topic
 	Topic types.TopicType `json:"topic"`
+	// PubsubTopic is the Waku v2 pubsub topic the messages are relayed on, the default one if empty
+	PubsubTopic string `json:"pubsubTopic,omitempty"`
 	// Discovery is whether this is a discovery topic
 	Discovery bool `json:"discovery"`
 	// Negotiated tells us whether is a negotiated topic
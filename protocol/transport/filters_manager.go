@@ -133,6 +133,20 @@ func (f *FiltersManager) InitPublicFilters(chatIDs []string) ([]*Filter, error)
 	return filters, nil
 }
 
+// InitPublicFiltersOnPubsubTopic adds filters for public chats whose messages are relayed
+// on pubsubTopic, the default one if empty
+func (f *FiltersManager) InitPublicFiltersOnPubsubTopic(chatIDs []string, pubsubTopic string) ([]*Filter, error) {
+	var filters []*Filter
+	for _, chatID := range chatIDs {
+		f, err := f.LoadPublicOnPubsubTopic(chatID, pubsubTopic)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
 func (f *FiltersManager) InitCommunityFilters(pks []*ecdsa.PrivateKey) ([]*Filter, error) {
 	var filters []*Filter
 	f.mutex.Lock()
@@ -428,7 +442,7 @@ func (f *FiltersManager) LoadNegotiated(secret types.NegotiatedSecret) (*Filter,
 	}
 
 	keyString := hex.EncodeToString(secret.Key)
-	filter, err := f.addSymmetric(keyString)
+	filter, err := f.addSymmetric(keyString, "")
 	if err != nil {
 		f.logger.Debug("could not register negotiated topic", zap.Error(err))
 		return nil, err
@@ -515,24 +529,53 @@ func (f *FiltersManager) LoadPublic(chatID string) (*Filter, error) {
 		return chat, nil
 	}
 
-	filterAndTopic, err := f.addSymmetric(chatID)
+	return f.loadPublic(chatID, "")
+}
+
+// LoadPublicOnPubsubTopic creates a filter for a public chat whose messages are relayed
+// on pubsubTopic. The filter of the chat is replaced if it was on another pubsub topic.
+func (f *FiltersManager) LoadPublicOnPubsubTopic(chatID string, pubsubTopic string) (*Filter, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if chat, ok := f.filters[chatID]; ok {
+		if chat.PubsubTopic == pubsubTopic {
+			return chat, nil
+		}
+
+		f.logger.Debug("moving filter to another pubsub topic", zap.String("chatID", chatID), zap.String("pubsubTopic", pubsubTopic))
+		if err := f.service.Unsubscribe(chat.FilterID); err != nil {
+			return nil, err
+		}
+		if chat.SymKeyID != "" {
+			f.service.DeleteSymKey(chat.SymKeyID)
+		}
+		delete(f.filters, chatID)
+	}
+
+	return f.loadPublic(chatID, pubsubTopic)
+}
+
+func (f *FiltersManager) loadPublic(chatID string, pubsubTopic string) (*Filter, error) {
+	filterAndTopic, err := f.addSymmetric(chatID, pubsubTopic)
 	if err != nil {
 		f.logger.Debug("could not register public chat topic", zap.String("chatID", chatID), zap.Error(err))
 		return nil, err
 	}
 
 	chat := &Filter{
-		ChatID:   chatID,
-		FilterID: filterAndTopic.FilterID,
-		SymKeyID: filterAndTopic.SymKeyID,
-		Topic:    filterAndTopic.Topic,
-		Listen:   true,
-		OneToOne: false,
+		ChatID:      chatID,
+		FilterID:    filterAndTopic.FilterID,
+		SymKeyID:    filterAndTopic.SymKeyID,
+		Topic:       filterAndTopic.Topic,
+		PubsubTopic: pubsubTopic,
+		Listen:      true,
+		OneToOne:    false,
 	}
 
 	f.filters[chatID] = chat
 
-	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "public"), zap.String("topic", filterAndTopic.Topic.String()))
+	f.logger.Debug("registering filter for", zap.String("chatID", chatID), zap.String("type", "public"), zap.String("topic", filterAndTopic.Topic.String()), zap.String("pubsubTopic", pubsubTopic))
 
 	return chat, nil
 }
@@ -548,7 +591,7 @@ func (f *FiltersManager) LoadContactCode(pubKey *ecdsa.PublicKey) (*Filter, erro
 		return f.filters[chatID], nil
 	}
 
-	contactCodeFilter, err := f.addSymmetric(chatID)
+	contactCodeFilter, err := f.addSymmetric(chatID, "")
 	if err != nil {
 		f.logger.Debug("could not register contact code topic", zap.String("chatID", chatID), zap.Error(err))
 		return nil, err
@@ -571,7 +614,7 @@ func (f *FiltersManager) LoadContactCode(pubKey *ecdsa.PublicKey) (*Filter, erro
 }
 
 // addSymmetric adds a symmetric key filter
-func (f *FiltersManager) addSymmetric(chatID string) (*RawFilter, error) {
+func (f *FiltersManager) addSymmetric(chatID string, pubsubTopic string) (*RawFilter, error) {
 	var symKeyID string
 	var err error
 
@@ -601,9 +644,10 @@ func (f *FiltersManager) addSymmetric(chatID string) (*RawFilter, error) {
 	}
 
 	id, err := f.service.Subscribe(&types.SubscriptionOptions{
-		SymKeyID: symKeyID,
-		PoW:      minPow,
-		Topics:   topics,
+		SymKeyID:    symKeyID,
+		PoW:         minPow,
+		Topics:      topics,
+		PubsubTopic: pubsubTopic,
 	})
 	if err != nil {
 		return nil, err
@@ -130,3 +130,29 @@ func (s *FiltersManagerSuite) assertRequiredFilters() {
 	s.Require().NotNil(partitionedFilter, "It adds the partitioned filter")
 	s.Require().True(partitionedFilter.Listen)
 }
+
+func (s *FiltersManagerSuite) TestLoadPublicOnPubsubTopic() {
+	chatID := "community-chat"
+	pubsubTopic := "/waku/2/rs/16/3"
+
+	filter, err := s.chats.LoadPublic(chatID)
+	s.Require().NoError(err)
+	s.Require().Empty(filter.PubsubTopic)
+
+	// The filter of the chat is moved to the pubsub topic
+	moved, err := s.chats.LoadPublicOnPubsubTopic(chatID, pubsubTopic)
+	s.Require().NoError(err)
+	s.Require().Equal(pubsubTopic, moved.PubsubTopic)
+	s.Require().Equal(filter.Topic, moved.Topic)
+	s.Require().NotEqual(filter.FilterID, moved.FilterID)
+	s.Require().Equal(moved, s.chats.FilterByChatID(chatID))
+
+	// Loading it again doesn't replace it
+	loaded, err := s.chats.LoadPublicOnPubsubTopic(chatID, pubsubTopic)
+	s.Require().NoError(err)
+	s.Require().Equal(moved.FilterID, loaded.FilterID)
+
+	loaded, err = s.chats.LoadPublic(chatID)
+	s.Require().NoError(err)
+	s.Require().Equal(moved.FilterID, loaded.FilterID)
+}
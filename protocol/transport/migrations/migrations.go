@@ -5,6 +5,8 @@
 // 1616691080_add_wakuV2_keys.down.sql (24B)
 // 1616691080_add_wakuV2_keys.up.sql (111B)
 // 1634723014_add_wakuV2_keys.up.sql (125B)
+// 1674300012_add_store_sync_state.up.sql (744B)
+// 1674300013_add_bandwidth_stats.up.sql (365B)
 // doc.go (373B)
 
 package sqlite
@@ -174,6 +176,46 @@ func _1634723014_add_wakuv2_keysUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1674300012_add_store_sync_stateUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x51\x6f\x9b\x30\x14\x85\xdf\xf9\x15\xe7\x2d\xa9\x44\xf6\x07\xf6\x44\x52\x47\x43\x63\x10\x11\x47\x6d\x9f\x22\x13\x6e\x8a\xd5\x62\x33\x5f\xd3\x89\x7f\x3f\x19\xd8\x9a\xaa\x48\x7d\xb4\x8e\xfd\x1d\xdf\x73\xee\x66\x83\x83\x65\xed\xb5\x35\xb0\x57\xf8\x86\xc0\xde\x3a\xc2\xef\x9e\x9c\x26\x86\x36\xe8\x9c\x7d\x76\xc4\x1c\x83\x2d\x7c\xa3\x7c\xb8\x36\xe0\xa2\x0c\x2a\x82\x23\xee\x5b\xaa\xa1\xae\x9e\x1c\x54\x38\x7b\xe5\x7c\xb4\x2b\x45\x22\x05\x64\xb2\xcd\x04\xd2\x3d\xf2\x42\x42\x3c\xa6\x47\x79\x9c\x1c\xce\x97\xde\xb1\x75\x8c\x75\x04\x78\xdb\xe9\x0b\xa4\x78\x94\x38\x94\xe9\xaf\xa4\x7c\xc2\x4f\xf1\x84\x22\xc7\xae\xc8\xf7\x59\xba\x93\x28\xc5\x21\x4b\x76\x22\x8e\x30\xbf\x37\xb6\x26\x6c\xb3\x62\x3b\xa2\xf3\x53\x96\x05\xad\xeb\x2b\xee\xab\xf3\x0d\xf0\x9f\x8a\x7b\xb1\x4f\x4e\x99\xc4\x6a\x15\x2e\x86\x01\x87\xf3\xd5\xd9\x16\x69\x2e\x3f\x30\x26\xc9\xdb\x4f\xc2\xf4\xe5\x8f\xa6\xd1\x1d\x1e\x52\xf9\xa3\x38\x49\x94\xc5\x43\x7a\xff\x3d\x8a\x36\x1b\x48\xdd\x12\x9c\x32\xcf\xc4\xf8\xd3\x58\x26\xb4\xc4\xac\xc2\xb1\x51\x6f\x04\xf5\xfa\x8a\x8a\xc8\xc0\x91\x77\x9a\xde\xa8\xc6\xf8\x15\x35\xc7\x1f\x86\x8b\x51\x0d\x53\x32\xdf\x02\xf2\xf8\x5f\x60\xd4\xd6\xac\xfc\x08\x79\x21\xea\xa6\xda\x54\x4b\x68\x74\x78\x3e\x8c\x4d\xcd\xee\xca\x11\x5e\xa8\xf3\xe8\xc8\xdd\xc0\xbf\xee\x87\x07\x73\xa1\xfa\x3c\x63\xd6\x5f\xe4\xbe\x10\xf8\x58\xd5\x04\x59\x8c\x79\xd6\x16\x72\xbe\xdd\x81\xf5\xbb\x6b\x3c\xb9\xc4\xb7\xd4\xbb\xa5\x25\xf9\xdc\xc9\xdf\x00\x00\x00\xff\xff\x35\x32\x95\xbf\xe8\x02\x00\x00")
+
+func _1674300012_add_store_sync_stateUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300012_add_store_sync_stateUpSql,
+		"1674300012_add_store_sync_state.up.sql",
+	)
+}
+
+func _1674300012_add_store_sync_stateUpSql() (*asset, error) {
+	bytes, err := _1674300012_add_store_sync_stateUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300012_add_store_sync_state.up.sql", size: 744, mode: os.FileMode(0644), modTime: time.Unix(1674300012, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5c, 0x67, 0xa9, 0x82, 0x12, 0x24, 0x86, 0x37, 0xb8, 0x25, 0x8e, 0xe4, 0x79, 0x1a, 0xa9, 0x4c, 0xb7, 0xd6, 0x70, 0x6e, 0x3e, 0xe0, 0x94, 0x2b, 0x89, 0x4e, 0x4d, 0x35, 0xcf, 0x57, 0x14, 0x9b}}
+	return a, nil
+}
+
+var __1674300013_add_bandwidth_statsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x90\x41\x6b\x83\x40\x14\x84\xef\xfe\x8a\xb9\x45\xc1\x40\xef\x3d\xd9\x66\x43\x97\x5a\x2d\xfa\xc4\xe4\x14\x56\x77\xdb\x48\xad\x2b\xee\x5a\x49\x7e\x7d\x13\x1b\x0c\x92\x40\xaf\x33\xdf\x9b\x37\xcc\x72\x89\xb4\x3a\x2a\xe8\x0f\xd8\xbd\xc2\xb7\x32\x46\x7c\x2a\x03\xa3\x1a\x0b\xd1\x48\x74\xaa\x54\xd5\x8f\x92\x28\x0e\x90\xe2\xe0\xc3\xea\xb6\x2a\x47\x2b\x17\x5f\x3d\xda\x4e\x5b\x5d\xea\xda\x79\x4e\x58\x40\x0c\x14\x3c\x85\x0c\x7c\x8d\x28\x26\xb0\x0d\x4f\x29\x45\x71\xa2\x87\x4a\xda\xfd\xce\x58\x61\x0d\x5c\x07\xe7\x2c\xf0\x88\x46\x2c\xca\xc2\xd0\x3f\x69\x7f\xd1\xc4\x36\x73\xb9\xed\x0b\xd3\x17\xbb\x3b\x2e\x56\x6c\x1d\x64\x21\x61\xb1\x18\xc1\x4b\x97\xdb\x88\xbe\xad\xb5\x90\xb3\x87\xd3\xed\xc3\x19\x90\x7a\x68\xfe\x41\xde\x13\xfe\x16\x24\x5b\xbc\xb2\x2d\xdc\xeb\x14\xfe\xac\x9f\x3f\x95\xf0\x1c\x0f\x39\xa7\x97\x38\x23\x24\x71\xce\x57\x8f\xce\x2f\x33\xd8\xc9\x7b\x6d\x01\x00\x00")
+
+func _1674300013_add_bandwidth_statsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1674300013_add_bandwidth_statsUpSql,
+		"1674300013_add_bandwidth_stats.up.sql",
+	)
+}
+
+func _1674300013_add_bandwidth_statsUpSql() (*asset, error) {
+	bytes, err := _1674300013_add_bandwidth_statsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1674300013_add_bandwidth_stats.up.sql", size: 365, mode: os.FileMode(0644), modTime: time.Unix(1674300013, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd6, 0x75, 0xb2, 0x70, 0xa9, 0x4c, 0xcb, 0xd5, 0x6f, 0x5b, 0x78, 0xa0, 0xa6, 0x7a, 0x72, 0x4e, 0xe6, 0xf8, 0x9f, 0x7a, 0x45, 0xc5, 0xc3, 0x98, 0x8d, 0x3e, 0x3f, 0xf, 0x58, 0xc9, 0x30, 0xa}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x3d\x72\xeb\x30\x0c\x84\x7b\x9d\x62\xc7\x8d\x9b\x27\xb2\x79\x55\xba\x94\xe9\x73\x01\x98\x5a\x91\x18\x4b\xa4\x42\xc0\x7f\xb7\xcf\xc8\xe3\xc2\x5d\xda\x1d\x7c\x1f\x76\x63\xc4\x77\x51\xc3\xac\x0b\xa1\x86\xca\x44\x33\xe9\x0f\x9c\x98\xe4\x62\xc4\x21\xab\x97\xcb\x29\xa4\xb6\x46\x73\xf1\x8b\x8d\xba\xc6\x55\x73\x17\x67\xbc\xfe\x3f\x0c\x31\x22\x49\x3d\x3a\x8a\xd4\x69\xe1\xd3\x65\x30\x97\xee\x5a\x33\x6e\xea\x05\x82\xad\x73\xd6\x7b\xc0\xa7\x63\xa1\x98\xc3\x8b\xf8\xd1\xe0\x85\x48\x62\xdc\x35\x73\xeb\xc8\x6d\x3c\x69\x9d\xc4\x25\xec\xd1\xd7\xfc\x96\xec\x0d\x93\x2c\x0b\x27\xcc\xbd\xad\x4f\xd6\x64\x25\x26\xed\x4c\xde\xfa\xe3\x1f\xc4\x8c\x8e\x2a\x2b\x6d\xe7\x8b\x5c\x89\xda\x5e\xef\x21\x75\xfa\x7b\x11\x6e\xad\x9f\x0d\x62\xe0\x7d\x63\x72\x4e\x61\x18\x36\x49\x67\xc9\x84\xfd\x2c\xea\x1c\x86\x18\x73\xfb\xc8\xac\xdc\xa9\xf7\x8e\xe3\x76\xce\xaf\x2b\x8c\x0d\x21\xbc\xd4\xda\xaa\x85\xdc\x10\x86\xdf\x00\x00\x00\xff\xff\x21\xa5\x75\x05\x75\x01\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -295,6 +337,10 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1634723014_add_wakuV2_keys.up.sql": _1634723014_add_wakuv2_keysUpSql,
 
+	"1674300012_add_store_sync_state.up.sql": _1674300012_add_store_sync_stateUpSql,
+
+	"1674300013_add_bandwidth_stats.up.sql": _1674300013_add_bandwidth_statsUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -339,12 +385,14 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"1561059284_add_waku_keys.down.sql":   &bintree{_1561059284_add_waku_keysDownSql, map[string]*bintree{}},
-	"1561059284_add_waku_keys.up.sql":     &bintree{_1561059284_add_waku_keysUpSql, map[string]*bintree{}},
-	"1616691080_add_wakuV2_keys.down.sql": &bintree{_1616691080_add_wakuv2_keysDownSql, map[string]*bintree{}},
-	"1616691080_add_wakuV2_keys.up.sql":   &bintree{_1616691080_add_wakuv2_keysUpSql, map[string]*bintree{}},
-	"1634723014_add_wakuV2_keys.up.sql":   &bintree{_1634723014_add_wakuv2_keysUpSql, map[string]*bintree{}},
-	"doc.go":                              &bintree{docGo, map[string]*bintree{}},
+	"1561059284_add_waku_keys.down.sql":      &bintree{_1561059284_add_waku_keysDownSql, map[string]*bintree{}},
+	"1561059284_add_waku_keys.up.sql":        &bintree{_1561059284_add_waku_keysUpSql, map[string]*bintree{}},
+	"1616691080_add_wakuV2_keys.down.sql":    &bintree{_1616691080_add_wakuv2_keysDownSql, map[string]*bintree{}},
+	"1616691080_add_wakuV2_keys.up.sql":      &bintree{_1616691080_add_wakuv2_keysUpSql, map[string]*bintree{}},
+	"1634723014_add_wakuV2_keys.up.sql":      &bintree{_1634723014_add_wakuv2_keysUpSql, map[string]*bintree{}},
+	"1674300012_add_store_sync_state.up.sql": &bintree{_1674300012_add_store_sync_stateUpSql, map[string]*bintree{}},
+	"1674300013_add_bandwidth_stats.up.sql":  &bintree{_1674300013_add_bandwidth_statsUpSql, map[string]*bintree{}},
+	"doc.go":                                 &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,19 @@
+-- Position of the store queries in progress, so that they can be resumed after a restart
+CREATE TABLE IF NOT EXISTS store_cursors (
+  topic TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  store_node BLOB NOT NULL,
+  pubsub_topic TEXT NOT NULL DEFAULT '',
+  query_from INT NOT NULL,
+  query_to INT NOT NULL,
+  cursor BLOB NOT NULL
+) WITHOUT ROWID;
+
+-- Time ranges whose messages have all been retrieved from a store node, by topic.
+-- Store nodes don't all keep the same history, so ranges are kept per store node
+CREATE TABLE IF NOT EXISTS store_synced_ranges (
+  store_node BLOB NOT NULL,
+  topic TEXT NOT NULL,
+  synced_from INT NOT NULL,
+  synced_to INT NOT NULL,
+  PRIMARY KEY (store_node, topic, synced_from) ON CONFLICT REPLACE
+) WITHOUT ROWID;
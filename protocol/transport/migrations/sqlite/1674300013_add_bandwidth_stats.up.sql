@@ -0,0 +1,10 @@
+-- Size of the messages sent and received by day, topic and Waku protocol
+CREATE TABLE IF NOT EXISTS bandwidth_stats (
+  day INT NOT NULL,
+  topic TEXT NOT NULL,
+  pubsub_topic TEXT NOT NULL DEFAULT '',
+  protocol TEXT NOT NULL,
+  upload INT NOT NULL DEFAULT 0,
+  download INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (day, topic, pubsub_topic, protocol)
+) WITHOUT ROWID;
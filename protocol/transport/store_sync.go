@@ -0,0 +1,294 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// SyncedRange is a period, in seconds, whose messages have all been retrieved
+// from a store node
+type SyncedRange struct {
+	From uint32 `json:"from"`
+	To   uint32 `json:"to"`
+}
+
+// StoreCursor is the position of a store query in progress. It's saved after
+// each page so that the query can be resumed after a restart
+type StoreCursor struct {
+	StoreNode   []byte
+	PubsubTopic string
+	From        uint32
+	To          uint32
+	Cursor      *types.StoreRequestCursor
+}
+
+// StoreSyncState persists the store queries in progress and the ranges they
+// covered, by store node and topic
+type StoreSyncState struct {
+	db *sql.DB
+}
+
+func NewStoreSyncState(db *sql.DB) *StoreSyncState {
+	return &StoreSyncState{db: db}
+}
+
+func (s *StoreSyncState) SaveCursor(topics []types.TopicType, cursor *StoreCursor) (err error) {
+	encodedCursor, err := json.Marshal(cursor.Cursor)
+	if err != nil {
+		return err
+	}
+
+	var tx *sql.Tx
+	tx, err = s.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, topic := range topics {
+		_, err = tx.Exec(`INSERT INTO store_cursors(topic, store_node, pubsub_topic, query_from, query_to, cursor) VALUES (?, ?, ?, ?, ?, ?)`,
+			topic.String(), cursor.StoreNode, cursor.PubsubTopic, cursor.From, cursor.To, encodedCursor)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Cursor returns the position of the query in progress for topics, nil if
+// they don't all share the same one
+func (s *StoreSyncState) Cursor(topics []types.TopicType) (*StoreCursor, error) {
+	var cursor *StoreCursor
+	var encodedCursor []byte
+
+	for _, topic := range topics {
+		var current StoreCursor
+		var currentEncodedCursor []byte
+		err := s.db.QueryRow(`SELECT store_node, pubsub_topic, query_from, query_to, cursor FROM store_cursors WHERE topic = ?`, topic.String()).
+			Scan(&current.StoreNode, &current.PubsubTopic, &current.From, &current.To, &currentEncodedCursor)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if cursor == nil {
+			cursor = &current
+			encodedCursor = currentEncodedCursor
+			continue
+		}
+
+		if !bytes.Equal(current.StoreNode, cursor.StoreNode) || current.PubsubTopic != cursor.PubsubTopic || current.From != cursor.From || current.To != cursor.To ||
+			!bytes.Equal(currentEncodedCursor, encodedCursor) {
+			return nil, nil
+		}
+	}
+
+	if cursor == nil {
+		return nil, nil
+	}
+
+	err := json.Unmarshal(encodedCursor, &cursor.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
+
+func (s *StoreSyncState) DeleteCursor(topics []types.TopicType) (err error) {
+	var tx *sql.Tx
+	tx, err = s.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, topic := range topics {
+		_, err = tx.Exec(`DELETE FROM store_cursors WHERE topic = ?`, topic.String())
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// AddSyncedRange marks syncedRange as synced from storeNode for topics, merging it
+// with the ranges it overlaps or touches
+func (s *StoreSyncState) AddSyncedRange(storeNode []byte, topics []types.TopicType, syncedRange SyncedRange) (err error) {
+	if syncedRange.From > syncedRange.To {
+		return nil
+	}
+
+	var tx *sql.Tx
+	tx, err = s.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, topic := range topics {
+		merged := syncedRange
+
+		var rows *sql.Rows
+		rows, err = tx.Query(`SELECT synced_from, synced_to FROM store_synced_ranges WHERE store_node = ? AND topic = ? AND synced_to >= ? AND synced_from <= ?`,
+			storeNode, topic.String(), syncedRange.From, syncedRange.To)
+		if err != nil {
+			return
+		}
+
+		for rows.Next() {
+			var r SyncedRange
+			err = rows.Scan(&r.From, &r.To)
+			if err != nil {
+				rows.Close()
+				return
+			}
+			if r.From < merged.From {
+				merged.From = r.From
+			}
+			if r.To > merged.To {
+				merged.To = r.To
+			}
+		}
+		rows.Close()
+
+		_, err = tx.Exec(`DELETE FROM store_synced_ranges WHERE store_node = ? AND topic = ? AND synced_to >= ? AND synced_from <= ?`,
+			storeNode, topic.String(), syncedRange.From, syncedRange.To)
+		if err != nil {
+			return
+		}
+
+		_, err = tx.Exec(`INSERT INTO store_synced_ranges(store_node, topic, synced_from, synced_to) VALUES (?, ?, ?, ?)`,
+			storeNode, topic.String(), merged.From, merged.To)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// SyncedRanges returns the ranges synced from storeNode for topic, oldest first
+func (s *StoreSyncState) SyncedRanges(storeNode []byte, topic types.TopicType) ([]SyncedRange, error) {
+	rows, err := s.db.Query(`SELECT synced_from, synced_to FROM store_synced_ranges WHERE store_node = ? AND topic = ? ORDER BY synced_from`, storeNode, topic.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []SyncedRange
+	for rows.Next() {
+		var r SyncedRange
+		err := rows.Scan(&r.From, &r.To)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// CommonSyncedRanges returns the ranges synced from storeNode for all topics, oldest first
+func (s *StoreSyncState) CommonSyncedRanges(storeNode []byte, topics []types.TopicType) ([]SyncedRange, error) {
+	var result []SyncedRange
+	for i, topic := range topics {
+		ranges, err := s.SyncedRanges(storeNode, topic)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result = ranges
+			continue
+		}
+		result = intersectSyncedRanges(result, ranges)
+	}
+	return result, nil
+}
+
+// UnsyncedRanges returns the parts of the range between from and to that haven't
+// been synced from storeNode for all topics, newest first. The last tolerance
+// seconds of each synced range are requested again, as messages sent then may
+// have reached the store node after it was queried.
+func (s *StoreSyncState) UnsyncedRanges(storeNode []byte, topics []types.TopicType, from, to uint32, tolerance uint32) ([]SyncedRange, error) {
+	synced, err := s.CommonSyncedRanges(storeNode, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []SyncedRange
+	for i := len(synced) - 1; i >= 0 && from < to; i-- {
+		r := synced[i]
+		if r.To-r.From <= tolerance {
+			continue
+		}
+		r.To -= tolerance
+		if r.To < from || r.From > to {
+			continue
+		}
+		if r.To < to {
+			result = append(result, SyncedRange{From: r.To, To: to})
+		}
+		to = r.From
+	}
+	if from < to {
+		result = append(result, SyncedRange{From: from, To: to})
+	}
+
+	return result, nil
+}
+
+// intersectSyncedRanges returns the periods covered by both a and b, which
+// are sorted and don't overlap
+func intersectSyncedRanges(a, b []SyncedRange) []SyncedRange {
+	var result []SyncedRange
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		from, to := a[i].From, a[i].To
+		if b[j].From > from {
+			from = b[j].From
+		}
+		if b[j].To < to {
+			to = b[j].To
+		}
+		if from <= to {
+			result = append(result, SyncedRange{From: from, To: to})
+		}
+		if a[i].To < b[j].To {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
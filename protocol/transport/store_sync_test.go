@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/sqlite"
+)
+
+func newTestStoreSyncState(t *testing.T) (*StoreSyncState, func()) {
+	dbPath, err := ioutil.TempFile("", "store_sync.sql")
+	require.NoError(t, err)
+	db, err := sqlite.Open(dbPath.Name(), "some-key", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+
+	return NewStoreSyncState(db), func() {
+		_ = db.Close()
+		_ = os.Remove(dbPath.Name())
+	}
+}
+
+func TestStoreCursor(t *testing.T) {
+	s, cleanup := newTestStoreSyncState(t)
+	defer cleanup()
+
+	topic1 := types.BytesToTopic([]byte{1, 1, 1, 1})
+	topic2 := types.BytesToTopic([]byte{2, 2, 2, 2})
+	topics := []types.TopicType{topic1, topic2}
+
+	cursor, err := s.Cursor(topics)
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+
+	saved := &StoreCursor{
+		StoreNode:   []byte{1},
+		PubsubTopic: "/waku/2/default-waku/proto",
+		From:        10,
+		To:          20,
+		Cursor:      &types.StoreRequestCursor{Digest: []byte{1, 2, 3}, SenderTime: 15},
+	}
+	require.NoError(t, s.SaveCursor(topics, saved))
+
+	cursor, err = s.Cursor(topics)
+	require.NoError(t, err)
+	require.Equal(t, saved, cursor)
+
+	// Topics that aren't part of the same query don't share a cursor
+	require.NoError(t, s.SaveCursor([]types.TopicType{topic2}, &StoreCursor{StoreNode: []byte{1}, From: 10, To: 30, Cursor: saved.Cursor}))
+	cursor, err = s.Cursor(topics)
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+
+	require.NoError(t, s.DeleteCursor(topics))
+	cursor, err = s.Cursor([]types.TopicType{topic1})
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+}
+
+func TestSyncedRanges(t *testing.T) {
+	s, cleanup := newTestStoreSyncState(t)
+	defer cleanup()
+
+	storeNode := []byte{1}
+	topic1 := types.BytesToTopic([]byte{1, 1, 1, 1})
+	topic2 := types.BytesToTopic([]byte{2, 2, 2, 2})
+
+	require.NoError(t, s.AddSyncedRange(storeNode, []types.TopicType{topic1}, SyncedRange{From: 10, To: 20}))
+	require.NoError(t, s.AddSyncedRange(storeNode, []types.TopicType{topic1}, SyncedRange{From: 30, To: 40}))
+	require.NoError(t, s.AddSyncedRange(storeNode, []types.TopicType{topic1, topic2}, SyncedRange{From: 35, To: 50}))
+	require.NoError(t, s.AddSyncedRange(storeNode, []types.TopicType{topic2}, SyncedRange{From: 5, To: 15}))
+
+	ranges, err := s.SyncedRanges(storeNode, topic1)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 10, To: 20}, {From: 30, To: 50}}, ranges)
+
+	ranges, err = s.CommonSyncedRanges(storeNode, []types.TopicType{topic1, topic2})
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 10, To: 15}, {From: 35, To: 50}}, ranges)
+
+	ranges, err = s.UnsyncedRanges(storeNode, []types.TopicType{topic1}, 0, 60, 0)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 50, To: 60}, {From: 20, To: 30}, {From: 0, To: 10}}, ranges)
+
+	ranges, err = s.UnsyncedRanges(storeNode, []types.TopicType{topic1, topic2}, 12, 45, 0)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 15, To: 35}}, ranges)
+
+	ranges, err = s.UnsyncedRanges(storeNode, []types.TopicType{topic1}, 32, 48, 0)
+	require.NoError(t, err)
+	require.Empty(t, ranges)
+
+	// Touching ranges are merged
+	require.NoError(t, s.AddSyncedRange(storeNode, []types.TopicType{topic1}, SyncedRange{From: 20, To: 30}))
+	ranges, err = s.SyncedRanges(storeNode, topic1)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 10, To: 50}}, ranges)
+}
+
+func TestUnsyncedRangesTolerance(t *testing.T) {
+	s, cleanup := newTestStoreSyncState(t)
+	defer cleanup()
+
+	storeNode := []byte{1}
+	topics := []types.TopicType{types.BytesToTopic([]byte{1, 1, 1, 1})}
+
+	require.NoError(t, s.AddSyncedRange(storeNode, topics, SyncedRange{From: 100, To: 200}))
+	require.NoError(t, s.AddSyncedRange(storeNode, topics, SyncedRange{From: 300, To: 305}))
+
+	// The end of each synced range is requested again, and ranges shorter than the
+	// tolerance are ignored
+	ranges, err := s.UnsyncedRanges(storeNode, topics, 0, 400, 10)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 190, To: 400}, {From: 0, To: 100}}, ranges)
+}
+
+func TestSyncedRangesByStoreNode(t *testing.T) {
+	s, cleanup := newTestStoreSyncState(t)
+	defer cleanup()
+
+	topics := []types.TopicType{types.BytesToTopic([]byte{1, 1, 1, 1})}
+
+	require.NoError(t, s.AddSyncedRange([]byte{1}, topics, SyncedRange{From: 10, To: 50}))
+
+	// What was retrieved from a store node still has to be retrieved from the others
+	ranges, err := s.UnsyncedRanges([]byte{2}, topics, 0, 60, 0)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 0, To: 60}}, ranges)
+
+	ranges, err = s.UnsyncedRanges([]byte{1}, topics, 0, 60, 0)
+	require.NoError(t, err)
+	require.Equal(t, []SyncedRange{{From: 50, To: 60}, {From: 0, To: 10}}, ranges)
+}
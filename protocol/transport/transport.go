@@ -72,6 +72,8 @@ type Transport struct {
 	filters     *FiltersManager
 	logger      *zap.Logger
 	cache       *ProcessedMessageIDsCache
+	syncState   *StoreSyncState
+	bandwidth   *BandwidthStats
 
 	mailservers      []string
 	envelopesMonitor *EnvelopesMonitor
@@ -112,6 +114,8 @@ func NewTransport(
 		waku:             waku,
 		api:              api,
 		cache:            NewProcessedMessageIDsCache(db),
+		syncState:        NewStoreSyncState(db),
+		bandwidth:        NewBandwidthStats(db),
 		envelopesMonitor: envelopesMonitor,
 		quit:             make(chan struct{}),
 		keysManager: &transportKeysManager{
@@ -143,6 +147,10 @@ func (t *Transport) InitPublicFilters(chatIDs []string) ([]*Filter, error) {
 	return t.filters.InitPublicFilters(chatIDs)
 }
 
+func (t *Transport) InitPublicFiltersOnPubsubTopic(chatIDs []string, pubsubTopic string) ([]*Filter, error) {
+	return t.filters.InitPublicFiltersOnPubsubTopic(chatIDs, pubsubTopic)
+}
+
 func (t *Transport) Filters() []*Filter {
 	return t.filters.Filters()
 }
@@ -285,6 +293,7 @@ func (t *Transport) SendPublic(ctx context.Context, newMessage *types.NewMessage
 
 	newMessage.SymKeyID = filter.SymKeyID
 	newMessage.Topic = filter.Topic
+	newMessage.PubsubTopic = filter.PubsubTopic
 
 	return t.api.Post(ctx, *newMessage)
 }
@@ -480,10 +489,12 @@ func (t *Transport) createMessagesRequestV2(
 	peerID []byte,
 	from, to uint32,
 	previousStoreCursor *types.StoreRequestCursor,
+	pubsubTopic string,
 	topics []types.TopicType,
 	waitForResponse bool,
 ) (storeCursor *types.StoreRequestCursor, err error) {
 	r := createMessagesRequest(from, to, nil, previousStoreCursor, topics)
+	r.PubsubTopic = pubsubTopic
 
 	if waitForResponse {
 		resultCh := make(chan struct {
@@ -517,18 +528,21 @@ func (t *Transport) createMessagesRequestV2(
 	return
 }
 
+// SendMessagesRequestForTopics requests the messages of topics. On Waku v2 they are
+// requested on pubsubTopic, the default one if empty.
 func (t *Transport) SendMessagesRequestForTopics(
 	ctx context.Context,
 	peerID []byte,
 	from, to uint32,
 	previousCursor []byte,
 	previousStoreCursor *types.StoreRequestCursor,
+	pubsubTopic string,
 	topics []types.TopicType,
 	waitForResponse bool,
 ) (cursor []byte, storeCursor *types.StoreRequestCursor, err error) {
 	switch t.waku.Version() {
 	case 2:
-		storeCursor, err = t.createMessagesRequestV2(ctx, peerID, from, to, previousStoreCursor, topics, waitForResponse)
+		storeCursor, err = t.createMessagesRequestV2(ctx, peerID, from, to, previousStoreCursor, pubsubTopic, topics, waitForResponse)
 	case 1:
 		cursor, err = t.createMessagesRequestV1(ctx, peerID, from, to, previousCursor, topics, waitForResponse)
 	default:
@@ -552,7 +566,7 @@ func (t *Transport) SendMessagesRequest(
 		topics = append(topics, f.Topic)
 	}
 
-	return t.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, topics, waitForResponse)
+	return t.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, "", topics, waitForResponse)
 }
 
 func (t *Transport) SendMessagesRequestForFilter(
@@ -568,7 +582,7 @@ func (t *Transport) SendMessagesRequestForFilter(
 	topics := make([]types.TopicType, len(t.Filters()))
 	topics = append(topics, filter.Topic)
 
-	return t.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, topics, waitForResponse)
+	return t.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, filter.PubsubTopic, topics, waitForResponse)
 }
 
 func createMessagesRequest(from, to uint32, cursor []byte, storeCursor *types.StoreRequestCursor, topics []types.TopicType) types.MessagesRequest {
@@ -633,6 +647,57 @@ func (t *Transport) ClearProcessedMessageIDsCache() error {
 	return t.cache.Clear()
 }
 
+// StoreCursor returns the position of the store query in progress for topics,
+// nil if there's none
+func (t *Transport) StoreCursor(topics []types.TopicType) (*StoreCursor, error) {
+	return t.syncState.Cursor(topics)
+}
+
+// SaveStoreCursor saves the position of the store query in progress for topics
+func (t *Transport) SaveStoreCursor(topics []types.TopicType, cursor *StoreCursor) error {
+	return t.syncState.SaveCursor(topics, cursor)
+}
+
+// DeleteStoreCursor forgets the store query in progress for topics, once complete
+func (t *Transport) DeleteStoreCursor(topics []types.TopicType) error {
+	return t.syncState.DeleteCursor(topics)
+}
+
+// AddSyncedRange marks the messages of topics in syncedRange as all retrieved
+// from the store node peerID
+func (t *Transport) AddSyncedRange(peerID []byte, topics []types.TopicType, syncedRange SyncedRange) error {
+	return t.syncState.AddSyncedRange(peerID, topics, syncedRange)
+}
+
+// SyncedRanges returns the ranges whose messages have been all retrieved from
+// the store node peerID for all topics, oldest first
+func (t *Transport) SyncedRanges(peerID []byte, topics []types.TopicType) ([]SyncedRange, error) {
+	return t.syncState.CommonSyncedRanges(peerID, topics)
+}
+
+// UnsyncedRanges returns the parts of the range between from and to that
+// remain to be retrieved from the store node peerID for topics, newest first,
+// overlapping the ranges already retrieved by tolerance seconds
+func (t *Transport) UnsyncedRanges(peerID []byte, topics []types.TopicType, from, to uint32, tolerance uint32) ([]SyncedRange, error) {
+	return t.syncState.UnsyncedRanges(peerID, topics, from, to, tolerance)
+}
+
+// RecordBandwidth adds the traffic since the previous call to the counters of
+// the current day
+func (t *Transport) RecordBandwidth() error {
+	return t.bandwidth.Add(time.Now(), t.waku.TakeTopicBandwidth())
+}
+
+// Bandwidth returns the traffic by day, topic and protocol between from and to
+func (t *Transport) Bandwidth(from, to time.Time) ([]DailyTopicBandwidth, error) {
+	return t.bandwidth.Bandwidth(from, to)
+}
+
+// DeleteBandwidthBefore forgets the traffic of the days before t
+func (t *Transport) DeleteBandwidthBefore(before time.Time) error {
+	return t.bandwidth.DeleteBefore(before)
+}
+
 func (t *Transport) BloomFilter() []byte {
 	return t.api.BloomFilter()
 }
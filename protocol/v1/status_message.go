@@ -57,6 +57,13 @@ type StatusMessage struct {
 
 	// HashRatchetInfo is the information about a new hash ratchet group/key pair
 	HashRatchetInfo []*encryption.HashRatchetInfo
+
+	// SessionOutOfSync is set when the encryption layer can't decrypt messages
+	// from the sender anymore, and the sessions with them should be reset
+	SessionOutOfSync bool
+
+	// SenderKey is set when the message was encrypted with the sender key of a group chat member
+	SenderKey bool
 }
 
 // Temporary JSON marshaling for those messages that are not yet processed
@@ -144,6 +151,11 @@ func (m *StatusMessage) HandleEncryption(myKey *ecdsa.PrivateKey, senderKey *ecd
 		return err
 	}
 
+	if err == encryption.ErrSessionOutOfSync {
+		m.SessionOutOfSync = true
+		return err
+	}
+
 	if err != nil {
 		return errors.Wrap(err, "failed to handle Encryption message")
 	}
@@ -152,6 +164,7 @@ func (m *StatusMessage) HandleEncryption(myKey *ecdsa.PrivateKey, senderKey *ecd
 	m.Installations = response.Installations
 	m.SharedSecrets = response.SharedSecrets
 	m.HashRatchetInfo = response.HashRatchetInfo
+	m.SenderKey = response.SenderKey
 	return nil
 }
 
@@ -241,6 +254,16 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData(new(protobuf.PushNotificationQueryResponse))
 	case protobuf.ApplicationMetadataMessage_PUSH_NOTIFICATION_RESPONSE:
 		return m.unmarshalProtobufData(new(protobuf.PushNotificationResponse))
+	case protobuf.ApplicationMetadataMessage_FILE_CHUNK:
+		return m.unmarshalProtobufData(new(protobuf.FileChunk))
+	case protobuf.ApplicationMetadataMessage_READ_RECEIPTS:
+		return m.unmarshalProtobufData(new(protobuf.ReadReceipts))
+	case protobuf.ApplicationMetadataMessage_TYPING_INDICATOR:
+		return m.unmarshalProtobufData(new(protobuf.TypingIndicator))
+	case protobuf.ApplicationMetadataMessage_INSTALLATION_REVOCATION:
+		return m.unmarshalProtobufData(new(protobuf.InstallationRevocation))
+	case protobuf.ApplicationMetadataMessage_SESSION_RESET:
+		return m.unmarshalProtobufData(new(protobuf.SessionReset))
 	case protobuf.ApplicationMetadataMessage_EMOJI_REACTION:
 		return m.unmarshalProtobufData(new(protobuf.EmojiReaction))
 	case protobuf.ApplicationMetadataMessage_GROUP_CHAT_INVITATION:
@@ -318,6 +341,8 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData((new(protobuf.SyncContactRequestDecision)))
 	case protobuf.ApplicationMetadataMessage_SYNC_SAVED_ADDRESS:
 		return m.unmarshalProtobufData(new(protobuf.SyncSavedAddress))
+	case protobuf.ApplicationMetadataMessage_SYNC_CHAT_DRAFT:
+		return m.unmarshalProtobufData(new(protobuf.SyncChatDraft))
 	}
 	return nil
 }
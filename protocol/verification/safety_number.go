@@ -0,0 +1,41 @@
+package verification
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/multiaccounts"
+	"github.com/status-im/status-go/protocol/identity/colorhash"
+	"github.com/status-im/status-go/protocol/identity/emojihash"
+)
+
+// SafetyNumber is derived from the identity keys of two users, and is the same
+// for both of them. Comparing it out of band, in person or through another
+// channel, confirms that nobody is impersonating any of the two.
+type SafetyNumber struct {
+	EmojiHash []string                 `json:"emojiHash"`
+	ColorHash multiaccounts.ColourHash `json:"colorHash"`
+}
+
+// NewSafetyNumber returns the safety number of the given identity keys,
+// regardless of their order
+func NewSafetyNumber(a *ecdsa.PublicKey, b *ecdsa.PublicKey) (*SafetyNumber, error) {
+	first := crypto.CompressPubkey(a)
+	second := crypto.CompressPubkey(b)
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+
+	fingerprint := crypto.Keccak256(first, second)
+
+	emojiHash, err := emojihash.GenerateForBytes(fingerprint[:20])
+	if err != nil {
+		return nil, err
+	}
+
+	return &SafetyNumber{
+		EmojiHash: emojiHash,
+		ColorHash: colorhash.GenerateForBytes(fingerprint[20:30]),
+	}, nil
+}
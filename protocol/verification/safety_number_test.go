@@ -0,0 +1,31 @@
+package verification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+func TestSafetyNumber(t *testing.T) {
+	alice, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bob, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	charlie, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	aliceBob, err := NewSafetyNumber(&alice.PublicKey, &bob.PublicKey)
+	require.NoError(t, err)
+	require.Len(t, aliceBob.EmojiHash, 14)
+	require.NotEmpty(t, aliceBob.ColorHash)
+
+	bobAlice, err := NewSafetyNumber(&bob.PublicKey, &alice.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, aliceBob, bobAlice)
+
+	aliceCharlie, err := NewSafetyNumber(&alice.PublicKey, &charlie.PublicKey)
+	require.NoError(t, err)
+	require.NotEqual(t, aliceBob.EmojiHash, aliceCharlie.EmojiHash)
+}
@@ -4,19 +4,23 @@ import (
 	"bytes"
 	"database/sql"
 	"image"
+	"mime"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/ipfs"
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/protocol/identity/colorhash"
 	"github.com/status-im/status-go/protocol/identity/identicon"
 	"github.com/status-im/status-go/protocol/identity/ring"
 	"github.com/status-im/status-go/protocol/images"
+	"github.com/status-im/status-go/protocol/protobuf"
 )
 
 const (
@@ -24,9 +28,13 @@ const (
 	identiconsPath         = basePath + "/identicons"
 	imagesPath             = basePath + "/images"
 	audioPath              = basePath + "/audio"
+	filesPath              = basePath + "/files"
+	videosPath             = basePath + "/videos"
+	videoThumbnailsPath    = basePath + "/videos/thumbnails"
 	ipfsPath               = "/ipfs"
 	discordAuthorsPath     = "/discord/authors"
 	discordAttachmentsPath = basePath + "/discord/attachments"
+	communityEmojisPath    = "/communities/emojis"
 
 	// Handler routes for pairing
 	accountImagesPath = "/accountImages"
@@ -359,6 +367,146 @@ func handleAudio(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
+func handleFile(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		messageIDs, ok := r.URL.Query()["messageId"]
+		if !ok || len(messageIDs) == 0 {
+			logger.Error("no messageID")
+			return
+		}
+		messageID := messageIDs[0]
+
+		var name, mimeType string
+		var payload []byte
+		err := db.QueryRow(`SELECT m.file_name, m.file_mime_type, f.payload FROM user_messages m JOIN file_attachments f ON m.file_hash = f.hash WHERE m.id = ?`, messageID).Scan(&name, &mimeType, &payload)
+		if err != nil {
+			logger.Error("failed to find file", zap.Error(err))
+			http.NotFound(w, r)
+			return
+		}
+
+		if len(mimeType) == 0 {
+			mimeType = "application/octet-stream"
+		}
+
+		w.Header().Set("Content-Type", mimeType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+		w.Header().Set("Cache-Control", "no-store")
+
+		// ServeContent handles range requests so that large files can be streamed
+		http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(payload))
+	}
+}
+
+func handleVideo(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		messageIDs, ok := r.URL.Query()["messageId"]
+		if !ok || len(messageIDs) == 0 {
+			logger.Error("no messageID")
+			return
+		}
+		messageID := messageIDs[0]
+
+		var name, mimeType string
+		var payload []byte
+		err := db.QueryRow(`SELECT m.file_name, m.file_mime_type, f.payload FROM user_messages m JOIN file_attachments f ON m.file_hash = f.hash WHERE m.id = ? AND m.content_type = ?`, messageID, protobuf.ChatMessage_VIDEO).Scan(&name, &mimeType, &payload)
+		if err != nil {
+			logger.Error("failed to find video", zap.Error(err))
+			http.NotFound(w, r)
+			return
+		}
+
+		// The mime type is the one announced by the sender, never serve anything else than a video
+		if !strings.HasPrefix(mimeType, "video/") {
+			logger.Error("not a video", zap.String("mimeType", mimeType))
+			http.Error(w, "not a video", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		w.Header().Set("Content-Type", mimeType)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "no-store")
+
+		// ServeContent handles range requests so that players can seek
+		http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(payload))
+	}
+}
+
+func handleVideoThumbnail(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		messageIDs, ok := r.URL.Query()["messageId"]
+		if !ok || len(messageIDs) == 0 {
+			logger.Error("no messageID")
+			return
+		}
+		messageID := messageIDs[0]
+		var thumbnail []byte
+		err := db.QueryRow(`SELECT video_thumbnail FROM user_messages WHERE id = ?`, messageID).Scan(&thumbnail)
+		if err != nil {
+			logger.Error("failed to find video thumbnail", zap.Error(err))
+			return
+		}
+		if len(thumbnail) == 0 {
+			logger.Error("empty video thumbnail")
+			return
+		}
+		mimeType, err := images.ImageMime(thumbnail)
+		if err != nil {
+			logger.Error("failed to get mime", zap.Error(err))
+		}
+
+		w.Header().Set("Content-Type", mimeType)
+		w.Header().Set("Cache-Control", "no-store")
+
+		_, err = w.Write(thumbnail)
+		if err != nil {
+			logger.Error("failed to write video thumbnail", zap.Error(err))
+		}
+	}
+}
+
+func handleCommunityCustomEmoji(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		communityIDs, ok := r.URL.Query()["communityId"]
+		if !ok || len(communityIDs) == 0 {
+			logger.Error("no communityID")
+			return
+		}
+		emojiIDs, ok := r.URL.Query()["emojiId"]
+		if !ok || len(emojiIDs) == 0 {
+			logger.Error("no emojiID")
+			return
+		}
+		communityID, err := types.DecodeHex(communityIDs[0])
+		if err != nil {
+			logger.Error("invalid communityID", zap.Error(err))
+			return
+		}
+		var image []byte
+		err = db.QueryRow(`SELECT payload FROM communities_custom_emojis WHERE community_id = ? AND id = ?`, communityID, emojiIDs[0]).Scan(&image)
+		if err != nil {
+			logger.Error("failed to find custom emoji", zap.Error(err))
+			return
+		}
+		if len(image) == 0 {
+			logger.Error("empty custom emoji")
+			return
+		}
+		mimeType, err := images.ImageMime(image)
+		if err != nil {
+			logger.Error("failed to get mime", zap.Error(err))
+		}
+
+		w.Header().Set("Content-Type", mimeType)
+		w.Header().Set("Cache-Control", "no-store")
+
+		_, err = w.Write(image)
+		if err != nil {
+			logger.Error("failed to write custom emoji", zap.Error(err))
+		}
+	}
+}
+
 func handleIPFS(downloader *ipfs.Downloader, logger *zap.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		hashes, ok := r.URL.Query()["hash"]
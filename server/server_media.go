@@ -39,12 +39,16 @@ func NewMediaServer(db *sql.DB, downloader *ipfs.Downloader, multiaccountsDB *mu
 	s.SetHandlers(HandlerPatternMap{
 		imagesPath:             handleImage(s.db, s.logger),
 		audioPath:              handleAudio(s.db, s.logger),
+		filesPath:              handleFile(s.db, s.logger),
+		videosPath:             handleVideo(s.db, s.logger),
+		videoThumbnailsPath:    handleVideoThumbnail(s.db, s.logger),
 		identiconsPath:         handleIdenticon(s.logger),
 		ipfsPath:               handleIPFS(s.downloader, s.logger),
 		accountImagesPath:      handleAccountImages(s.multiaccountsDB, s.logger),
 		contactImagesPath:      handleContactImages(s.db, s.logger),
 		discordAuthorsPath:     handleDiscordAuthorAvatar(s.db, s.logger),
 		discordAttachmentsPath: handleDiscordAttachment(s.db, s.logger),
+		communityEmojisPath:    handleCommunityCustomEmoji(s.db, s.logger),
 	})
 
 	return s, nil
@@ -96,6 +100,38 @@ func (s *MediaServer) MakeAudioURL(id string) string {
 	return u.String()
 }
 
+func (s *MediaServer) MakeFileURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = filesPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
+func (s *MediaServer) MakeVideoURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = videosPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
+func (s *MediaServer) MakeVideoThumbnailURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = videoThumbnailsPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
+func (s *MediaServer) MakeCommunityCustomEmojiURL(communityID string, emojiID string) string {
+	u := s.MakeBaseURL()
+	u.Path = communityEmojisPath
+	u.RawQuery = url.Values{"communityId": {communityID}, "emojiId": {emojiID}}.Encode()
+
+	return u.String()
+}
+
 func (s *MediaServer) MakeStickerURL(stickerHash string) string {
 	u := s.MakeBaseURL()
 	u.Path = ipfsPath
@@ -94,6 +94,33 @@ func (s *ServerURLSuite) TestServer_MakeAudioURL() {
 		s.serverNoPort.MakeAudioURL("0xde1e7ebee71e"))
 }
 
+func (s *ServerURLSuite) TestServer_MakeVideoURL() {
+	s.Require().Equal(
+		"https://127.0.0.1:1337/messages/videos?messageId=0xde1e7ebee71e",
+		s.server.MakeVideoURL("0xde1e7ebee71e"))
+	s.testNoPort(
+		"https://127.0.0.1:80/messages/videos?messageId=0xde1e7ebee71e",
+		s.serverNoPort.MakeVideoURL("0xde1e7ebee71e"))
+}
+
+func (s *ServerURLSuite) TestServer_MakeVideoThumbnailURL() {
+	s.Require().Equal(
+		"https://127.0.0.1:1337/messages/videos/thumbnails?messageId=0xde1e7ebee71e",
+		s.server.MakeVideoThumbnailURL("0xde1e7ebee71e"))
+	s.testNoPort(
+		"https://127.0.0.1:80/messages/videos/thumbnails?messageId=0xde1e7ebee71e",
+		s.serverNoPort.MakeVideoThumbnailURL("0xde1e7ebee71e"))
+}
+
+func (s *ServerURLSuite) TestServer_MakeCommunityCustomEmojiURL() {
+	s.Require().Equal(
+		"https://127.0.0.1:1337/communities/emojis?communityId=0xde1e7ebee71e&emojiId=emoji-id",
+		s.server.MakeCommunityCustomEmojiURL("0xde1e7ebee71e", "emoji-id"))
+	s.testNoPort(
+		"https://127.0.0.1:80/communities/emojis?communityId=0xde1e7ebee71e&emojiId=emoji-id",
+		s.serverNoPort.MakeCommunityCustomEmojiURL("0xde1e7ebee71e", "emoji-id"))
+}
+
 func (s *ServerURLSuite) TestServer_MakeStickerURL() {
 	s.Require().Equal(
 		"https://127.0.0.1:1337/ipfs?hash=0xdeadbeef4ac0",
@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/signal"
 
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/multiaccounts"
 )
@@ -14,14 +16,15 @@ var (
 	ErrUpdatingWrongAccount = errors.New("failed to update wrong account. Please login with that account first")
 )
 
-func NewMultiAccountsAPI(db *multiaccounts.Database, mediaServer *server.MediaServer) *MultiAccountsAPI {
-	return &MultiAccountsAPI{db: db, mediaServer: mediaServer}
+func NewMultiAccountsAPI(db *multiaccounts.Database, mediaServer *server.MediaServer, dataPolicy *connection.DataPolicy) *MultiAccountsAPI {
+	return &MultiAccountsAPI{db: db, mediaServer: mediaServer, dataPolicy: dataPolicy}
 }
 
 // MultiAccountsAPI is class with methods available over RPC.
 type MultiAccountsAPI struct {
 	db          *multiaccounts.Database
 	mediaServer *server.MediaServer
+	dataPolicy  *connection.DataPolicy
 }
 
 func (api *MultiAccountsAPI) UpdateAccount(account multiaccounts.Account) error {
@@ -60,7 +63,21 @@ func (api *MultiAccountsAPI) StoreIdentityImage(keyUID, filepath string, aX, aY,
 	return iis, err
 }
 
+// StoreIdentityImageFromURL downloads the image at url and stores it like StoreIdentityImage.
+// On a metered connection the download may be deferred, the image is then stored once it's
+// allowed and a datapolicy.fetched signal is sent.
 func (api *MultiAccountsAPI) StoreIdentityImageFromURL(keyUID, url string) ([]images.IdentityImage, error) {
+	if api.dataPolicy != nil && api.dataPolicy.DeferOnce(connection.DataMedia, url, func() {
+		_, err := api.storeIdentityImageFromURL(keyUID, url)
+		signal.SendDataPolicyFetched(string(connection.DataMedia), url, err)
+	}) {
+		return nil, connection.ErrDataDeferred
+	}
+
+	return api.storeIdentityImageFromURL(keyUID, url)
+}
+
+func (api *MultiAccountsAPI) storeIdentityImageFromURL(keyUID, url string) ([]images.IdentityImage, error) {
 	iis, err := images.GenerateIdentityImagesFromURL(url)
 	if err != nil {
 		return nil, err
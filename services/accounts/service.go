@@ -8,6 +8,7 @@ import (
 	"github.com/status-im/status-go/server"
 
 	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/params"
@@ -15,8 +16,8 @@ import (
 )
 
 // NewService initializes service instance.
-func NewService(db *accounts.Database, mdb *multiaccounts.Database, manager *account.GethManager, config *params.NodeConfig, feed *event.Feed, mediaServer *server.MediaServer) *Service {
-	return &Service{db, mdb, manager, config, feed, nil, mediaServer}
+func NewService(db *accounts.Database, mdb *multiaccounts.Database, manager *account.GethManager, config *params.NodeConfig, feed *event.Feed, mediaServer *server.MediaServer, dataPolicy *connection.DataPolicy) *Service {
+	return &Service{db, mdb, manager, config, feed, nil, mediaServer, dataPolicy}
 }
 
 // Service is a browsers service.
@@ -28,6 +29,7 @@ type Service struct {
 	feed        *event.Feed
 	messenger   *protocol.Messenger
 	mediaServer *server.MediaServer
+	dataPolicy  *connection.DataPolicy
 }
 
 func (s *Service) Init(messenger *protocol.Messenger) {
@@ -60,7 +62,7 @@ func (s *Service) APIs() []rpc.API {
 		{
 			Namespace: "multiaccounts",
 			Version:   "0.1.0",
-			Service:   NewMultiAccountsAPI(s.mdb, s.mediaServer),
+			Service:   NewMultiAccountsAPI(s.mdb, s.mediaServer, s.dataPolicy),
 		},
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/mailserver"
@@ -348,6 +349,11 @@ func (api *PublicAPI) DisableInstallation(installationID string) error {
 	return api.service.messenger.DisableInstallation(installationID)
 }
 
+// RevokeInstallation disables an installation for good, and tells our other installations and contacts
+func (api *PublicAPI) RevokeInstallation(ctx context.Context, installationID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RevokeInstallation(ctx, installationID)
+}
+
 // GetOurInstallations returns all the installations available given an identity
 func (api *PublicAPI) GetOurInstallations() []*multidevice.Installation {
 	return api.service.messenger.Installations()
@@ -554,6 +560,23 @@ func (api *PublicAPI) DeleteCommunityCategory(request *requests.DeleteCommunityC
 	return api.service.messenger.DeleteCommunityCategory(request)
 }
 
+func (api *PublicAPI) AddCommunityCustomEmoji(request *requests.AddCommunityCustomEmoji) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AddCommunityCustomEmoji(request)
+}
+
+func (api *PublicAPI) RemoveCommunityCustomEmoji(request *requests.RemoveCommunityCustomEmoji) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RemoveCommunityCustomEmoji(request)
+}
+
+// SetCommunityShard moves the chats of a community we own to another Waku v2 shard
+func (api *PublicAPI) SetCommunityShard(request *requests.SetCommunityShard) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityShard(request)
+}
+
+func (api *PublicAPI) CommunityCustomEmojis(communityID types.HexBytes) ([]*communities.CustomEmoji, error) {
+	return api.service.messenger.CommunityCustomEmojis(communityID)
+}
+
 type ApplicationMessagesResponse struct {
 	Messages []*common.Message `json:"messages"`
 	Cursor   string            `json:"cursor"`
@@ -661,6 +684,22 @@ func (api *PublicAPI) SwitcherCards() (*ApplicationSwitcherCardsResponse, error)
 	}, nil
 }
 
+func (api *PublicAPI) SaveChatDraft(ctx context.Context, request *requests.SaveChatDraft) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SaveChatDraft(ctx, request)
+}
+
+func (api *PublicAPI) DeleteChatDraft(ctx context.Context, chatID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteChatDraft(ctx, chatID)
+}
+
+func (api *PublicAPI) ChatDraft(chatID string) (*protocol.ChatDraft, error) {
+	return api.service.messenger.ChatDraft(chatID)
+}
+
+func (api *PublicAPI) ChatDrafts() ([]*protocol.ChatDraft, error) {
+	return api.service.messenger.ChatDrafts()
+}
+
 func (api *PublicAPI) StartMessenger() (*protocol.MessengerResponse, error) {
 	return api.service.StartMessenger()
 }
@@ -835,6 +874,10 @@ func (api *PublicAPI) GetLatestVerificationRequestFrom(ctx context.Context, cont
 	return api.service.messenger.GetLatestVerificationRequestFrom(contactID)
 }
 
+func (api *PublicAPI) GetSafetyNumber(ctx context.Context, contactID string) (*verification.SafetyNumber, error) {
+	return api.service.messenger.GetSafetyNumber(contactID)
+}
+
 func (api *PublicAPI) SendContactVerificationRequest(ctx context.Context, contactID string, challenge string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SendContactVerificationRequest(ctx, contactID, challenge)
 }
@@ -1044,6 +1087,14 @@ func (api *PublicAPI) SendEmojiReaction(ctx context.Context, chatID, messageID s
 	return api.service.messenger.SendEmojiReaction(ctx, chatID, messageID, emojiID)
 }
 
+func (api *PublicAPI) SendUnicodeEmojiReaction(ctx context.Context, chatID, messageID, emoji string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendUnicodeEmojiReaction(ctx, chatID, messageID, emoji)
+}
+
+func (api *PublicAPI) SendCustomEmojiReaction(ctx context.Context, chatID, messageID, customEmojiID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendCustomEmojiReaction(ctx, chatID, messageID, customEmojiID)
+}
+
 func (api *PublicAPI) SendEmojiReactionRetraction(ctx context.Context, emojiReactionID string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SendEmojiReactionRetraction(ctx, emojiReactionID)
 }
@@ -1052,6 +1103,16 @@ func (api *PublicAPI) EmojiReactionsByChatID(chatID string, cursor string, limit
 	return api.service.messenger.EmojiReactionsByChatID(chatID, cursor, limit)
 }
 
+// SendTypingIndicator lets the members of a one-to-one or group chat know that we started or stopped typing
+func (api *PublicAPI) SendTypingIndicator(ctx context.Context, chatID string, typing bool) error {
+	return api.service.messenger.SendTypingIndicator(ctx, chatID, typing)
+}
+
+// ReadReceipts returns who read a message we sent, only available if the readers opted in
+func (api *PublicAPI) ReadReceipts(messageID string) ([]*protocol.ReadReceipt, error) {
+	return api.service.messenger.ReadReceipts(messageID)
+}
+
 func (api *PublicAPI) EmojiReactionsByChatIDMessageID(chatID string, messageID string) ([]*protocol.EmojiReaction, error) {
 	return api.service.messenger.EmojiReactionsByChatIDMessageID(chatID, messageID)
 }
@@ -1062,8 +1123,10 @@ func (api *PublicAPI) GetLinkPreviewWhitelist() []urls.Site {
 	return urls.LinkPreviewWhitelist()
 }
 
+// GetLinkPreviewData unfurls link. On a metered connection the preview may be deferred,
+// a datapolicy.fetched signal is then sent once it can be requested again.
 func (api *PublicAPI) GetLinkPreviewData(link string) (previewData urls.LinkPreviewData, err error) {
-	return urls.GetLinkPreviewData(link)
+	return api.service.linkPreviews.get(api.service.dataPolicy, link)
 }
 
 func (api *PublicAPI) EnsVerified(pk, ensName string) error {
@@ -1153,6 +1216,28 @@ func (api *PublicAPI) SyncChatFromSyncedFrom(chatID string) (uint32, error) {
 	return api.service.messenger.SyncChatFromSyncedFrom(chatID)
 }
 
+// SyncedRanges returns the periods of a chat whose messages have all been retrieved from the active store node
+func (api *PublicAPI) SyncedRanges(chatID string) ([]transport.SyncedRange, error) {
+	return api.service.messenger.SyncedRanges(chatID)
+}
+
+// DataPolicy returns on which connections each category of non-essential traffic is allowed
+func (api *PublicAPI) DataPolicy() map[connection.DataCategory]connection.DataMode {
+	return api.service.messenger.DataPolicy()
+}
+
+// SetDataPolicy sets on which connections the given categories of non-essential traffic are allowed,
+// "always" or "unmetered" ones only
+func (api *PublicAPI) SetDataPolicy(modes map[connection.DataCategory]connection.DataMode) error {
+	return api.service.messenger.SetDataPolicy(modes)
+}
+
+// BandwidthStats returns the traffic between from and to, in seconds, by day
+// and topic, and in total by chat and community
+func (api *PublicAPI) BandwidthStats(from, to int64) (*protocol.BandwidthStats, error) {
+	return api.service.messenger.BandwidthStats(time.Unix(from, 0), time.Unix(to, 0))
+}
+
 // BloomFilter returns the current bloom filter bytes
 func (api *PublicAPI) BloomFilter() string {
 	return hexutil.Encode(api.service.messenger.BloomFilter())
@@ -0,0 +1,56 @@
+package ext
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/connection"
+	"github.com/status-im/status-go/protocol/urls"
+	"github.com/status-im/status-go/signal"
+)
+
+// linkPreviews unfurls links according to the data policy. The previews fetched
+// after being deferred are held until they're requested again.
+type linkPreviews struct {
+	mu      sync.Mutex
+	fetched map[string]urls.LinkPreviewData
+	fetch   func(link string) (urls.LinkPreviewData, error)
+}
+
+func newLinkPreviews() *linkPreviews {
+	return &linkPreviews{
+		fetched: make(map[string]urls.LinkPreviewData),
+		fetch:   urls.GetLinkPreviewData,
+	}
+}
+
+// get returns the preview of link, or connection.ErrDataDeferred if it will
+// be fetched once allowed
+func (l *linkPreviews) get(dataPolicy *connection.DataPolicy, link string) (urls.LinkPreviewData, error) {
+	l.mu.Lock()
+	previewData, ok := l.fetched[link]
+	delete(l.fetched, link)
+	l.mu.Unlock()
+	if ok {
+		return previewData, nil
+	}
+
+	if dataPolicy != nil && dataPolicy.DeferOnce(connection.DataLinkPreviews, link, func() { l.fetchDeferred(link) }) {
+		return previewData, connection.ErrDataDeferred
+	}
+
+	return l.fetch(link)
+}
+
+func (l *linkPreviews) fetchDeferred(link string) {
+	previewData, err := l.fetch(link)
+	if err != nil {
+		log.Error("failed to fetch deferred link preview", "link", link, "err", err)
+	} else {
+		l.mu.Lock()
+		l.fetched[link] = previewData
+		l.mu.Unlock()
+	}
+	signal.SendDataPolicyFetched(string(connection.DataLinkPreviews), link, err)
+}
@@ -0,0 +1,51 @@
+package ext
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/connection"
+	"github.com/status-im/status-go/protocol/urls"
+)
+
+func TestLinkPreviewsDeferred(t *testing.T) {
+	var fetches int32
+	previews := newLinkPreviews()
+	previews.fetch = func(link string) (urls.LinkPreviewData, error) {
+		atomic.AddInt32(&fetches, 1)
+		return urls.LinkPreviewData{Site: "GitHub", Title: link}, nil
+	}
+
+	policy := connection.NewDataPolicy()
+	policy.SetModes(map[connection.DataCategory]connection.DataMode{connection.DataLinkPreviews: connection.DataUnmetered})
+	policy.ConnectionChanged(connection.State{Type: connection.NewType(connection.Cellular)})
+
+	link := "https://github.com/status-im/status-go"
+	_, err := previews.get(policy, link)
+	require.ErrorIs(t, err, connection.ErrDataDeferred)
+	_, err = previews.get(policy, link)
+	require.ErrorIs(t, err, connection.ErrDataDeferred)
+	require.Zero(t, atomic.LoadInt32(&fetches))
+
+	policy.ConnectionChanged(connection.State{Type: connection.NewType(connection.Wifi)})
+	require.Eventually(t, func() bool {
+		previews.mu.Lock()
+		defer previews.mu.Unlock()
+		_, ok := previews.fetched[link]
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// The deferred preview is handed over without fetching it again
+	previewData, err := previews.get(nil, link)
+	require.NoError(t, err)
+	require.Equal(t, link, previewData.Title)
+	require.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// Only once, later requests fetch the current preview
+	_, err = previews.get(policy, link)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
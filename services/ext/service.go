@@ -68,6 +68,8 @@ type Service struct {
 	accountsDB      *accounts.Database
 	multiAccountsDB *multiaccounts.Database
 	account         *multiaccounts.Account
+	dataPolicy      *connection.DataPolicy
+	linkPreviews    *linkPreviews
 }
 
 // Make sure that Service implements node.Service interface.
@@ -84,12 +86,13 @@ func New(
 	cache := mailservers.NewCache(ldb)
 	peerStore := mailservers.NewPeerStore(cache)
 	return &Service{
-		storage:     db.NewLevelDBStorage(ldb),
-		n:           n,
-		rpcClient:   rpcClient,
-		config:      config,
-		mailMonitor: mailMonitor,
-		peerStore:   peerStore,
+		storage:      db.NewLevelDBStorage(ldb),
+		n:            n,
+		rpcClient:    rpcClient,
+		config:       config,
+		mailMonitor:  mailMonitor,
+		peerStore:    peerStore,
+		linkPreviews: newLinkPreviews(),
 	}
 }
 
@@ -150,6 +153,9 @@ func (s *Service) InitProtocol(nodeName string, identity *ecdsa.PrivateKey, db *
 	if err != nil {
 		return err
 	}
+	if s.dataPolicy != nil {
+		options = append(options, protocol.WithDataPolicy(s.dataPolicy))
+	}
 
 	messenger, err := protocol.NewMessenger(
 		nodeName,
@@ -358,6 +364,11 @@ func (s *Service) SetP2PServer(server *p2p.Server) {
 	s.server = server
 }
 
+// SetDataPolicy shares the data policy of the node with the messenger
+func (s *Service) SetDataPolicy(dataPolicy *connection.DataPolicy) {
+	s.dataPolicy = dataPolicy
+}
+
 // Start is run when a service is started.
 // It does nothing in this case but is required by `node.Service` interface.
 func (s *Service) Start() error {
@@ -423,6 +434,26 @@ func buildMessengerOptions(
 		options = append(options, protocol.WithDatasync())
 	}
 
+	if config.ShhextConfig.SenderKeysEnabled {
+		options = append(options, protocol.WithSenderKeys())
+	}
+
+	if config.ShhextConfig.CommunityShardingEnabled {
+		options = append(options, protocol.WithCommunitySharding())
+	}
+
+	if len(config.ShhextConfig.MessagePaddingBuckets) != 0 {
+		options = append(options, protocol.WithMessagePadding(config.ShhextConfig.MessagePaddingBuckets))
+	}
+
+	if config.ShhextConfig.OutboxDeadlineSeconds != 0 {
+		options = append(options, protocol.WithOutboxDeadline(time.Duration(config.ShhextConfig.OutboxDeadlineSeconds)*time.Second))
+	}
+
+	if config.ShhextConfig.MaxFileAttachmentSize != 0 {
+		options = append(options, protocol.WithMaxFileAttachmentSize(config.ShhextConfig.MaxFileAttachmentSize))
+	}
+
 	settings, err := accountsDB.GetSettings()
 	if err != sql.ErrNoRows && err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package ext
 
 import (
+	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/communities"
@@ -156,3 +157,15 @@ func (m *MessengerSignalsHandler) SendWakuBackedUpProfile(response *wakusync.Wak
 func (m *MessengerSignalsHandler) SendWakuBackedUpSettings(response *wakusync.WakuBackedUpDataResponse) {
 	signal.SendWakuBackedUpSettings(response)
 }
+
+func (m *MessengerSignalsHandler) FileTransferProgress(progress *protocol.FileTransferProgress) {
+	signal.SendFileTransferProgress(progress)
+}
+
+func (m *MessengerSignalsHandler) TypingIndicator(indicator *protocol.TypingIndicator) {
+	signal.SendTypingIndicator(indicator)
+}
+
+func (m *MessengerSignalsHandler) DataPolicyResumed(categories []connection.DataCategory) {
+	signal.SendDataPolicyResumed(categories)
+}
@@ -70,3 +70,9 @@ func (a *API) GetChatRequestRanges(ctx context.Context) ([]ChatRequestRange, err
 func (a *API) DeleteChatRequestRange(ctx context.Context, chatID string) error {
 	return a.db.DeleteChatRequestRange(chatID)
 }
+
+// GetMailserverScores returns the scores of the mailservers of a fleet,
+// that mailservers are selected by
+func (a *API) GetMailserverScores(ctx context.Context, fleet string) ([]MailserverScore, error) {
+	return a.db.MailserverScores(fleet)
+}
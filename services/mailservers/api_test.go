@@ -214,3 +214,53 @@ func TestAddGetDeleteChatRequestRanges(t *testing.T) {
 	err = api.DeleteChatRequestRange(context.Background(), "non-existing-chat-id")
 	require.NoError(t, err)
 }
+
+func TestMailserverScores(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+	api := &API{db: db}
+
+	require.NoError(t, db.RecordPing("fast", "prod", 20, false))
+	require.NoError(t, db.RecordPing("fast", "prod", 40, false))
+	require.NoError(t, db.RecordRequest("fast", "prod", false, false))
+
+	require.NoError(t, db.RecordPing("slow", "prod", 400, false))
+	require.NoError(t, db.RecordPing("slow", "prod", 0, true))
+	require.NoError(t, db.RecordRequest("slow", "prod", true, false))
+	require.NoError(t, db.RecordRequest("slow", "prod", false, true))
+
+	require.NoError(t, db.RecordPing("other", "staging", 20, false))
+
+	scores, err := api.GetMailserverScores(context.Background(), "prod")
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+
+	fast := scores[0]
+	require.Equal(t, "fast", fast.ID)
+	// Moving average of the latency
+	require.Equal(t, 26, fast.RTTMs)
+	require.Equal(t, uint(2), fast.Pings)
+	require.Equal(t, uint(1), fast.Requests)
+
+	slow := scores[1]
+	require.Equal(t, "slow", slow.ID)
+	require.Equal(t, 400, slow.RTTMs)
+	require.Equal(t, uint(1), slow.FailedPings)
+	require.Equal(t, uint(2), slow.Requests)
+	require.Equal(t, uint(1), slow.FailedRequests)
+	require.Equal(t, uint(1), slow.IncompleteRequests)
+
+	require.Greater(t, fast.Score, slow.Score)
+	// Mailservers without statistics score in between
+	unknown := (&MailserverScore{}).Compute()
+	require.Greater(t, fast.Score, unknown)
+	require.Greater(t, unknown, slow.Score)
+
+	// Counters are halved once the window is full
+	for i := 0; i < statsWindow; i++ {
+		require.NoError(t, db.RecordRequest("fast", "prod", false, false))
+	}
+	scores, err = api.GetMailserverScores(context.Background(), "prod")
+	require.NoError(t, err)
+	require.Less(t, scores[0].Requests, uint(statsWindow))
+}
@@ -0,0 +1,166 @@
+package mailservers
+
+import (
+	"database/sql"
+)
+
+// Mailservers are scored from the statistics collected when pinging and querying them,
+// so that history is fetched from fast and reliable ones, including after a restart.
+
+const (
+	scoreLatencyWeight      = 0.3
+	scoreSuccessWeight      = 0.3
+	scoreCompletenessWeight = 0.2
+	scoreUptimeWeight       = 0.2
+
+	// scoreReferenceRTTMs is the latency, in milliseconds, that halves the latency score
+	scoreReferenceRTTMs = 200
+	// rttSmoothingFactor is the weight of the latest ping in the moving average of the RTT
+	rttSmoothingFactor = 0.3
+	// statsWindow is the number of pings or requests after which the counters are halved,
+	// so that recent behavior weights more than old one
+	statsWindow = 100
+)
+
+// MailserverScore holds the statistics a mailserver is scored by
+type MailserverScore struct {
+	ID    string `json:"id"`
+	Fleet string `json:"fleet"`
+	// RTTMs is the moving average of the latency of the successful pings
+	RTTMs       int  `json:"rttMs"`
+	Pings       uint `json:"pings"`
+	FailedPings uint `json:"failedPings"`
+	Requests    uint `json:"requests"`
+	// FailedRequests are the requests that returned nothing
+	FailedRequests uint `json:"failedRequests"`
+	// IncompleteRequests are the requests that failed before the whole history was returned
+	IncompleteRequests uint `json:"incompleteRequests"`
+	// Score is between 0 and 1, higher is better
+	Score float64 `json:"score"`
+}
+
+// ratio returns the share of successes with add-one smoothing, so that mailservers
+// without statistics are neither favored nor avoided
+func ratio(successes, total uint) float64 {
+	return (float64(successes) + 1) / (float64(total) + 2)
+}
+
+// Compute returns the score of the mailserver from its statistics
+func (s *MailserverScore) Compute() float64 {
+	latency := 0.5
+	if s.Pings > s.FailedPings {
+		latency = scoreReferenceRTTMs / (scoreReferenceRTTMs + float64(s.RTTMs))
+	}
+
+	succeeded := s.Requests - s.FailedRequests
+	success := ratio(succeeded, s.Requests)
+	completeness := ratio(succeeded-s.IncompleteRequests, succeeded)
+	uptime := ratio(s.Pings-s.FailedPings, s.Pings)
+
+	return scoreLatencyWeight*latency +
+		scoreSuccessWeight*success +
+		scoreCompletenessWeight*completeness +
+		scoreUptimeWeight*uptime
+}
+
+func (d *Database) ensureScore(tx *sql.Tx, id, fleet string) error {
+	_, err := tx.Exec(`INSERT OR IGNORE INTO mailserver_scores(id, fleet) VALUES (?, ?)`, id, fleet)
+	return err
+}
+
+func (d *Database) updateScore(id, fleet string, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	err = d.ensureScore(tx, id, fleet)
+	if err != nil {
+		return err
+	}
+
+	return fn(tx)
+}
+
+// RecordPing updates the latency and uptime of a mailserver with a ping result,
+// rttMs is ignored if the ping failed
+func (d *Database) RecordPing(id, fleet string, rttMs int, failed bool) error {
+	return d.updateScore(id, fleet, func(tx *sql.Tx) error {
+		if failed {
+			_, err := tx.Exec(`UPDATE mailserver_scores SET pings = pings + 1, failed_pings = failed_pings + 1 WHERE id = ?`, id)
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err := tx.Exec(`UPDATE mailserver_scores SET
+			rtt_ms = CASE WHEN pings = failed_pings THEN ? ELSE CAST(rtt_ms * ? + ? * ? AS INT) END,
+			pings = pings + 1
+			WHERE id = ?`, rttMs, 1-rttSmoothingFactor, rttMs, rttSmoothingFactor, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.Exec(`UPDATE mailserver_scores SET pings = pings / 2, failed_pings = failed_pings / 2 WHERE id = ? AND pings >= ?`, id, statsWindow)
+		return err
+	})
+}
+
+// RecordRequest updates the success rate and completeness of a mailserver with the
+// outcome of a history request
+func (d *Database) RecordRequest(id, fleet string, failed bool, incomplete bool) error {
+	return d.updateScore(id, fleet, func(tx *sql.Tx) error {
+		var failedRequests, incompleteRequests int
+		if failed {
+			failedRequests = 1
+		} else if incomplete {
+			incompleteRequests = 1
+		}
+
+		_, err := tx.Exec(`UPDATE mailserver_scores SET
+		requests = requests + 1,
+		failed_requests = failed_requests + ?,
+		incomplete_requests = incomplete_requests + ?
+		WHERE id = ?`, failedRequests, incompleteRequests, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`UPDATE mailserver_scores SET
+		requests = requests / 2,
+		failed_requests = failed_requests / 2,
+		incomplete_requests = incomplete_requests / 2
+		WHERE id = ? AND requests >= ?`, id, statsWindow)
+		return err
+	})
+}
+
+// MailserverScores returns the scores of the mailservers of a fleet
+func (d *Database) MailserverScores(fleet string) ([]MailserverScore, error) {
+	rows, err := d.db.Query(`SELECT id, fleet, rtt_ms, pings, failed_pings, requests, failed_requests, incomplete_requests
+	FROM mailserver_scores WHERE fleet = ? ORDER BY id`, fleet)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []MailserverScore
+	for rows.Next() {
+		var s MailserverScore
+		err := rows.Scan(&s.ID, &s.Fleet, &s.RTTMs, &s.Pings, &s.FailedPings, &s.Requests, &s.FailedRequests, &s.IncompleteRequests)
+		if err != nil {
+			return nil, err
+		}
+		s.Score = s.Compute()
+		result = append(result, s)
+	}
+
+	return result, rows.Err()
+}
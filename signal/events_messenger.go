@@ -13,6 +13,20 @@ const (
 
 	// EventStatusUpdatesTimedOut Event Automatic Status Updates Timed out
 	EventStatusUpdatesTimedOut = "status.updates.timedout"
+
+	// EventFileTransferProgress triggered when chunks of a file attachment have been sent or received
+	EventFileTransferProgress = "message.fileTransferProgress"
+
+	// EventTypingIndicator triggered when a chat member starts or stops typing
+	EventTypingIndicator = "message.typing"
+
+	// EventDataPolicyResumed triggered when categories of traffic deferred on a metered
+	// connection are allowed again, requests refused meanwhile can be retried
+	EventDataPolicyResumed = "datapolicy.resumed"
+
+	// EventDataPolicyFetched triggered when a request deferred on a metered connection
+	// has been made, the request can be repeated to get its result
+	EventDataPolicyFetched = "datapolicy.fetched"
 )
 
 // MessageDeliveredSignal specifies chat and message that was delivered
@@ -34,6 +48,13 @@ type CommunityInfoFoundSignal struct {
 	Verified     bool   `json:"verified"`
 }
 
+// DataPolicyFetchedSignal identifies a deferred request that has been made
+type DataPolicyFetchedSignal struct {
+	Category string `json:"category"`
+	Key      string `json:"key"`
+	Error    string `json:"error,omitempty"`
+}
+
 // SendMessageDelivered notifies about delivered message
 func SendMessageDelivered(chatID string, messageID string) {
 	send(EventMesssageDelivered, MessageDeliveredSignal{ChatID: chatID, MessageID: messageID})
@@ -52,3 +73,27 @@ func SendCommunityInfoFound(community interface{}) {
 func SendStatusUpdatesTimedOut(statusUpdates interface{}) {
 	send(EventStatusUpdatesTimedOut, statusUpdates)
 }
+
+// SendFileTransferProgress notifies about the progress of a chunked file attachment transfer
+func SendFileTransferProgress(progress interface{}) {
+	send(EventFileTransferProgress, progress)
+}
+
+// SendTypingIndicator notifies that a chat member started or stopped typing
+func SendTypingIndicator(indicator interface{}) {
+	send(EventTypingIndicator, indicator)
+}
+
+// SendDataPolicyResumed notifies that deferred categories of traffic are allowed again
+func SendDataPolicyResumed(categories interface{}) {
+	send(EventDataPolicyResumed, categories)
+}
+
+// SendDataPolicyFetched notifies that the deferred request of category identified by key has been made
+func SendDataPolicyFetched(category string, key string, err error) {
+	fetched := DataPolicyFetchedSignal{Category: category, Key: key}
+	if err != nil {
+		fetched.Error = err.Error()
+	}
+	send(EventDataPolicyFetched, fetched)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
 
 	"github.com/status-im/status-go/wakuv2/common"
+	"github.com/status-im/status-go/wakuv2/persistence"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -167,6 +168,11 @@ func (api *PublicWakuAPI) BloomFilter() []byte {
 	return nil
 }
 
+// StoreStats returns the disk usage of the messages stored by the node, by topic.
+func (api *PublicWakuAPI) StoreStats(ctx context.Context) (*persistence.StoreStats, error) {
+	return api.w.StoreStats()
+}
+
 //go:generate gencodec -type NewMessage -field-override newMessageOverride -out gen_newmessage_json.go
 
 // NewMessage represents a new waku message that is posted through the RPC.
@@ -179,6 +185,8 @@ type NewMessage struct {
 	Padding    []byte           `json:"padding"`
 	TargetPeer string           `json:"targetPeer"`
 	Ephemeral  bool             `json:"ephemeral"`
+	// PubsubTopic is the pubsub topic to publish on, the default one if empty
+	PubsubTopic string `json:"pubsubTopic"`
 }
 
 // Post posts a message on the Waku network.
@@ -256,7 +264,7 @@ func (api *PublicWakuAPI) Post(ctx context.Context, req NewMessage) (hexutil.Byt
 		Ephemeral:    req.Ephemeral,
 	}
 
-	hash, err := api.w.Send(wakuMsg)
+	hash, err := api.w.Send(req.PubsubTopic, wakuMsg)
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"sync"
+)
+
+// Waku v2 protocols whose traffic is accounted by content topic
+const (
+	ProtocolRelay     = "relay"
+	ProtocolStore     = "store"
+	ProtocolLightpush = "lightpush"
+	ProtocolFilter    = "filter"
+)
+
+// TopicBandwidth is the size of the messages sent and received on a content
+// topic over a protocol
+type TopicBandwidth struct {
+	PubsubTopic  string
+	ContentTopic string
+	Protocol     string
+	Upload       uint64
+	Download     uint64
+}
+
+type topicBandwidthKey struct {
+	pubsubTopic  string
+	contentTopic string
+	protocol     string
+}
+
+// TopicBandwidthTracker accumulates the traffic of each content topic until
+// it's taken
+type TopicBandwidthTracker struct {
+	sync.Mutex
+	bandwidth map[topicBandwidthKey]*TopicBandwidth
+}
+
+func NewTopicBandwidthTracker() *TopicBandwidthTracker {
+	return &TopicBandwidthTracker{bandwidth: make(map[topicBandwidthKey]*TopicBandwidth)}
+}
+
+func (t *TopicBandwidthTracker) get(protocol, pubsubTopic, contentTopic string) *TopicBandwidth {
+	key := topicBandwidthKey{pubsubTopic: pubsubTopic, contentTopic: contentTopic, protocol: protocol}
+	bandwidth, ok := t.bandwidth[key]
+	if !ok {
+		bandwidth = &TopicBandwidth{PubsubTopic: pubsubTopic, ContentTopic: contentTopic, Protocol: protocol}
+		t.bandwidth[key] = bandwidth
+	}
+	return bandwidth
+}
+
+func (t *TopicBandwidthTracker) AddUpload(protocol, pubsubTopic, contentTopic string, size int) {
+	t.Lock()
+	defer t.Unlock()
+	t.get(protocol, pubsubTopic, contentTopic).Upload += uint64(size)
+}
+
+func (t *TopicBandwidthTracker) AddDownload(protocol, pubsubTopic, contentTopic string, size int) {
+	t.Lock()
+	defer t.Unlock()
+	t.get(protocol, pubsubTopic, contentTopic).Download += uint64(size)
+}
+
+// Take returns the traffic accounted since the previous call
+func (t *TopicBandwidthTracker) Take() []TopicBandwidth {
+	t.Lock()
+	defer t.Unlock()
+
+	result := make([]TopicBandwidth, 0, len(t.bandwidth))
+	for _, bandwidth := range t.bandwidth {
+		result = append(result, *bandwidth)
+	}
+	t.bandwidth = make(map[topicBandwidthKey]*TopicBandwidth)
+	return result
+}
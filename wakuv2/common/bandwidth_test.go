@@ -0,0 +1,33 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicBandwidthTracker(t *testing.T) {
+	tracker := NewTopicBandwidthTracker()
+
+	tracker.AddUpload(ProtocolRelay, "pubsub", "topic", 10)
+	tracker.AddDownload(ProtocolRelay, "pubsub", "topic", 20)
+	tracker.AddDownload(ProtocolStore, "pubsub", "topic", 30)
+
+	bandwidth := tracker.Take()
+	require.Len(t, bandwidth, 2)
+	for _, b := range bandwidth {
+		switch b.Protocol {
+		case ProtocolRelay:
+			require.Equal(t, uint64(10), b.Upload)
+			require.Equal(t, uint64(20), b.Download)
+		case ProtocolStore:
+			require.Equal(t, uint64(0), b.Upload)
+			require.Equal(t, uint64(30), b.Download)
+		default:
+			t.Fatalf("unexpected protocol %s", b.Protocol)
+		}
+	}
+
+	// The traffic is taken only once
+	require.Len(t, tracker.Take(), 0)
+}
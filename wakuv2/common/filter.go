@@ -30,12 +30,13 @@ import (
 
 // Filter represents a Waku message filter
 type Filter struct {
-	Src        *ecdsa.PublicKey  // Sender of the message
-	KeyAsym    *ecdsa.PrivateKey // Private Key of recipient
-	KeySym     []byte            // Key associated with the Topic
-	Topics     [][]byte          // Topics to filter messages with
-	SymKeyHash common.Hash       // The Keccak256Hash of the symmetric key, needed for optimization
-	id         string            // unique identifier
+	Src         *ecdsa.PublicKey  // Sender of the message
+	KeyAsym     *ecdsa.PrivateKey // Private Key of recipient
+	KeySym      []byte            // Key associated with the Topic
+	Topics      [][]byte          // Topics to filter messages with
+	SymKeyHash  common.Hash       // The Keccak256Hash of the symmetric key, needed for optimization
+	PubsubTopic string            // Pubsub topic the messages are relayed on, the default one if empty
+	id          string            // unique identifier
 
 	Messages MessageStore
 }
@@ -42,6 +42,29 @@ type Config struct {
 	EnableStore          bool     `toml:",omitempty"`
 	StoreCapacity        int      `toml:",omitempty"`
 	StoreSeconds         int      `toml:",omitempty"`
+	StoreMaxBytes        int64    `toml:",omitempty"`
+	EnableRLN            bool     `toml:",omitempty"`
+	RLNCredentialPath    string   `toml:",omitempty"`
+	RLNStaticGroupPath   string   `toml:",omitempty"`
+	// RLNRegistry provides the members of the RLN group, instead of the static group file
+	RLNRegistry RLNMembershipRegistry `toml:"-"`
+	// StoreRetentionPolicies apply to the messages of their topic instead of StoreCapacity and StoreSeconds
+	StoreRetentionPolicies []StoreRetentionPolicy `toml:",omitempty"`
+	// StoreIncrementalVacuum returns the space freed by removed messages to the file system.
+	// The messages are then kept in a DB of their own at StoreDBPath, instead of the app DB,
+	// and the ones already in the app DB are moved there
+	StoreIncrementalVacuum bool   `toml:",omitempty"`
+	StoreDBPath            string `toml:",omitempty"`
+}
+
+// StoreRetentionPolicy limits the messages of a topic kept in the store. An
+// empty pubsub or content topic matches any, zero limits mean no limit
+type StoreRetentionPolicy struct {
+	PubsubTopic  string `toml:",omitempty"`
+	ContentTopic string `toml:",omitempty"`
+	MaxMessages  int    `toml:",omitempty"`
+	MaxSeconds   int    `toml:",omitempty"`
+	MaxBytes     int64  `toml:",omitempty"`
 }
 
 var DefaultConfig = Config{
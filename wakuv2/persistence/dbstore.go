@@ -12,7 +12,6 @@ import (
 	"github.com/waku-org/go-waku/waku/v2/protocol"
 	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
 	"github.com/waku-org/go-waku/waku/v2/timesource"
-	"github.com/waku-org/go-waku/waku/v2/utils"
 
 	"go.uber.org/zap"
 )
@@ -24,8 +23,11 @@ type DBStore struct {
 	db  *sql.DB
 	log *zap.Logger
 
-	maxMessages int
-	maxDuration time.Duration
+	maxMessages       int
+	maxDuration       time.Duration
+	maxBytes          int64
+	topicPolicies     []TopicRetentionPolicy
+	incrementalVacuum bool
 
 	wg   sync.WaitGroup
 	quit chan struct{}
@@ -71,6 +73,16 @@ func NewDBStore(log *zap.Logger, options ...DBOption) (*DBStore, error) {
 }
 
 func (d *DBStore) Start(timesource timesource.Timesource) error {
+	if d.incrementalVacuum {
+		enabled, err := d.incrementalVacuumEnabled()
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return errors.New("incremental vacuum requires a DB dedicated to the store")
+		}
+	}
+
 	err := d.cleanOlderRecords()
 	if err != nil {
 		return err
@@ -85,28 +97,38 @@ func (d *DBStore) Start(timesource timesource.Timesource) error {
 func (d *DBStore) cleanOlderRecords() error {
 	d.log.Debug("Cleaning older records...")
 
-	// Delete older messages
-	if d.maxDuration > 0 {
-		start := time.Now()
-		sqlStmt := `DELETE FROM store_messages WHERE receiverTimestamp < ?`
-		_, err := d.db.Exec(sqlStmt, utils.GetUnixEpochFrom(time.Now().Add(-d.maxDuration)))
+	// Messages are only subject to the first topic policy matching them, the
+	// default one applies to the others
+	var excluded []string
+	var excludedParameters []interface{}
+	for _, policy := range d.topicPolicies {
+		condition, parameters := policy.condition()
+		scope := append([]string{condition}, excluded...)
+		err := d.applyRetentionPolicy(policy.RetentionPolicy, strings.Join(scope, " AND "), append(parameters, excludedParameters...))
 		if err != nil {
 			return err
 		}
-		elapsed := time.Since(start)
-		d.log.Debug("deleting older records from the DB", zap.Duration("duration", elapsed))
+		excluded = append(excluded, "NOT ("+condition+")")
+		excludedParameters = append(excludedParameters, parameters...)
 	}
 
-	// Limit number of records to a max N
-	if d.maxMessages > 0 {
-		start := time.Now()
-		sqlStmt := `DELETE FROM store_messages WHERE id IN (SELECT id FROM store_messages ORDER BY receiverTimestamp DESC LIMIT -1 OFFSET ?)`
-		_, err := d.db.Exec(sqlStmt, d.maxMessages)
-		if err != nil {
-			return err
-		}
-		elapsed := time.Since(start)
-		d.log.Debug("deleting excess records from the DB", zap.Duration("duration", elapsed))
+	scope := "1"
+	if len(excluded) != 0 {
+		scope = strings.Join(excluded, " AND ")
+	}
+	err := d.applyRetentionPolicy(RetentionPolicy{MaxMessages: d.maxMessages, MaxDuration: d.maxDuration}, scope, excludedParameters)
+	if err != nil {
+		return err
+	}
+
+	// The size cap applies to all messages
+	err = d.applyRetentionPolicy(RetentionPolicy{MaxBytes: d.maxBytes}, "1", nil)
+	if err != nil {
+		return err
+	}
+
+	if d.incrementalVacuum {
+		return d.vacuum()
 	}
 
 	return nil
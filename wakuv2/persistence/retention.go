@@ -0,0 +1,241 @@
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/utils"
+)
+
+// vacuumPagesPerRun is the number of free pages returned to the file system
+// each time the records are cleaned, so that the DB isn't locked for long
+const vacuumPagesPerRun = 4096
+
+// messageSize is the SQL expression of the number of bytes taken by a stored message
+const messageSize = "(length(id) + length(contentTopic) + length(pubsubTopic) + IFNULL(length(payload), 0))"
+
+// RetentionPolicy limits the messages kept in the store. Zero values mean no limit
+type RetentionPolicy struct {
+	MaxMessages int
+	MaxDuration time.Duration
+	MaxBytes    int64
+}
+
+// TopicRetentionPolicy is the retention policy of the messages of a topic. An
+// empty pubsub or content topic matches any
+type TopicRetentionPolicy struct {
+	PubsubTopic  string
+	ContentTopic string
+	RetentionPolicy
+}
+
+func (p TopicRetentionPolicy) condition() (string, []interface{}) {
+	var conditions []string
+	var parameters []interface{}
+	if p.PubsubTopic != "" {
+		conditions = append(conditions, "pubsubTopic = ?")
+		parameters = append(parameters, p.PubsubTopic)
+	}
+	if p.ContentTopic != "" {
+		conditions = append(conditions, "contentTopic = ?")
+		parameters = append(parameters, p.ContentTopic)
+	}
+	if len(conditions) == 0 {
+		return "1", nil
+	}
+	return strings.Join(conditions, " AND "), parameters
+}
+
+// TopicUsage is the space taken by the messages of a topic
+type TopicUsage struct {
+	PubsubTopic  string `json:"pubsubTopic"`
+	ContentTopic string `json:"contentTopic"`
+	Messages     int    `json:"messages"`
+	Bytes        int64  `json:"bytes"`
+}
+
+// StoreStats reports the disk usage of the store
+type StoreStats struct {
+	// FileSize is the size of the DB file dedicated to the store, zero if the
+	// store shares the app DB
+	FileSize int64 `json:"fileSize"`
+	// FreeSize is the size of the unused pages of the DB dedicated to the store
+	// that haven't been vacuumed yet, zero if the store shares the app DB
+	FreeSize int64        `json:"freeSize"`
+	Topics   []TopicUsage `json:"topics"`
+}
+
+// WithMaxSize is a DBOption that specifies the max number of bytes taken by
+// the stored messages. The oldest ones are removed first
+func WithMaxSize(maxBytes int64) DBOption {
+	return func(d *DBStore) error {
+		d.maxBytes = maxBytes
+		return nil
+	}
+}
+
+// WithTopicRetentionPolicies is a DBOption that specifies retention policies by
+// topic. Messages are retained according to the first policy matching their
+// topic, instead of the default one
+func WithTopicRetentionPolicies(policies ...TopicRetentionPolicy) DBOption {
+	return func(d *DBStore) error {
+		d.topicPolicies = policies
+		return nil
+	}
+}
+
+// WithIncrementalVacuum is a DBOption that returns the space freed by removed
+// records to the file system, in the background. The DB must be dedicated to
+// the store and opened with OpenStoreDB
+func WithIncrementalVacuum() DBOption {
+	return func(d *DBStore) error {
+		d.incrementalVacuum = true
+		return nil
+	}
+}
+
+// applyRetentionPolicy removes the records matching condition in excess of policy
+func (d *DBStore) applyRetentionPolicy(policy RetentionPolicy, condition string, parameters []interface{}) error {
+	if policy.MaxDuration > 0 {
+		start := time.Now()
+		sqlStmt := `DELETE FROM store_messages WHERE receiverTimestamp < ? AND ` + condition // nolint: gosec
+		_, err := d.db.Exec(sqlStmt, append([]interface{}{utils.GetUnixEpochFrom(time.Now().Add(-policy.MaxDuration))}, parameters...)...)
+		if err != nil {
+			return err
+		}
+		d.log.Debug("deleting older records from the DB", zap.Duration("duration", time.Since(start)))
+	}
+
+	if policy.MaxMessages > 0 {
+		start := time.Now()
+		sqlStmt := `DELETE FROM store_messages WHERE id IN (SELECT id FROM store_messages WHERE ` + condition + ` ORDER BY receiverTimestamp DESC LIMIT -1 OFFSET ?)` // nolint: gosec
+		_, err := d.db.Exec(sqlStmt, append(append([]interface{}{}, parameters...), policy.MaxMessages)...)
+		if err != nil {
+			return err
+		}
+		d.log.Debug("deleting excess records from the DB", zap.Duration("duration", time.Since(start)))
+	}
+
+	if policy.MaxBytes > 0 {
+		start := time.Now()
+		err := d.deleteOverSize(policy.MaxBytes, condition, parameters)
+		if err != nil {
+			return err
+		}
+		d.log.Debug("deleting records over the size limit from the DB", zap.Duration("duration", time.Since(start)))
+	}
+
+	return nil
+}
+
+// deleteOverSize removes the oldest records matching condition until the others
+// take at most maxBytes
+func (d *DBStore) deleteOverSize(maxBytes int64, condition string, parameters []interface{}) error {
+	var total sql.NullInt64
+	err := d.db.QueryRow(`SELECT SUM(`+messageSize+`) FROM store_messages WHERE `+condition, parameters...).Scan(&total) // nolint: gosec
+	if err != nil {
+		return err
+	}
+	if total.Int64 <= maxBytes {
+		return nil
+	}
+
+	// Find the newest record that doesn't fit, it's removed with all the older ones
+	rows, err := d.db.Query(`SELECT id, receiverTimestamp, `+messageSize+` FROM store_messages WHERE `+condition+` ORDER BY receiverTimestamp DESC, id DESC`, parameters...) // nolint: gosec
+	if err != nil {
+		return err
+	}
+
+	var id []byte
+	var receiverTimestamp int64
+	var size int64
+	found := false
+	total.Int64 = 0
+	for rows.Next() {
+		err = rows.Scan(&id, &receiverTimestamp, &size)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		total.Int64 += size
+		if total.Int64 > maxBytes {
+			found = true
+			break
+		}
+	}
+	rows.Close()
+
+	if !found {
+		return nil
+	}
+
+	sqlStmt := `DELETE FROM store_messages WHERE (receiverTimestamp < ? OR (receiverTimestamp = ? AND id <= ?)) AND ` + condition // nolint: gosec
+	_, err = d.db.Exec(sqlStmt, append([]interface{}{receiverTimestamp, receiverTimestamp, id}, parameters...)...)
+	return err
+}
+
+// incrementalVacuumEnabled returns whether the DB was created with incremental
+// vacuum. Enabling it afterwards requires a full VACUUM, which isn't done here as
+// the DB may be shared with the app, see OpenStoreDB
+func (d *DBStore) incrementalVacuumEnabled() (bool, error) {
+	var mode int
+	err := d.db.QueryRow(`PRAGMA auto_vacuum`).Scan(&mode)
+	if err != nil {
+		return false, err
+	}
+
+	// 2 is INCREMENTAL
+	return mode == 2, nil
+}
+
+func (d *DBStore) vacuum() error {
+	_, err := d.db.Exec(fmt.Sprintf(`PRAGMA incremental_vacuum(%d)`, vacuumPagesPerRun))
+	return err
+}
+
+// Stats returns the disk usage of the messages of each topic, and of the DB if
+// it's dedicated to the store
+func (d *DBStore) Stats() (*StoreStats, error) {
+	stats := &StoreStats{}
+
+	// The pages of a DB shared with the app can't be told apart from the store ones
+	if d.incrementalVacuum {
+		var pageSize, pageCount, freePages int64
+		err := d.db.QueryRow(`PRAGMA page_size`).Scan(&pageSize)
+		if err != nil {
+			return nil, err
+		}
+		err = d.db.QueryRow(`PRAGMA page_count`).Scan(&pageCount)
+		if err != nil {
+			return nil, err
+		}
+		err = d.db.QueryRow(`PRAGMA freelist_count`).Scan(&freePages)
+		if err != nil {
+			return nil, err
+		}
+
+		stats.FileSize = pageSize * pageCount
+		stats.FreeSize = pageSize * freePages
+	}
+
+	rows, err := d.db.Query(`SELECT pubsubTopic, contentTopic, COUNT(*), SUM(` + messageSize + `) FROM store_messages GROUP BY pubsubTopic, contentTopic ORDER BY 4 DESC`) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var usage TopicUsage
+		err := rows.Scan(&usage.PubsubTopic, &usage.ContentTopic, &usage.Messages, &usage.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		stats.Topics = append(stats.Topics, usage)
+	}
+
+	return stats, rows.Err()
+}
@@ -0,0 +1,162 @@
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/timesource"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/sqlite"
+)
+
+const (
+	communityPubsubTopic = "/waku/2/rs/16/1"
+	defaultPubsubTopic   = "/waku/2/default-waku/proto"
+)
+
+func newTestDBStore(t *testing.T, options ...DBOption) (*DBStore, func()) {
+	tmpfile, err := ioutil.TempFile("", "dbstore-tests-")
+	require.NoError(t, err)
+	db, err := appdatabase.InitializeDB(tmpfile.Name(), "tests", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+
+	store, err := NewDBStore(zap.NewNop(), append([]DBOption{WithDB(db)}, options...)...)
+	require.NoError(t, err)
+
+	return store, func() {
+		_ = db.Close()
+		_ = os.Remove(tmpfile.Name())
+	}
+}
+
+func putMessages(t *testing.T, store *DBStore, pubsubTopic string, contentTopic string, count int, payloadSize int) {
+	now := time.Now().UnixNano()
+	for i := 0; i < count; i++ {
+		msg := &pb.WakuMessage{
+			ContentTopic: contentTopic,
+			Payload:      make([]byte, payloadSize),
+			Timestamp:    now + int64(i),
+		}
+		require.NoError(t, store.Put(protocol.NewEnvelope(msg, now+int64(i), pubsubTopic)))
+	}
+}
+
+func countMessages(t *testing.T, store *DBStore, pubsubTopic string) int {
+	var count int
+	require.NoError(t, store.db.QueryRow(`SELECT COUNT(*) FROM store_messages WHERE pubsubTopic = ?`, pubsubTopic).Scan(&count))
+	return count
+}
+
+func TestTopicRetentionPolicies(t *testing.T) {
+	store, cleanup := newTestDBStore(t,
+		WithRetentionPolicy(2, 0),
+		WithTopicRetentionPolicies(TopicRetentionPolicy{
+			PubsubTopic:     communityPubsubTopic,
+			RetentionPolicy: RetentionPolicy{MaxMessages: 5},
+		}),
+	)
+	defer cleanup()
+
+	putMessages(t, store, communityPubsubTopic, "/waku/1/0x01020304/rfc26", 10, 10)
+	putMessages(t, store, defaultPubsubTopic, "/waku/1/0x05060708/rfc26", 10, 10)
+
+	require.NoError(t, store.cleanOlderRecords())
+
+	// Community messages are kept longer than the other ones
+	require.Equal(t, 5, countMessages(t, store, communityPubsubTopic))
+	require.Equal(t, 2, countMessages(t, store, defaultPubsubTopic))
+}
+
+func newTestStoreDB(t *testing.T, options ...DBOption) (*DBStore, func()) {
+	dir := t.TempDir()
+	db, err := OpenStoreDB(filepath.Join(dir, "store.db"))
+	require.NoError(t, err)
+
+	store, err := NewDBStore(zap.NewNop(), append([]DBOption{WithDB(db)}, options...)...)
+	require.NoError(t, err)
+
+	return store, func() {
+		_ = db.Close()
+	}
+}
+
+func TestMaxSize(t *testing.T) {
+	store, cleanup := newTestStoreDB(t, WithMaxSize(5000), WithIncrementalVacuum())
+	defer cleanup()
+	enabled, err := store.incrementalVacuumEnabled()
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	putMessages(t, store, defaultPubsubTopic, "/waku/1/0x05060708/rfc26", 10, 1000)
+
+	stats, err := store.Stats()
+	require.NoError(t, err)
+	require.Len(t, stats.Topics, 1)
+	require.Equal(t, 10, stats.Topics[0].Messages)
+	require.Greater(t, stats.Topics[0].Bytes, int64(10000))
+
+	require.NoError(t, store.cleanOlderRecords())
+
+	stats, err = store.Stats()
+	require.NoError(t, err)
+	require.Len(t, stats.Topics, 1)
+	require.Equal(t, defaultPubsubTopic, stats.Topics[0].PubsubTopic)
+	require.Equal(t, 4, stats.Topics[0].Messages)
+	require.LessOrEqual(t, stats.Topics[0].Bytes, int64(5000))
+	require.NotZero(t, stats.FileSize)
+}
+
+func TestIncrementalVacuumRequiresStoreDB(t *testing.T) {
+	store, cleanup := newTestDBStore(t, WithIncrementalVacuum())
+	defer cleanup()
+
+	// The app DB is never vacuumed by the store
+	require.Error(t, store.Start(timesource.NewDefaultClock()))
+}
+
+func TestStatsOfSharedDB(t *testing.T) {
+	store, cleanup := newTestDBStore(t)
+	defer cleanup()
+
+	putMessages(t, store, defaultPubsubTopic, "/waku/1/0x05060708/rfc26", 2, 1000)
+
+	// The size of the app DB isn't reported as the one of the store
+	stats, err := store.Stats()
+	require.NoError(t, err)
+	require.Zero(t, stats.FileSize)
+	require.Zero(t, stats.FreeSize)
+	require.Len(t, stats.Topics, 1)
+	require.Equal(t, 2, stats.Topics[0].Messages)
+}
+
+func TestMoveStoreMessages(t *testing.T) {
+	appStore, cleanupApp := newTestDBStore(t)
+	defer cleanupApp()
+	store, cleanup := newTestStoreDB(t)
+	defer cleanup()
+
+	putMessages(t, appStore, defaultPubsubTopic, "/waku/1/0x05060708/rfc26", moveBatchSize+5, 10)
+	// Messages moved before an interruption are only moved once
+	putMessages(t, store, communityPubsubTopic, "/waku/1/0x01020304/rfc26", 3, 10)
+
+	moved, err := MoveStoreMessages(appStore.db, store.db)
+	require.NoError(t, err)
+	require.Equal(t, moveBatchSize+5, moved)
+
+	require.Equal(t, 0, countMessages(t, appStore, defaultPubsubTopic))
+	require.Equal(t, moveBatchSize+5, countMessages(t, store, defaultPubsubTopic))
+	require.Equal(t, 3, countMessages(t, store, communityPubsubTopic))
+
+	moved, err = MoveStoreMessages(appStore.db, store.db)
+	require.NoError(t, err)
+	require.Zero(t, moved)
+}
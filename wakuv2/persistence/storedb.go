@@ -0,0 +1,160 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/status-im/status-go/sqlite"
+)
+
+// storeMessagesSchema is the schema of the messages table, as created in the app DB
+const storeMessagesSchema = `
+CREATE TABLE IF NOT EXISTS store_messages (
+	id BLOB,
+	receiverTimestamp INTEGER NOT NULL,
+	senderTimestamp INTEGER NOT NULL,
+	contentTopic BLOB NOT NULL,
+	pubsubTopic BLOB NOT NULL,
+	payload BLOB,
+	version INTEGER NOT NULL DEFAULT 0,
+	CONSTRAINT messageIndex PRIMARY KEY (id, pubsubTopic)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS store_message_senderTimestamp ON store_messages(senderTimestamp);
+CREATE INDEX IF NOT EXISTS store_message_receiverTimestamp ON store_messages(receiverTimestamp);`
+
+// OpenStoreDB opens the DB at path dedicated to the stored messages, creating it
+// if needed. A new DB is created with incremental vacuum, which can't be enabled
+// without a full VACUUM once tables exist. The stored messages are relayed
+// envelopes, so like the mailserver DB it isn't encrypted.
+func OpenStoreDB(path string) (*sql.DB, error) {
+	db, err := sqlite.OpenUnecryptedDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mode of a new DB is switched while it's empty, so its VACUUM is instant
+	var tables int
+	err = db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table'`).Scan(&tables)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if tables == 0 {
+		_, err = db.Exec(`PRAGMA auto_vacuum = INCREMENTAL`)
+		if err == nil {
+			_, err = db.Exec(`VACUUM`)
+		}
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	_, err = db.Exec(storeMessagesSchema)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// moveBatchSize is the number of messages moved at once by MoveStoreMessages
+const moveBatchSize = 1000
+
+// MoveStoreMessages moves the messages stored in from into to, one batch at a time.
+// A batch is only removed from from once it is in to, so an interrupted move is
+// resumed on the next call. It returns the number of messages moved.
+func MoveStoreMessages(from, to *sql.DB) (int, error) {
+	moved := 0
+	for {
+		n, err := moveStoreMessagesBatch(from, to)
+		moved += n
+		if err != nil || n == 0 {
+			return moved, err
+		}
+	}
+}
+
+type storeMessageRow struct {
+	id                []byte
+	receiverTimestamp int64
+	senderTimestamp   int64
+	contentTopic      string
+	pubsubTopic       string
+	payload           []byte
+	version           int64
+}
+
+func moveStoreMessagesBatch(from, to *sql.DB) (int, error) {
+	rows, err := from.Query(`SELECT id, receiverTimestamp, senderTimestamp, contentTopic, pubsubTopic, payload, version FROM store_messages LIMIT ?`, moveBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var batch []storeMessageRow
+	for rows.Next() {
+		var r storeMessageRow
+		err = rows.Scan(&r.id, &r.receiverTimestamp, &r.senderTimestamp, &r.contentTopic, &r.pubsubTopic, &r.payload, &r.version)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		batch = append(batch, r)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	err = inTx(to, func(tx *sql.Tx) error {
+		for _, r := range batch {
+			_, err := tx.Exec(`INSERT OR IGNORE INTO store_messages(id, receiverTimestamp, senderTimestamp, contentTopic, pubsubTopic, payload, version) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				r.id, r.receiverTimestamp, r.senderTimestamp, r.contentTopic, r.pubsubTopic, r.payload, r.version)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = inTx(from, func(tx *sql.Tx) error {
+		for _, r := range batch {
+			_, err := tx.Exec(`DELETE FROM store_messages WHERE id = ? AND pubsubTopic = ?`, r.id, r.pubsubTopic)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(batch), nil
+}
+
+func inTx(db *sql.DB, fn func(*sql.Tx) error) (err error) {
+	tx, err := db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	return fn(tx)
+}
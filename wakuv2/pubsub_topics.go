@@ -0,0 +1,145 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+package wakuv2
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+// Messages are relayed on the default pubsub topic unless a filter or a message
+// names another one, so that e.g. the traffic of a community can be kept on its
+// own shard. The node only subscribes to the pubsub topics its filters need.
+
+// pubsubTopicSubscription is a relay subscription shared by the filters on a pubsub topic
+type pubsubTopicSubscription struct {
+	filters int
+	sub     *relay.Subscription
+}
+
+func pubsubTopicOrDefault(topic string) string {
+	if topic == "" {
+		return relay.DefaultWakuTopic
+	}
+	return topic
+}
+
+// subscribeToPubsubTopic relays the messages of topic for one more filter
+func (w *Waku) subscribeToPubsubTopic(topic string) error {
+	if w.settings.LightClient || topic == relay.DefaultWakuTopic {
+		return nil
+	}
+
+	w.pubsubTopicsMu.Lock()
+	defer w.pubsubTopicsMu.Unlock()
+
+	if s, ok := w.pubsubTopics[topic]; ok {
+		s.filters++
+		return nil
+	}
+
+	sub, err := w.node.Relay().SubscribeToTopic(context.Background(), topic)
+	if err != nil {
+		return err
+	}
+
+	if err = w.mountRLNOnPubsubTopic(topic); err != nil {
+		if err := w.node.Relay().Unsubscribe(context.Background(), topic); err != nil {
+			w.logger.Warn("could not unsubscribe from pubsub topic", zap.String("pubsubTopic", topic), zap.Error(err))
+		}
+		return err
+	}
+
+	w.logger.Debug("subscribed to pubsub topic", zap.String("pubsubTopic", topic))
+	w.pubsubTopics[topic] = &pubsubTopicSubscription{filters: 1, sub: sub}
+
+	w.wg.Add(1)
+	go w.runPubsubTopicMsgLoop(sub)
+
+	return nil
+}
+
+// unsubscribeFromPubsubTopic stops relaying the messages of topic once no filter needs them
+func (w *Waku) unsubscribeFromPubsubTopic(topic string) {
+	if w.settings.LightClient || topic == relay.DefaultWakuTopic {
+		return
+	}
+
+	w.pubsubTopicsMu.Lock()
+	defer w.pubsubTopicsMu.Unlock()
+
+	s, ok := w.pubsubTopics[topic]
+	if !ok {
+		return
+	}
+
+	s.filters--
+	if s.filters > 0 {
+		return
+	}
+
+	delete(w.pubsubTopics, topic)
+	w.logger.Debug("unsubscribing from pubsub topic", zap.String("pubsubTopic", topic))
+	if err := w.node.Relay().Unsubscribe(context.Background(), topic); err != nil {
+		w.logger.Warn("could not unsubscribe from pubsub topic", zap.String("pubsubTopic", topic), zap.Error(err))
+	}
+	w.unmountRLNFromPubsubTopic(topic)
+}
+
+// SubscribedPubsubTopics returns the pubsub topics the node relays messages of
+func (w *Waku) SubscribedPubsubTopics() []string {
+	if w.settings.LightClient {
+		return nil
+	}
+
+	w.pubsubTopicsMu.Lock()
+	defer w.pubsubTopicsMu.Unlock()
+
+	topics := []string{relay.DefaultWakuTopic}
+	for topic := range w.pubsubTopics {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+func (w *Waku) runPubsubTopicMsgLoop(sub *relay.Subscription) {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-w.quit:
+			sub.Unsubscribe()
+			return
+		case env, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			w.topicBandwidth.AddDownload(common.ProtocolRelay, env.PubsubTopic(), env.Message().ContentTopic, env.Message().Size())
+			_, err := w.OnNewEnvelopes(env, common.RelayedMessageType)
+			if err != nil {
+				w.logger.Error("onNewEnvelope error", zap.Error(err))
+			}
+		}
+	}
+}
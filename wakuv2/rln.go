@@ -0,0 +1,194 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+package wakuv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+)
+
+// RLN (rate limiting nullifier) lets relay nodes drop the messages of peers who aren't
+// members of the RLN group, or who publish more than one message per epoch. Outgoing
+// messages carry a zero knowledge proof of membership, which requires building with the
+// gowaku_rln tag. Proofs are validated on every relayed pubsub topic, the default one
+// and those joined later on, such as community shards, each with its own nullifier log.
+
+// rlnIDSize is the size in bytes of identity keys and commitments
+const rlnIDSize = 32
+
+var (
+	ErrRLNNotSupported       = errors.New("RLN requires building with the gowaku_rln tag")
+	ErrRLNInvalidCredential  = errors.New("invalid RLN membership credential")
+	ErrRLNInvalidMember      = errors.New("invalid RLN group member")
+	ErrRLNMembershipNotFound = errors.New("RLN membership credential not found in the group")
+)
+
+// RLNMembershipCredential is the membership of this node in the RLN group
+type RLNMembershipCredential struct {
+	IDKey        hexutil.Bytes `json:"idKey"`
+	IDCommitment hexutil.Bytes `json:"idCommitment"`
+	// Index is the position of IDCommitment in the group
+	Index uint `json:"index"`
+}
+
+// LoadRLNMembershipCredential reads a membership credential from a JSON file
+func LoadRLNMembershipCredential(path string) (*RLNMembershipCredential, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := &RLNMembershipCredential{}
+	err = json.Unmarshal(data, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(credential.IDKey) != rlnIDSize || len(credential.IDCommitment) != rlnIDSize {
+		return nil, ErrRLNInvalidCredential
+	}
+
+	return credential, nil
+}
+
+// RLNMembershipRegistry provides the members of the RLN group
+type RLNMembershipRegistry interface {
+	// Members returns the identity commitments of the members, ordered by their index
+	Members() ([][rlnIDSize]byte, error)
+}
+
+// RLNStaticGroupRegistry is a RLNMembershipRegistry backed by a JSON file, listing the
+// hex encoded identity commitments of the members
+type RLNStaticGroupRegistry struct {
+	path string
+}
+
+func NewRLNStaticGroupRegistry(path string) *RLNStaticGroupRegistry {
+	return &RLNStaticGroupRegistry{path: path}
+}
+
+func (r *RLNStaticGroupRegistry) Members() ([][rlnIDSize]byte, error) {
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var encodedMembers []hexutil.Bytes
+	err = json.Unmarshal(data, &encodedMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([][rlnIDSize]byte, len(encodedMembers))
+	for i, member := range encodedMembers {
+		if len(member) != rlnIDSize {
+			return nil, fmt.Errorf("%w: %d", ErrRLNInvalidMember, i)
+		}
+		copy(members[i][:], member)
+	}
+
+	return members, nil
+}
+
+// rlnRelay validates the proofs of relayed messages, and attaches proofs to outgoing ones
+type rlnRelay interface {
+	AppendRLNProof(msg *pb.WakuMessage, senderEpochTime time.Time) error
+	Stop()
+}
+
+// rlnMembership is the membership of this node, and the members of the RLN group
+type rlnMembership struct {
+	credential *RLNMembershipCredential
+	members    [][rlnIDSize]byte
+}
+
+// rlnRelayFor returns the RLN relay of pubsubTopic, falling back to the one of the
+// default pubsub topic for topics that aren't relayed, or nil if RLN is disabled
+func (w *Waku) rlnRelayFor(pubsubTopic string) rlnRelay {
+	w.rlnRelaysMu.Lock()
+	defer w.rlnRelaysMu.Unlock()
+
+	if r, ok := w.rlnRelays[pubsubTopic]; ok {
+		return r
+	}
+	return w.rlnRelays[relay.DefaultWakuTopic]
+}
+
+// unmountRLNFromPubsubTopic stops validating the proofs of the messages of pubsubTopic
+func (w *Waku) unmountRLNFromPubsubTopic(pubsubTopic string) {
+	w.rlnRelaysMu.Lock()
+	r, ok := w.rlnRelays[pubsubTopic]
+	delete(w.rlnRelays, pubsubTopic)
+	w.rlnRelaysMu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	r.Stop()
+	if err := w.node.Relay().PubSub().UnregisterTopicValidator(pubsubTopic); err != nil {
+		w.logger.Warn("could not unregister RLN validator", zap.String("pubsubTopic", pubsubTopic), zap.Error(err))
+	}
+}
+
+// stopRLN stops the RLN relays of all the pubsub topics
+func (w *Waku) stopRLN() {
+	w.rlnRelaysMu.Lock()
+	defer w.rlnRelaysMu.Unlock()
+
+	for topic, r := range w.rlnRelays {
+		r.Stop()
+		delete(w.rlnRelays, topic)
+	}
+}
+
+// loadRLNMembership returns the membership credential of this node and the members of
+// the RLN group, making sure the credential belongs to the group
+func loadRLNMembership(cfg *Config) (*RLNMembershipCredential, [][rlnIDSize]byte, error) {
+	credential, err := LoadRLNMembershipCredential(cfg.RLNCredentialPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registry := cfg.RLNRegistry
+	if registry == nil {
+		registry = NewRLNStaticGroupRegistry(cfg.RLNStaticGroupPath)
+	}
+
+	members, err := registry.Members()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if credential.Index >= uint(len(members)) || !bytes.Equal(members[credential.Index][:], credential.IDCommitment) {
+		return nil, nil, ErrRLNMembershipNotFound
+	}
+
+	return credential, members, nil
+}
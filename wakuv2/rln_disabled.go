@@ -0,0 +1,37 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+//go:build !gowaku_rln
+// +build !gowaku_rln
+
+package wakuv2
+
+import (
+	"context"
+)
+
+func (w *Waku) mountRLN(ctx context.Context, cfg *Config) error {
+	if cfg.EnableRLN {
+		return ErrRLNNotSupported
+	}
+	return nil
+}
+
+func (w *Waku) mountRLNOnPubsubTopic(pubsubTopic string) error {
+	return nil
+}
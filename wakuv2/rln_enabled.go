@@ -0,0 +1,94 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+//go:build gowaku_rln
+// +build gowaku_rln
+
+package wakuv2
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+	"github.com/waku-org/go-waku/waku/v2/protocol/rln"
+	r "github.com/waku-org/go-zerokit-rln/rln"
+)
+
+// mountRLN validates the proofs of the messages relayed on the default pubsub topic,
+// using the membership credential and group of cfg. The pubsub topics subscribed to
+// later on are validated by mountRLNOnPubsubTopic.
+func (w *Waku) mountRLN(ctx context.Context, cfg *Config) error {
+	if !cfg.EnableRLN {
+		return nil
+	}
+
+	if w.node.Relay() == nil {
+		return errors.New("RLN requires relay")
+	}
+
+	credential, members, err := loadRLNMembership(cfg)
+	if err != nil {
+		return err
+	}
+
+	w.rlnMembership = &rlnMembership{credential: credential, members: members}
+	return w.mountRLNOnPubsubTopic(relay.DefaultWakuTopic)
+}
+
+// mountRLNOnPubsubTopic validates the proofs of the messages relayed on pubsubTopic,
+// if RLN is enabled
+func (w *Waku) mountRLNOnPubsubTopic(pubsubTopic string) error {
+	if w.rlnMembership == nil {
+		return nil
+	}
+
+	w.rlnRelaysMu.Lock()
+	defer w.rlnRelaysMu.Unlock()
+
+	if _, ok := w.rlnRelays[pubsubTopic]; ok {
+		return nil
+	}
+
+	credential := w.rlnMembership.credential
+	group := make([]r.IDCommitment, len(w.rlnMembership.members))
+	for i, member := range w.rlnMembership.members {
+		group[i] = member
+	}
+
+	var keyPair r.MembershipKeyPair
+	copy(keyPair.IDKey[:], credential.IDKey)
+	copy(keyPair.IDCommitment[:], credential.IDCommitment)
+
+	spamHandler := func(message *pb.WakuMessage) error {
+		w.logger.Debug("dropping spam message", zap.String("pubsubTopic", pubsubTopic), zap.String("contentTopic", message.ContentTopic))
+		return nil
+	}
+
+	// An empty content topic validates all the messages of the pubsub topic
+	rlnRelay, err := rln.RlnRelayStatic(context.Background(), w.node.Relay(), group, keyPair, r.MembershipIndex(credential.Index), pubsubTopic, "", spamHandler, w.node.Timesource(), w.logger)
+	if err != nil {
+		return err
+	}
+
+	w.rlnRelays[pubsubTopic] = rlnRelay
+	return nil
+}
@@ -0,0 +1,133 @@
+package wakuv2
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+)
+
+type testRLNRegistry struct {
+	members [][rlnIDSize]byte
+}
+
+func (r *testRLNRegistry) Members() ([][rlnIDSize]byte, error) {
+	return r.members, nil
+}
+
+type testRLNRelay struct {
+	stopped bool
+}
+
+func (r *testRLNRelay) AppendRLNProof(msg *pb.WakuMessage, senderEpochTime time.Time) error {
+	return nil
+}
+
+func (r *testRLNRelay) Stop() {
+	r.stopped = true
+}
+
+func rlnID(b byte) [rlnIDSize]byte {
+	var id [rlnIDSize]byte
+	id[0] = b
+	return id
+}
+
+func writeJSON(t *testing.T, path string, v interface{}) {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+}
+
+func writeRLNMembership(t *testing.T, dir string, index uint) *Config {
+	key := rlnID(1)
+	commitment := rlnID(2)
+
+	credentialPath := filepath.Join(dir, "credential.json")
+	writeJSON(t, credentialPath, &RLNMembershipCredential{
+		IDKey:        key[:],
+		IDCommitment: commitment[:],
+		Index:        index,
+	})
+
+	other := rlnID(3)
+	groupPath := filepath.Join(dir, "group.json")
+	writeJSON(t, groupPath, []hexutil.Bytes{other[:], commitment[:]})
+
+	return &Config{
+		EnableRLN:          true,
+		RLNCredentialPath:  credentialPath,
+		RLNStaticGroupPath: groupPath,
+	}
+}
+
+func TestLoadRLNMembership(t *testing.T) {
+	cfg := writeRLNMembership(t, t.TempDir(), 1)
+
+	credential, members, err := loadRLNMembership(cfg)
+	require.NoError(t, err)
+	require.Equal(t, uint(1), credential.Index)
+	require.Equal(t, [][rlnIDSize]byte{rlnID(3), rlnID(2)}, members)
+}
+
+func TestLoadRLNMembershipNotInGroup(t *testing.T) {
+	cfg := writeRLNMembership(t, t.TempDir(), 0)
+	_, _, err := loadRLNMembership(cfg)
+	require.Equal(t, ErrRLNMembershipNotFound, err)
+
+	cfg = writeRLNMembership(t, t.TempDir(), 2)
+	_, _, err = loadRLNMembership(cfg)
+	require.Equal(t, ErrRLNMembershipNotFound, err)
+}
+
+func TestLoadRLNMembershipFromRegistry(t *testing.T) {
+	cfg := writeRLNMembership(t, t.TempDir(), 0)
+	cfg.RLNRegistry = &testRLNRegistry{members: [][rlnIDSize]byte{rlnID(2)}}
+
+	credential, members, err := loadRLNMembership(cfg)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), credential.Index)
+	require.Len(t, members, 1)
+}
+
+func TestLoadInvalidRLNMembershipCredential(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credential.json")
+	writeJSON(t, path, &RLNMembershipCredential{IDKey: []byte{1}, IDCommitment: []byte{2}})
+
+	_, err := LoadRLNMembershipCredential(path)
+	require.Equal(t, ErrRLNInvalidCredential, err)
+}
+
+func TestRLNStaticGroupInvalidMember(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "group.json")
+	writeJSON(t, path, []hexutil.Bytes{{1, 2, 3}})
+
+	_, err := NewRLNStaticGroupRegistry(path).Members()
+	require.True(t, errors.Is(err, ErrRLNInvalidMember))
+}
+
+func TestRLNRelayPerPubsubTopic(t *testing.T) {
+	defaultRelay := &testRLNRelay{}
+	shardRelay := &testRLNRelay{}
+	w := &Waku{rlnRelays: map[string]rlnRelay{
+		relay.DefaultWakuTopic: defaultRelay,
+		"/waku/2/shard":        shardRelay,
+	}}
+
+	require.Equal(t, shardRelay, w.rlnRelayFor("/waku/2/shard"))
+	require.Equal(t, defaultRelay, w.rlnRelayFor(relay.DefaultWakuTopic))
+	// Messages of pubsub topics that aren't relayed are proved against the default one
+	require.Equal(t, defaultRelay, w.rlnRelayFor("/waku/2/other"))
+
+	w.stopRLN()
+	require.True(t, defaultRelay.stopped)
+	require.True(t, shardRelay.stopped)
+	require.Nil(t, w.rlnRelayFor(relay.DefaultWakuTopic))
+}
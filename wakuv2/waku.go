@@ -98,6 +98,8 @@ type Waku struct {
 	node            *node.WakuNode // reference to a libp2p waku node
 	identifyService identify.IDService
 	appDB           *sql.DB
+	dbStore         *persistence.DBStore // nil if the store protocol is disabled
+	storeDB         *sql.DB              // DB dedicated to the store, nil if it shares appDB
 
 	dnsAddressCache     map[string][]dnsdisc.DiscoveredNode // Map to store the multiaddresses returned by dns discovery
 	dnsAddressCacheLock *sync.RWMutex                       // lock to handle access to the map
@@ -114,8 +116,12 @@ type Waku struct {
 	poolMu      sync.RWMutex                                // Mutex to sync the message and expiration pools
 
 	bandwidthCounter *metrics.BandwidthCounter
+	topicBandwidth   *common.TopicBandwidthTracker // Traffic by content topic, for the messages sent and received
 
-	sendQueue chan *pb.WakuMessage
+	pubsubTopics   map[string]*pubsubTopicSubscription // Relay subscriptions to pubsub topics other than the default one
+	pubsubTopicsMu sync.Mutex
+
+	sendQueue chan *protocol.Envelope
 	msgQueue  chan *common.ReceivedMessage // Message queue for waku messages that havent been decoded
 	quit      chan struct{}                // Channel used for graceful exit
 	wg        sync.WaitGroup
@@ -150,6 +156,12 @@ type Waku struct {
 
 	// discV5BootstrapNodes is the ENR to be used to fetch bootstrap nodes for discovery
 	discV5BootstrapNodes []string
+
+	// rlnMembership is set when RLN is enabled
+	rlnMembership *rlnMembership
+	// rlnRelays validate the proofs of the messages of each relayed pubsub topic
+	rlnRelays   map[string]rlnRelay
+	rlnRelaysMu sync.Mutex
 }
 
 // New creates a WakuV2 client ready to communicate through the LibP2P network.
@@ -173,7 +185,9 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		envelopes:               make(map[gethcommon.Hash]*common.ReceivedMessage),
 		expirations:             make(map[uint32]mapset.Set),
 		msgQueue:                make(chan *common.ReceivedMessage, messageQueueLimit),
-		sendQueue:               make(chan *pb.WakuMessage, 1000),
+		sendQueue:               make(chan *protocol.Envelope, 1000),
+		pubsubTopics:            make(map[string]*pubsubTopicSubscription),
+		rlnRelays:               make(map[string]rlnRelay),
 		connStatusSubscriptions: make(map[string]*types.ConnStatusSubscription),
 		quit:                    make(chan struct{}),
 		connectionChanged:       make(chan struct{}),
@@ -203,6 +217,7 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 
 	waku.filters = common.NewFilters()
 	waku.bandwidthCounter = metrics.NewBandwidthCounter()
+	waku.topicBandwidth = common.NewTopicBandwidthTracker()
 	waku.filterMsgChannel = make(chan *protocol.Envelope, 1024)
 
 	var privateKey *ecdsa.PrivateKey
@@ -275,10 +290,31 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 
 	if cfg.EnableStore {
 		opts = append(opts, node.WithWakuStore())
-		dbStore, err := persistence.NewDBStore(logger, persistence.WithDB(appDB), persistence.WithRetentionPolicy(cfg.StoreCapacity, time.Duration(cfg.StoreSeconds)*time.Second))
+		storeDB := appDB
+		if cfg.StoreIncrementalVacuum {
+			if cfg.StoreDBPath == "" {
+				return nil, errors.New("incremental vacuum of the store requires a store DB path")
+			}
+			waku.storeDB, err = persistence.OpenStoreDB(cfg.StoreDBPath)
+			if err != nil {
+				return nil, err
+			}
+			// The messages stored in the app DB before are moved, so that they keep
+			// being served and pruned, and their pages are reused by the app DB
+			moved, err := persistence.MoveStoreMessages(appDB, waku.storeDB)
+			if err != nil {
+				return nil, err
+			}
+			if moved != 0 {
+				logger.Info("moved stored messages to the store DB", zap.Int("count", moved))
+			}
+			storeDB = waku.storeDB
+		}
+		dbStore, err := persistence.NewDBStore(logger, storeOptions(storeDB, cfg)...)
 		if err != nil {
 			return nil, err
 		}
+		waku.dbStore = dbStore
 		opts = append(opts, node.WithMessageProvider(dbStore))
 	}
 
@@ -297,6 +333,11 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		return nil, fmt.Errorf("failed to start go-waku node: %v", err)
 	}
 
+	if err = waku.mountRLN(ctx, cfg); err != nil {
+		waku.node.Stop()
+		return nil, fmt.Errorf("failed to mount RLN: %v", err)
+	}
+
 	if err = waku.addWakuV2Peers(ctx, cfg); err != nil {
 		return nil, fmt.Errorf("failed to add wakuv2 peers: %v", err)
 	}
@@ -523,6 +564,12 @@ func (w *Waku) GetStats() types.StatsSummary {
 	}
 }
 
+// TakeTopicBandwidth returns the size of the messages sent and received by
+// content topic and protocol since the previous call
+func (w *Waku) TakeTopicBandwidth() []common.TopicBandwidth {
+	return w.topicBandwidth.Take()
+}
+
 func (w *Waku) runPeerExchangeLoop() {
 	defer w.wg.Done()
 
@@ -621,6 +668,7 @@ func (w *Waku) runRelayMsgLoop() {
 			sub.Unsubscribe()
 			return
 		case env := <-sub.C:
+			w.topicBandwidth.AddDownload(common.ProtocolRelay, env.PubsubTopic(), env.Message().ContentTopic, env.Message().Size())
 			envelopeErrors, err := w.OnNewEnvelopes(env, common.RelayedMessageType)
 			if err != nil {
 				w.logger.Error("onNewEnvelope error", zap.Error(err))
@@ -644,6 +692,7 @@ func (w *Waku) runFilterMsgLoop() {
 		case <-w.quit:
 			return
 		case env := <-w.filterMsgChannel:
+			w.topicBandwidth.AddDownload(common.ProtocolFilter, env.PubsubTopic(), env.Message().ContentTopic, env.Message().Size())
 			envelopeErrors, err := w.OnNewEnvelopes(env, common.RelayedMessageType)
 			// TODO: should these be handled?
 			_ = envelopeErrors
@@ -652,7 +701,7 @@ func (w *Waku) runFilterMsgLoop() {
 	}
 }
 
-func (w *Waku) subscribeWakuFilterTopic(topics [][]byte) {
+func (w *Waku) subscribeWakuFilterTopic(pubsubTopic string, topics [][]byte) {
 	var contentTopics []string
 	for _, topic := range topics {
 		contentTopics = append(contentTopics, common.BytesToTopic(topic).ContentTopic())
@@ -660,7 +709,7 @@ func (w *Waku) subscribeWakuFilterTopic(topics [][]byte) {
 
 	var err error
 	contentFilter := filter.ContentFilter{
-		Topic:         relay.DefaultWakuTopic,
+		Topic:         pubsubTopicOrDefault(pubsubTopic),
 		ContentTopics: contentTopics,
 	}
 
@@ -970,7 +1019,13 @@ func (w *Waku) Subscribe(f *common.Filter) (string, error) {
 	}
 
 	if w.settings.LightClient {
-		w.subscribeWakuFilterTopic(f.Topics)
+		w.subscribeWakuFilterTopic(f.PubsubTopic, f.Topics)
+	}
+
+	err = w.subscribeToPubsubTopic(pubsubTopicOrDefault(f.PubsubTopic))
+	if err != nil {
+		w.filters.Uninstall(s)
+		return "", err
 	}
 
 	return s, nil
@@ -986,7 +1041,7 @@ func (w *Waku) Unsubscribe(id string) error {
 	f := w.filters.Get(id)
 	if f != nil && w.settings.LightClient {
 		contentFilter := filter.ContentFilter{
-			Topic: relay.DefaultWakuTopic,
+			Topic: pubsubTopicOrDefault(f.PubsubTopic),
 		}
 		for _, topic := range f.Topics {
 			contentFilter.ContentTopics = append(contentFilter.ContentTopics, common.BytesToTopic(topic).ContentTopic())
@@ -1001,6 +1056,9 @@ func (w *Waku) Unsubscribe(id string) error {
 	if !ok {
 		return fmt.Errorf("failed to unsubscribe: invalid ID '%s'", id)
 	}
+
+	w.unsubscribeFromPubsubTopic(pubsubTopicOrDefault(f.PubsubTopic))
+
 	return nil
 }
 
@@ -1008,10 +1066,13 @@ func (w *Waku) Unsubscribe(id string) error {
 func (w *Waku) UnsubscribeMany(ids []string) error {
 	for _, id := range ids {
 		w.logger.Debug("cleaning up filter", zap.String("id", id))
+		f := w.filters.Get(id)
 		ok := w.filters.Uninstall(id)
 		if !ok {
 			w.logger.Warn("could not remove filter with id", zap.String("id", id))
+			continue
 		}
+		w.unsubscribeFromPubsubTopic(pubsubTopicOrDefault(f.PubsubTopic))
 	}
 	return nil
 }
@@ -1019,7 +1080,8 @@ func (w *Waku) UnsubscribeMany(ids []string) error {
 func (w *Waku) broadcast() {
 	for {
 		select {
-		case msg := <-w.sendQueue:
+		case envelope := <-w.sendQueue:
+			msg := envelope.Message()
 
 			hash, _, err := msg.Hash()
 			if err != nil {
@@ -1027,12 +1089,14 @@ func (w *Waku) broadcast() {
 				continue
 			}
 
+			sentVia := common.ProtocolRelay
 			if w.settings.LightClient {
+				sentVia = common.ProtocolLightpush
 				w.logger.Info("publishing message via lightpush", zap.String("envelopeHash", hexutil.Encode(hash)))
-				_, err = w.node.Lightpush().Publish(context.Background(), msg)
+				_, err = w.node.Lightpush().PublishToTopic(context.Background(), msg, envelope.PubsubTopic())
 			} else {
 				w.logger.Info("publishing message via relay", zap.String("envelopeHash", hexutil.Encode(hash)))
-				_, err = w.node.Relay().Publish(context.Background(), msg)
+				_, err = w.node.Relay().PublishToTopic(context.Background(), msg, envelope.PubsubTopic())
 			}
 
 			if err != nil {
@@ -1051,6 +1115,7 @@ func (w *Waku) broadcast() {
 			}
 
 			w.SendEnvelopeEvent(event)
+			w.topicBandwidth.AddUpload(sentVia, envelope.PubsubTopic(), msg.ContentTopic, msg.Size())
 
 		case <-w.quit:
 			return
@@ -1059,20 +1124,30 @@ func (w *Waku) broadcast() {
 }
 
 // Send injects a message into the waku send queue, to be distributed in the
-// network in the coming cycles.
-func (w *Waku) Send(msg *pb.WakuMessage) ([]byte, error) {
+// network in the coming cycles. It is published on pubsubTopic, the default
+// one if empty.
+func (w *Waku) Send(pubsubTopic string, msg *pb.WakuMessage) ([]byte, error) {
+	// The proof is part of the message, so it must be attached before hashing it
+	if rlnRelay := w.rlnRelayFor(pubsubTopicOrDefault(pubsubTopic)); rlnRelay != nil {
+		err := rlnRelay.AppendRLNProof(msg, w.timeSource())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	hash, _, err := msg.Hash()
 	if err != nil {
 		return nil, err
 	}
 
-	w.sendQueue <- msg
+	envelope := protocol.NewEnvelope(msg, msg.Timestamp, pubsubTopicOrDefault(pubsubTopic))
+
+	w.sendQueue <- envelope
 
 	w.poolMu.Lock()
 	_, alreadyCached := w.envelopes[gethcommon.BytesToHash(hash)]
 	w.poolMu.Unlock()
 	if !alreadyCached {
-		envelope := protocol.NewEnvelope(msg, msg.Timestamp, relay.DefaultWakuTopic)
 		recvMessage := common.NewReceivedMessage(envelope, common.RelayedMessageType)
 		w.postEvent(recvMessage) // notify the local node about the new message
 		w.addEnvelope(recvMessage)
@@ -1081,7 +1156,11 @@ func (w *Waku) Send(msg *pb.WakuMessage) ([]byte, error) {
 	return hash, nil
 }
 
-func (w *Waku) Query(peerID peer.ID, topics []common.TopicType, from uint64, to uint64, opts []store.HistoryRequestOption) (cursor *pb.Index, err error) {
+// Query requests the messages relayed on pubsubTopic, the default one if empty,
+// in the given content topics and time range from the store node peerID.
+func (w *Waku) Query(peerID peer.ID, pubsubTopic string, topics []common.TopicType, from uint64, to uint64, opts []store.HistoryRequestOption) (cursor *pb.Index, err error) {
+	pubsubTopic = pubsubTopicOrDefault(pubsubTopic)
+
 	strTopics := make([]string, len(topics))
 	for i, t := range topics {
 		strTopics[i] = t.ContentTopic()
@@ -1096,7 +1175,7 @@ func (w *Waku) Query(peerID peer.ID, topics []common.TopicType, from uint64, to
 		StartTime:     int64(from) * int64(time.Second),
 		EndTime:       int64(to) * int64(time.Second),
 		ContentTopics: strTopics,
-		Topic:         relay.DefaultWakuTopic,
+		Topic:         pubsubTopic,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
@@ -1112,9 +1191,14 @@ func (w *Waku) Query(peerID peer.ID, topics []common.TopicType, from uint64, to
 	for _, msg := range result.Messages {
 		// Temporarily setting RateLimitProof to nil so it matches the WakuMessage protobuffer we are sending
 		// See https://github.com/vacp2p/rfc/issues/563
-		msg.RateLimitProof = nil
+		// Messages sent with RLN enabled carry their proof
+		if w.rlnMembership == nil {
+			msg.RateLimitProof = nil
+		}
 
-		envelope := protocol.NewEnvelope(msg, msg.Timestamp, relay.DefaultWakuTopic)
+		w.topicBandwidth.AddDownload(common.ProtocolStore, pubsubTopic, msg.ContentTopic, msg.Size())
+
+		envelope := protocol.NewEnvelope(msg, msg.Timestamp, pubsubTopic)
 		w.logger.Info("received waku2 store message", zap.Any("envelopeHash", hexutil.Encode(envelope.Hash())))
 		_, err = w.OnNewEnvelopes(envelope, common.StoreMessageType)
 		if err != nil {
@@ -1146,8 +1230,14 @@ func (w *Waku) Start() error {
 // Stop implements node.Service, stopping the background data propagation thread
 // of the Waku protocol.
 func (w *Waku) Stop() error {
+	w.stopRLN()
 	w.identifyService.Close()
 	w.node.Stop()
+	if w.storeDB != nil {
+		if err := w.storeDB.Close(); err != nil {
+			w.logger.Warn("could not close the store DB", zap.Error(err))
+		}
+	}
 	close(w.quit)
 	close(w.filterMsgChannel)
 	close(w.connectionChanged)
@@ -1399,6 +1489,40 @@ func (w *Waku) restartDiscV5() error {
 	return w.node.SetDiscV5Bootnodes(bootnodes)
 }
 
+func storeOptions(db *sql.DB, cfg *Config) []persistence.DBOption {
+	var topicPolicies []persistence.TopicRetentionPolicy
+	for _, policy := range cfg.StoreRetentionPolicies {
+		topicPolicies = append(topicPolicies, persistence.TopicRetentionPolicy{
+			PubsubTopic:  policy.PubsubTopic,
+			ContentTopic: policy.ContentTopic,
+			RetentionPolicy: persistence.RetentionPolicy{
+				MaxMessages: policy.MaxMessages,
+				MaxDuration: time.Duration(policy.MaxSeconds) * time.Second,
+				MaxBytes:    policy.MaxBytes,
+			},
+		})
+	}
+
+	options := []persistence.DBOption{
+		persistence.WithDB(db),
+		persistence.WithRetentionPolicy(cfg.StoreCapacity, time.Duration(cfg.StoreSeconds)*time.Second),
+		persistence.WithMaxSize(cfg.StoreMaxBytes),
+		persistence.WithTopicRetentionPolicies(topicPolicies...),
+	}
+	if cfg.StoreIncrementalVacuum {
+		options = append(options, persistence.WithIncrementalVacuum())
+	}
+	return options
+}
+
+// StoreStats returns the disk usage of the messages stored by the node, by topic
+func (w *Waku) StoreStats() (*persistence.StoreStats, error) {
+	if w.dbStore == nil {
+		return nil, errors.New("store protocol is not enabled")
+	}
+	return w.dbStore.Stats()
+}
+
 func (w *Waku) AddStorePeer(address string) (string, error) {
 	addr, err := multiaddr.NewMultiaddr(address)
 	if err != nil {
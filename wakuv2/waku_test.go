@@ -1,6 +1,7 @@
 package wakuv2
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -8,7 +9,10 @@ import (
 	"github.com/cenkalti/backoff/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+
 	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/wakuv2/common"
 )
 
 var testENRBootstrap = "enrtree://AOGECG2SPND25EEFMAJ5WF3KSGJNSGV356DSTL2YVLLZWIV6SAYBM@prod.nodes.status.im"
@@ -83,3 +87,50 @@ func TestRestartDiscoveryV5(t *testing.T) {
 	require.NotEqual(t, 0, len(w.Peers()))
 	require.NoError(t, w.Stop())
 }
+
+func TestRelayBandwidthOnShardTopic(t *testing.T) {
+	shard := "/waku/2/rs/16/1"
+
+	newNode := func(port int) *Waku {
+		config := &Config{}
+		config.Host = "127.0.0.1"
+		config.Port = port
+		w, err := New("", "", config, nil, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, w.Start())
+		require.NoError(t, w.subscribeToPubsubTopic(shard))
+		return w
+	}
+
+	sender := newNode(60101)
+	defer func() { require.NoError(t, sender.Stop()) }()
+	receiver := newNode(60102)
+	defer func() { require.NoError(t, receiver.Stop()) }()
+
+	require.NoError(t, sender.DialPeer(receiver.ListenAddresses()[0]))
+
+	contentTopic := "/waku/1/0x01020304/rfc26"
+
+	// Messages published before the peers joined the shard mesh are lost, so a new
+	// one is published on each attempt
+	err := tt.RetryWithBackOff(func() error {
+		msg := &pb.WakuMessage{
+			Payload:      []byte{1, 2, 3},
+			ContentTopic: contentTopic,
+			Timestamp:    time.Now().UnixNano(),
+		}
+		_, err := sender.node.Relay().PublishToTopic(context.Background(), msg, shard)
+		if err != nil {
+			return err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		for _, bandwidth := range receiver.TakeTopicBandwidth() {
+			if bandwidth.Protocol == common.ProtocolRelay && bandwidth.PubsubTopic == shard && bandwidth.ContentTopic == contentTopic && bandwidth.Download > 0 {
+				return nil
+			}
+		}
+		return errors.New("no relay download accounted on the shard topic")
+	})
+	require.NoError(t, err)
+}